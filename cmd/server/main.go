@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,8 +9,10 @@ import (
 	"myfin-api/internal/config"
 	"myfin-api/internal/db"
 	handlers "myfin-api/internal/handler"
+	"myfin-api/internal/migrations"
 	"myfin-api/internal/repository"
 	"myfin-api/internal/services"
+	"myfin-api/internal/worker"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -17,26 +20,90 @@ import (
 
 const transactionsPath = "/transactions"
 const transactionsDashboardPath = "/transactions/dashboard"
+const transactionsBatchPath = "/transactions/batch"
+const accountsPath = "/accounts"
+const transfersPath = "/transfers"
+const budgetsPath = "/budgets"
+const recurringRulesPath = "/recurring-rules"
+const exchangeRatesPath = "/exchange-rates"
+const reportsPath = "/reports"
+const savedViewsPath = "/views"
 
 var transactionsIDPath = fmt.Sprintf("%s/:id", transactionsPath)
+var accountsIDPath = fmt.Sprintf("%s/:id", accountsPath)
+var accountsBalancePath = fmt.Sprintf("%s/balance", accountsIDPath)
+var transfersIDPath = fmt.Sprintf("%s/:id", transfersPath)
+var budgetsIDPath = fmt.Sprintf("%s/:id", budgetsPath)
+var budgetsStatusPath = fmt.Sprintf("%s/:month/status", budgetsPath)
+var recurringRulesIDPath = fmt.Sprintf("%s/:id", recurringRulesPath)
+var exchangeRatesIDPath = fmt.Sprintf("%s/:id", exchangeRatesPath)
+var reportsCategoriesPath = fmt.Sprintf("%s/categories", reportsPath)
+var reportsCashflowPath = fmt.Sprintf("%s/cashflow", reportsPath)
+var savedViewsIDPath = fmt.Sprintf("%s/:id", savedViewsPath)
+var savedViewsTransactionsPath = fmt.Sprintf("%s/transactions", savedViewsIDPath)
 
 func main() {
 	cfg := config.LoadConfig()
 
 	db.Connect(cfg)
 
-	r := gin.Default()
+	r := gin.New()
+	r.Use(gin.Logger(), gin.CustomRecovery(handlers.Recovered))
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(handlers.NotFound)
+	r.NoMethod(handlers.MethodNotAllowed)
+
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
-	config.AllowMethods = []string{"POST", "GET", "PUT", "OPTIONS", "DELETE"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "Accept", "User-Agent", "Cache-Control", "Pragma"}
-	config.ExposeHeaders = []string{"Content-Length"}
+	config.AllowMethods = []string{"POST", "GET", "PUT", "PATCH", "OPTIONS", "DELETE"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "Accept", "User-Agent", "Cache-Control", "Pragma", "Idempotency-Key", "If-Match", "Prefer"}
+	config.ExposeHeaders = []string{"Content-Length", "Content-Language", "Link", "Idempotent-Replayed", "ETag", "Preference-Applied"}
 	config.AllowCredentials = true
 	config.MaxAge = 12 * time.Hour
 
-	r.Use(cors.New(config))
+	r.Use(cors.New(config), handlers.DateFormat)
+
+	transactionsRepository := repository.NewTransactionsEntryRepository(db.MongoDatabase)
+	accountsRepository := repository.NewAccountsRepository(db.MongoDatabase)
+	budgetsRepository := repository.NewBudgetsRepository(db.MongoDatabase)
+	recurringRulesRepository := repository.NewRecurringRulesRepository(db.MongoDatabase)
+	exchangeRatesRepository := repository.NewExchangeRatesRepository(db.MongoDatabase)
+	savedViewsRepository := repository.NewSavedViewsRepository(db.MongoDatabase)
+	idempotencyKeysRepository := repository.NewIdempotencyKeysRepository(db.MongoDatabase)
+
+	if err := migrations.Run(db.MongoDatabase, migrations.All); err != nil {
+		log.Fatal("Erro ao aplicar migrações:", err)
+	}
+
+	if err := transactionsRepository.EnsureIndexes(); err != nil {
+		log.Fatal("Erro ao criar índices de transações:", err)
+	}
+
+	if err := idempotencyKeysRepository.EnsureIndexes(); err != nil {
+		log.Fatal("Erro ao criar índices de chaves de idempotência:", err)
+	}
 
-	handler := handlers.NewTransactionsHandler(services.NewTransactionsService(repository.NewTransactionsEntryRepository(db.MongoDatabase)))
+	if err := budgetsRepository.EnsureIndexes(); err != nil {
+		log.Fatal("Erro ao criar índices de orçamentos:", err)
+	}
+
+	if err := exchangeRatesRepository.EnsureIndexes(); err != nil {
+		log.Fatal("Erro ao criar índices de cotações:", err)
+	}
+
+	transactionsService := services.NewTransactionsService(transactionsRepository, accountsRepository, exchangeRatesRepository)
+	handler := handlers.NewTransactionsHandler(transactionsService, services.NewIdempotencyService(idempotencyKeysRepository, transactionsService))
+	accountsHandler := handlers.NewAccountsHandler(services.NewAccountsService(accountsRepository, transactionsRepository))
+	transfersHandler := handlers.NewTransfersHandler(services.NewTransfersService(transactionsRepository, accountsRepository))
+	budgetsHandler := handlers.NewBudgetsHandler(services.NewBudgetsService(budgetsRepository, transactionsRepository))
+	exchangeRatesHandler := handlers.NewExchangeRatesHandler(services.NewExchangeRatesService(exchangeRatesRepository))
+	reportsHandler := handlers.NewReportsHandler(services.NewReportsService(transactionsRepository))
+	savedViewsHandler := handlers.NewSavedViewsHandler(services.NewSavedViewsService(savedViewsRepository, transactionsService))
+
+	recurringRulesService := services.NewRecurringRulesService(recurringRulesRepository, transactionsRepository, accountsRepository)
+	recurringRulesHandler := handlers.NewRecurringRulesHandler(recurringRulesService)
+
+	go worker.NewRecurringWorker(recurringRulesService, cfg.RecurringInterval).Start(context.Background())
 
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -52,6 +119,10 @@ func main() {
 		handler.GetAll(c)
 	})
 
+	r.POST(transactionsBatchPath, func(c *gin.Context) {
+		handler.Batch(c)
+	})
+
 	r.GET(transactionsDashboardPath, func(c *gin.Context) {
 		handler.GetTransactionDashboardData(c)
 	})
@@ -64,10 +135,142 @@ func main() {
 		handler.Update(c)
 	})
 
+	r.PATCH(transactionsIDPath, func(c *gin.Context) {
+		handler.Patch(c)
+	})
+
 	r.DELETE(transactionsIDPath, func(c *gin.Context) {
 		handler.Delete(c)
 	})
 
+	r.POST(accountsPath, func(c *gin.Context) {
+		accountsHandler.Save(c)
+	})
+
+	r.GET(accountsPath, func(c *gin.Context) {
+		accountsHandler.GetAll(c)
+	})
+
+	r.GET(accountsIDPath, func(c *gin.Context) {
+		accountsHandler.GetByID(c)
+	})
+
+	r.GET(accountsBalancePath, func(c *gin.Context) {
+		accountsHandler.GetBalance(c)
+	})
+
+	r.PUT(accountsIDPath, func(c *gin.Context) {
+		accountsHandler.Update(c)
+	})
+
+	r.DELETE(accountsIDPath, func(c *gin.Context) {
+		accountsHandler.Delete(c)
+	})
+
+	r.POST(transfersPath, func(c *gin.Context) {
+		transfersHandler.Save(c)
+	})
+
+	r.GET(transfersIDPath, func(c *gin.Context) {
+		transfersHandler.GetByID(c)
+	})
+
+	r.PUT(transfersIDPath, func(c *gin.Context) {
+		transfersHandler.Update(c)
+	})
+
+	r.DELETE(transfersIDPath, func(c *gin.Context) {
+		transfersHandler.Delete(c)
+	})
+
+	r.POST(budgetsPath, func(c *gin.Context) {
+		budgetsHandler.Save(c)
+	})
+
+	r.GET(budgetsPath, func(c *gin.Context) {
+		budgetsHandler.GetAll(c)
+	})
+
+	r.GET(budgetsStatusPath, func(c *gin.Context) {
+		budgetsHandler.GetStatus(c)
+	})
+
+	r.PUT(budgetsIDPath, func(c *gin.Context) {
+		budgetsHandler.Update(c)
+	})
+
+	r.DELETE(budgetsIDPath, func(c *gin.Context) {
+		budgetsHandler.Delete(c)
+	})
+
+	r.POST(recurringRulesPath, func(c *gin.Context) {
+		recurringRulesHandler.Save(c)
+	})
+
+	r.GET(recurringRulesPath, func(c *gin.Context) {
+		recurringRulesHandler.GetAll(c)
+	})
+
+	r.GET(recurringRulesIDPath, func(c *gin.Context) {
+		recurringRulesHandler.GetByID(c)
+	})
+
+	r.PUT(recurringRulesIDPath, func(c *gin.Context) {
+		recurringRulesHandler.Update(c)
+	})
+
+	r.DELETE(recurringRulesIDPath, func(c *gin.Context) {
+		recurringRulesHandler.Delete(c)
+	})
+
+	r.POST(exchangeRatesPath, func(c *gin.Context) {
+		exchangeRatesHandler.Save(c)
+	})
+
+	r.GET(exchangeRatesPath, func(c *gin.Context) {
+		exchangeRatesHandler.GetAll(c)
+	})
+
+	r.PUT(exchangeRatesIDPath, func(c *gin.Context) {
+		exchangeRatesHandler.Update(c)
+	})
+
+	r.DELETE(exchangeRatesIDPath, func(c *gin.Context) {
+		exchangeRatesHandler.Delete(c)
+	})
+
+	r.GET(reportsCategoriesPath, func(c *gin.Context) {
+		reportsHandler.GetCategories(c)
+	})
+
+	r.GET(reportsCashflowPath, func(c *gin.Context) {
+		reportsHandler.GetCashflow(c)
+	})
+
+	r.POST(savedViewsPath, func(c *gin.Context) {
+		savedViewsHandler.Save(c)
+	})
+
+	r.GET(savedViewsPath, func(c *gin.Context) {
+		savedViewsHandler.GetAll(c)
+	})
+
+	r.GET(savedViewsIDPath, func(c *gin.Context) {
+		savedViewsHandler.GetByID(c)
+	})
+
+	r.GET(savedViewsTransactionsPath, func(c *gin.Context) {
+		savedViewsHandler.GetTransactions(c)
+	})
+
+	r.PUT(savedViewsIDPath, func(c *gin.Context) {
+		savedViewsHandler.Update(c)
+	})
+
+	r.DELETE(savedViewsIDPath, func(c *gin.Context) {
+		savedViewsHandler.Delete(c)
+	})
+
 	log.Println("🚀 Servidor rodando em http://localhost:8080")
 	r.Run(":8080")
 }
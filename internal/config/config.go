@@ -3,13 +3,17 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+const defaultRecurringInterval = time.Minute
+
 type Config struct {
-	MongoURI      string
-	MongoDatabase string
+	MongoURI          string
+	MongoDatabase     string
+	RecurringInterval time.Duration
 }
 
 func LoadConfig() *Config {
@@ -20,10 +24,12 @@ func LoadConfig() *Config {
 	}
 
 	config := &Config{
-		MongoURI:      getEnv("MONGODB_DATABASE_URL", "mongodb://localhost:27017"),
+		MongoURI:      getEnv("MONGODB_DATABASE_URL", "mongodb://localhost:27017/?directConnection=true"),
 		MongoDatabase: getEnv("MONGODB_DATABASE", "testdb"),
 	}
 
+	config.RecurringInterval = getDurationEnv("RECURRING_WORKER_INTERVAL", defaultRecurringInterval)
+
 	return config
 }
 
@@ -33,3 +39,18 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getDurationEnv(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Printf("⚠️  Valor inválido para %s (%q), usando %s.", key, value, fallback)
+		return fallback
+	}
+
+	return duration
+}
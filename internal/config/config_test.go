@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -50,7 +51,7 @@ func TestLoadConfig(t *testing.T) {
 
 		config := LoadConfig()
 
-		assert.Equal(t, "mongodb://localhost:27017", config.MongoURI, "Should use default MongoDB URI")
+		assert.Equal(t, "mongodb://localhost:27017/?directConnection=true", config.MongoURI, "Should use default MongoDB URI")
 		assert.Equal(t, "testdb", config.MongoDatabase, "Should use default MongoDB database name")
 	})
 
@@ -140,3 +141,31 @@ MONGODB_DATABASE=envfiledb
 	assert.Equal(t, envURI, config.MongoURI, "Environment variable should take precedence over .env file")
 	assert.Equal(t, envDB, config.MongoDatabase, "Environment variable should take precedence over .env file")
 }
+
+func TestGetDurationEnv(t *testing.T) {
+	t.Run("valid_duration", func(t *testing.T) {
+		os.Setenv("TEST_DURATION_VAR", "30s")
+		defer os.Unsetenv("TEST_DURATION_VAR")
+
+		result := getDurationEnv("TEST_DURATION_VAR", time.Minute)
+
+		assert.Equal(t, 30*time.Second, result, "Should parse the duration from the environment")
+	})
+
+	t.Run("missing_duration", func(t *testing.T) {
+		os.Unsetenv("TEST_DURATION_VAR")
+
+		result := getDurationEnv("TEST_DURATION_VAR", time.Minute)
+
+		assert.Equal(t, time.Minute, result, "Should return the fallback duration")
+	})
+
+	t.Run("invalid_duration", func(t *testing.T) {
+		os.Setenv("TEST_DURATION_VAR", "soon")
+		defer os.Unsetenv("TEST_DURATION_VAR")
+
+		result := getDurationEnv("TEST_DURATION_VAR", time.Minute)
+
+		assert.Equal(t, time.Minute, result, "Should return the fallback for an invalid duration")
+	})
+}
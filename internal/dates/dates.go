@@ -0,0 +1,64 @@
+package dates
+
+import (
+	"strings"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/i18n"
+)
+
+const (
+	// BrazilianLayout is the DD/MM/YYYY format the API has always used, and
+	// stays the default output so existing clients keep working.
+	BrazilianLayout = "02/01/2006"
+	ISOLayout       = "2006-01-02"
+
+	DefaultLayout = BrazilianLayout
+)
+
+var ErrInvalidDate = domain.NewError(domain.ErrValidation, i18n.InvalidDate)
+
+// formats maps the names clients use to pick an output format to layouts.
+var formats = map[string]string{
+	"br":  BrazilianLayout,
+	"iso": ISOLayout,
+}
+
+// Parse reads a calendar date written as DD/MM/YYYY, as an ISO 8601 date
+// (YYYY-MM-DD) or as an RFC 3339 timestamp. A timestamp keeps the date it has
+// in its own offset, so "2025-01-31T23:30:00-03:00" is 31 January. The result
+// is midnight UTC, which is how dates are stored.
+func Parse(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range []string{BrazilianLayout, ISOLayout} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// Layout returns the layout for an output format name such as "iso".
+func Layout(format string) (string, bool) {
+	layout, ok := formats[strings.ToLower(strings.TrimSpace(format))]
+	return layout, ok
+}
+
+// Format rewrites a date accepted by Parse in layout. Values Parse rejects,
+// including the empty string, are returned as they are.
+func Format(value string, layout string) string {
+	date, err := Parse(value)
+	if err != nil {
+		return value
+	}
+
+	return date.Format(layout)
+}
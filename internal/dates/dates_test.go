@@ -0,0 +1,77 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	expected := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		value         string
+		expectedError error
+	}{
+		{name: "brazilian", value: "31/01/2025"},
+		{name: "iso_date", value: "2025-01-31"},
+		{name: "surrounding_spaces", value: " 2025-01-31 "},
+		{name: "utc_timestamp", value: "2025-01-31T10:00:00Z"},
+		{name: "timestamp_keeps_its_own_date", value: "2025-01-31T23:30:00-03:00"},
+		{name: "fractional_seconds", value: "2025-01-31T00:00:00.123+09:00"},
+		{name: "impossible_day", value: "31/02/2025", expectedError: ErrInvalidDate},
+		{name: "dashed_day_first", value: "31-01-2025", expectedError: ErrInvalidDate},
+		{name: "slashed_year_first", value: "2025/01/31", expectedError: ErrInvalidDate},
+		{name: "timestamp_without_offset", value: "2025-01-31T10:00:00", expectedError: ErrInvalidDate},
+		{name: "empty", value: "", expectedError: ErrInvalidDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := Parse(tt.value)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, date)
+		})
+	}
+}
+
+func TestLayout(t *testing.T) {
+	layout, ok := Layout("iso")
+	assert.True(t, ok)
+	assert.Equal(t, ISOLayout, layout)
+
+	layout, ok = Layout(" BR ")
+	assert.True(t, ok)
+	assert.Equal(t, BrazilianLayout, layout)
+
+	_, ok = Layout("us")
+	assert.False(t, ok)
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		layout   string
+		expected string
+	}{
+		{name: "brazilian_to_iso", value: "31/01/2025", layout: ISOLayout, expected: "2025-01-31"},
+		{name: "iso_to_brazilian", value: "2025-01-31", layout: BrazilianLayout, expected: "31/01/2025"},
+		{name: "timestamp_to_iso", value: "2025-01-31T23:30:00-03:00", layout: ISOLayout, expected: "2025-01-31"},
+		{name: "empty", value: "", layout: ISOLayout, expected: ""},
+		{name: "not_a_date", value: "yesterday", layout: ISOLayout, expected: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Format(tt.value, tt.layout))
+		})
+	}
+}
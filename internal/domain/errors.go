@@ -0,0 +1,38 @@
+// Package domain holds the error categories shared by every layer. Repositories
+// and services return errors in one of these categories and handlers map the
+// category to an HTTP status, so no layer needs to know about the others.
+package domain
+
+import "myfin-api/internal/i18n"
+
+var (
+	ErrNotFound   = &Error{Code: i18n.NotFound}
+	ErrInvalidID  = &Error{Code: i18n.InvalidID}
+	ErrConflict   = &Error{Code: i18n.Conflict}
+	ErrValidation = &Error{Code: i18n.ValidationFailed}
+
+	// ErrUnsupported marks operations the deployment cannot perform, such as
+	// multi-document transactions on a standalone Mongo server.
+	ErrUnsupported = &Error{Code: i18n.Unsupported}
+)
+
+// Error keeps its own message while still matching its category with
+// errors.Is, so callers can check for the specific error or the category.
+// The message is looked up by Code, which lets handlers show it in the
+// client's language; Error returns the English text.
+type Error struct {
+	Kind error
+	Code i18n.Code
+}
+
+func NewError(kind error, code i18n.Code) *Error {
+	return &Error{Kind: kind, Code: code}
+}
+
+func (e *Error) Error() string {
+	return i18n.Message(i18n.English, e.Code)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
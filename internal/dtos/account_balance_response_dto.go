@@ -0,0 +1,15 @@
+package dtos
+
+import "encoding/json"
+
+type AccountBalanceResponseDTO struct {
+	AccountID      string      `bson:"accountId" json:"accountId"`
+	Name           string      `bson:"name" json:"name"`
+	Currency       string      `bson:"currency" json:"currency"`
+	OpeningBalance json.Number `bson:"openingBalance" json:"openingBalance"`
+	IncomeAmount   json.Number `bson:"incomeAmount" json:"incomeAmount"`
+	ExpenseAmount  json.Number `bson:"expenseAmount" json:"expenseAmount"`
+	TransfersIn    json.Number `bson:"transfersIn" json:"transfersIn"`
+	TransfersOut   json.Number `bson:"transfersOut" json:"transfersOut"`
+	Balance        json.Number `bson:"balance" json:"balance"`
+}
@@ -0,0 +1,14 @@
+package dtos
+
+import "encoding/json"
+
+type AccountResponseDTO struct {
+	ID             string      `bson:"_id" json:"id"`
+	Name           string      `bson:"name" json:"name"`
+	Type           string      `bson:"type" json:"type"`
+	Currency       string      `bson:"currency" json:"currency"`
+	OpeningBalance json.Number `bson:"openingBalance" json:"openingBalance"`
+	Description    string      `bson:"description,omitempty" json:"description,omitempty"`
+	CreatedAt      string      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      string      `bson:"updatedAt" json:"updatedAt"`
+}
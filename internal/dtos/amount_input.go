@@ -0,0 +1,67 @@
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"myfin-api/internal/money"
+)
+
+// LocalizedAmountError reports an amount string that cannot be read in the
+// locale declared by amountLocale.
+type LocalizedAmountError struct {
+	Value    string
+	Locale   string
+	Currency string
+	// Normalized is the reading of an ambiguous value in Locale.
+	Normalized string
+	Err        error
+}
+
+func (e *LocalizedAmountError) Error() string {
+	return fmt.Sprintf("amount %q is not valid for locale %s: %v", e.Value, e.Locale, e.Err)
+}
+
+func (e *LocalizedAmountError) Unwrap() error {
+	return e.Err
+}
+
+// amountInput is an amount as sent by the client. JSON numbers are exact
+// already; strings may be written for the declared locale, e.g. "1.234,56"
+// or "R$ 50,00" for pt-BR.
+type amountInput struct {
+	value  string
+	quoted bool
+}
+
+func (a *amountInput) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		a.quoted = true
+		return json.Unmarshal(data, &a.value)
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	a.value = number.String()
+
+	return nil
+}
+
+// number returns the amount in the plain decimal form the services parse.
+// Strings are read in locale when it is one money supports; otherwise they
+// are kept as sent and the amount validation reports them. A currency symbol
+// in the string must match currency, when it is known.
+func (a amountInput) number(locale, currency string) (json.Number, error) {
+	if !a.quoted || !money.SupportsLocale(locale) {
+		return json.Number(a.value), nil
+	}
+
+	normalized, err := money.Normalize(a.value, locale, currency)
+	if err != nil {
+		return "", &LocalizedAmountError{Value: a.value, Locale: locale, Currency: currency, Normalized: normalized, Err: err}
+	}
+
+	return json.Number(normalized), nil
+}
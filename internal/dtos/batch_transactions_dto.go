@@ -0,0 +1,20 @@
+package dtos
+
+import "encoding/json"
+
+const MaxBatchOperations = 100
+
+type BatchTransactionsDTO struct {
+	Operations []BatchTransactionOperationDTO `json:"operations" binding:"required,min=1,max=100,dive"`
+	Atomic     bool                           `json:"-"`
+}
+
+type BatchTransactionOperationDTO struct {
+	Op               string                      `json:"op" binding:"required,oneof=create update delete"`
+	ID               string                      `json:"id" binding:"required_unless=Op create,omitempty,mongodb"`
+	Version          int64                       `json:"version" binding:"required_unless=Op create"`
+	Data             json.RawMessage             `json:"data" binding:"required_unless=Op delete"`
+	Create           *CreateTransactionsEntryDTO `json:"-"`
+	Update           *UpdateTransactionsEntryDTO `json:"-"`
+	ValidationErrors []ProblemFieldErrorDTO      `json:"-"`
+}
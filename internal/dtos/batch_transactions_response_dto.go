@@ -0,0 +1,22 @@
+package dtos
+
+type BatchTransactionResultDTO struct {
+	Index  int                           `json:"index"`
+	Op     string                        `json:"op"`
+	ID     string                        `json:"id,omitempty"`
+	Status int                           `json:"status"`
+	Data   *TransactionsEntryResponseDTO `json:"data,omitempty"`
+	Error  *ProblemDTO                   `json:"error,omitempty"`
+	Err    error                         `json:"-"`
+
+	// ValidationErrors carries the per-field errors of an operation rejected
+	// before reaching the database.
+	ValidationErrors []ProblemFieldErrorDTO `json:"-"`
+}
+
+type BatchTransactionsResponseDTO struct {
+	Atomic    bool                        `json:"atomic"`
+	Succeeded int                         `json:"succeeded"`
+	Failed    int                         `json:"failed"`
+	Results   []BatchTransactionResultDTO `json:"results"`
+}
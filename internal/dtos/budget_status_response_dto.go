@@ -0,0 +1,18 @@
+package dtos
+
+import "encoding/json"
+
+type BudgetStatusResponseDTO struct {
+	Month      string                    `bson:"month" json:"month"`
+	Categories []BudgetCategoryStatusDTO `bson:"categories" json:"categories"`
+}
+
+type BudgetCategoryStatusDTO struct {
+	BudgetID    string      `bson:"budgetId" json:"budgetId"`
+	Category    string      `bson:"category" json:"category"`
+	Currency    string      `bson:"currency" json:"currency"`
+	Limit       json.Number `bson:"limit" json:"limit"`
+	Spent       json.Number `bson:"spent" json:"spent"`
+	Remaining   json.Number `bson:"remaining" json:"remaining"`
+	PercentUsed float64     `bson:"percentUsed" json:"percentUsed"`
+}
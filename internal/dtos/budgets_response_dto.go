@@ -0,0 +1,13 @@
+package dtos
+
+import "encoding/json"
+
+type BudgetResponseDTO struct {
+	ID        string      `bson:"_id" json:"id"`
+	Category  string      `bson:"category" json:"category"`
+	Month     string      `bson:"month" json:"month"`
+	Limit     json.Number `bson:"limit" json:"limit"`
+	Currency  string      `bson:"currency" json:"currency"`
+	CreatedAt string      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt string      `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,8 @@
+package dtos
+
+type CashflowReportQueryDTO struct {
+	From     string `form:"from" binding:"omitempty,date"`
+	To       string `form:"to" binding:"omitempty,date"`
+	Interval string `form:"interval" binding:"omitempty,oneof=month week day"`
+	Filter   string `form:"filter" binding:"omitempty,max=1000"`
+}
@@ -0,0 +1,26 @@
+package dtos
+
+import "encoding/json"
+
+type CashflowReportResponseDTO struct {
+	From       string                      `bson:"from,omitempty" json:"from,omitempty"`
+	To         string                      `bson:"to,omitempty" json:"to,omitempty"`
+	Interval   string                      `bson:"interval" json:"interval"`
+	Filter     string                      `bson:"filter,omitempty" json:"filter,omitempty"`
+	Currencies []CashflowReportCurrencyDTO `bson:"currencies" json:"currencies"`
+}
+
+type CashflowReportCurrencyDTO struct {
+	Currency       string              `bson:"currency" json:"currency"`
+	OpeningBalance json.Number         `bson:"openingBalance" json:"openingBalance"`
+	Buckets        []CashflowBucketDTO `bson:"buckets" json:"buckets"`
+}
+
+type CashflowBucketDTO struct {
+	Start             string      `bson:"start" json:"start"`
+	End               string      `bson:"end" json:"end"`
+	IncomeAmount      json.Number `bson:"incomeAmount" json:"incomeAmount"`
+	ExpenseAmount     json.Number `bson:"expenseAmount" json:"expenseAmount"`
+	NetAmount         json.Number `bson:"netAmount" json:"netAmount"`
+	CumulativeBalance json.Number `bson:"cumulativeBalance" json:"cumulativeBalance"`
+}
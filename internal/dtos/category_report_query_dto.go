@@ -0,0 +1,9 @@
+package dtos
+
+type CategoryReportQueryDTO struct {
+	From   string `form:"from" binding:"omitempty,date"`
+	To     string `form:"to" binding:"omitempty,date"`
+	Type   string `form:"type" binding:"omitempty,oneof=income expense"`
+	Top    int    `form:"top" binding:"omitempty,min=1"`
+	Filter string `form:"filter" binding:"omitempty,max=1000"`
+}
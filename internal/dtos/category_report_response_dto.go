@@ -0,0 +1,26 @@
+package dtos
+
+import "encoding/json"
+
+type CategoryReportResponseDTO struct {
+	From       string                      `bson:"from,omitempty" json:"from,omitempty"`
+	To         string                      `bson:"to,omitempty" json:"to,omitempty"`
+	Type       string                      `bson:"type" json:"type"`
+	Filter     string                      `bson:"filter,omitempty" json:"filter,omitempty"`
+	Currencies []CategoryReportCurrencyDTO `bson:"currencies" json:"currencies"`
+}
+
+type CategoryReportCurrencyDTO struct {
+	Currency   string                  `bson:"currency" json:"currency"`
+	Total      json.Number             `bson:"total" json:"total"`
+	Count      int64                   `bson:"count" json:"count"`
+	Categories []CategoryReportItemDTO `bson:"categories" json:"categories"`
+}
+
+type CategoryReportItemDTO struct {
+	Category      string      `bson:"category" json:"category"`
+	Total         json.Number `bson:"total" json:"total"`
+	Count         int64       `bson:"count" json:"count"`
+	Share         float64     `bson:"share" json:"share"`
+	AverageTicket json.Number `bson:"averageTicket" json:"averageTicket"`
+}
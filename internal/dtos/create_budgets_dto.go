@@ -0,0 +1,10 @@
+package dtos
+
+import "encoding/json"
+
+type CreateBudgetDTO struct {
+	Category string      `json:"category" binding:"required,min=1"`
+	Month    string      `json:"month" binding:"required,datetime=2006-01"`
+	Limit    json.Number `json:"limit" binding:"required,money_positive=Currency"`
+	Currency string      `json:"currency" binding:"required,len=3"`
+}
@@ -0,0 +1,9 @@
+package dtos
+
+import "encoding/json"
+
+type CreateExchangeRateDTO struct {
+	BaseCurrency  string      `json:"base" binding:"required,len=3"`
+	QuoteCurrency string      `json:"quote" binding:"required,len=3,nefield=BaseCurrency"`
+	Rate          json.Number `json:"rate" binding:"required,exchange_rate"`
+}
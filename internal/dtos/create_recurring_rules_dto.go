@@ -0,0 +1,19 @@
+package dtos
+
+import "encoding/json"
+
+type CreateRecurringRuleDTO struct {
+	Amount         json.Number `json:"amount" binding:"required,money_positive=Currency"`
+	Title          string      `json:"title" binding:"required"`
+	Currency       string      `json:"currency" binding:"required,len=3"`
+	Type           string      `json:"type" binding:"required,oneof=income expense"`
+	Category       string      `json:"category" binding:"required,min=1"`
+	PaymentMethod  string      `json:"paymentMethod" binding:"required,min=1"`
+	Description    string      `json:"description" binding:"omitempty"`
+	AccountID      string      `json:"accountId" binding:"omitempty,mongodb"`
+	Frequency      string      `json:"frequency" binding:"required,oneof=daily weekly monthly yearly"`
+	Interval       int         `json:"interval" binding:"omitempty,min=1"`
+	StartDate      string      `json:"startDate" binding:"required,date"`
+	EndDate        string      `json:"endDate" binding:"omitempty,date,excluded_with=MaxOccurrences"`
+	MaxOccurrences int         `json:"occurrences" binding:"omitempty,min=1"`
+}
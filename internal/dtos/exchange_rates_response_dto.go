@@ -0,0 +1,12 @@
+package dtos
+
+import "encoding/json"
+
+type ExchangeRateResponseDTO struct {
+	ID            string      `bson:"_id" json:"id"`
+	BaseCurrency  string      `bson:"base" json:"base"`
+	QuoteCurrency string      `bson:"quote" json:"quote"`
+	Rate          json.Number `bson:"rate" json:"rate"`
+	CreatedAt     string      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     string      `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,20 @@
+package dtos
+
+import "slices"
+
+// IfMatchDTO is the precondition an If-Match header puts on a write: either
+// any current version ("*") or one of the listed versions.
+type IfMatchDTO struct {
+	Any      bool
+	Versions []int64
+}
+
+// IfMatchVersion requires exactly version, as batch operations carry it.
+func IfMatchVersion(version int64) IfMatchDTO {
+	return IfMatchDTO{Versions: []int64{version}}
+}
+
+// Matches reports whether a resource at version satisfies the precondition.
+func (m IfMatchDTO) Matches(version int64) bool {
+	return m.Any || slices.Contains(m.Versions, version)
+}
@@ -0,0 +1,50 @@
+package dtos
+
+import "encoding/json"
+
+type PatchTransactionsEntryDTO struct {
+	Amount        *json.Number `json:"amount" binding:"omitempty,money_positive=Currency"`
+	AmountLocale  string       `json:"amountLocale" binding:"omitempty,amount_locale"`
+	Title         *string      `json:"title" binding:"omitempty,min=1"`
+	Currency      *string      `json:"currency" binding:"omitempty,len=3"`
+	Type          *string      `json:"type" binding:"omitempty,oneof=income expense"`
+	Category      *string      `json:"category" binding:"omitempty,min=1"`
+	PaymentMethod *string      `json:"paymentMethod" binding:"omitempty,min=1"`
+	Description   *string      `json:"description" binding:"omitempty,min=1"`
+	Date          *string      `json:"date" binding:"omitempty,date"`
+	AccountID     *string      `json:"accountId" binding:"omitempty,mongodb"`
+	Remove        []string     `json:"-"`
+}
+
+// UnmarshalJSON reads amount as a JSON number or as a string written for
+// amountLocale.
+func (p *PatchTransactionsEntryDTO) UnmarshalJSON(data []byte) error {
+	type plain PatchTransactionsEntryDTO
+	body := struct {
+		*plain
+		Amount *amountInput `json:"amount"`
+	}{plain: (*plain)(p)}
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	if body.Amount == nil {
+		return nil
+	}
+
+	// Without a currency in the patch the entry's is not known here, so a
+	// symbol in the amount is not checked.
+	currency := ""
+	if p.Currency != nil {
+		currency = *p.Currency
+	}
+
+	amount, err := body.Amount.number(p.AmountLocale, currency)
+	if err != nil {
+		return err
+	}
+	p.Amount = &amount
+
+	return nil
+}
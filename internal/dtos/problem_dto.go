@@ -0,0 +1,49 @@
+package dtos
+
+import (
+	"net/http"
+
+	"myfin-api/internal/i18n"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	ProblemTypeBlank   = "about:blank"
+)
+
+// ProblemDTO is an RFC 7807 problem details document. Every error response
+// uses it so clients can read failures the same way across endpoints.
+type ProblemDTO struct {
+	Type   string                 `json:"type"`
+	Title  string                 `json:"title"`
+	Status int                    `json:"status"`
+	Detail string                 `json:"detail,omitempty"`
+	Errors []ProblemFieldErrorDTO `json:"errors,omitempty"`
+}
+
+// ProblemFieldErrorDTO locates one invalid part of the request: a JSON pointer
+// into the body, a query or path parameter, or a header.
+type ProblemFieldErrorDTO struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+// NewProblem builds a problem whose title is the reason phrase of status in
+// locale.
+func NewProblem(locale i18n.Locale, status int, detail string) ProblemDTO {
+	return ProblemDTO{
+		Type:   ProblemTypeBlank,
+		Title:  i18n.StatusText(locale, status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+func NewValidationProblem(locale i18n.Locale, errors []ProblemFieldErrorDTO) ProblemDTO {
+	problem := NewProblem(locale, http.StatusBadRequest, i18n.Message(locale, i18n.InvalidFields))
+	problem.Errors = errors
+
+	return problem
+}
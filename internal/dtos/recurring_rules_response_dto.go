@@ -0,0 +1,25 @@
+package dtos
+
+import "encoding/json"
+
+type RecurringRuleResponseDTO struct {
+	ID                   string      `bson:"_id" json:"id"`
+	Amount               json.Number `bson:"amount" json:"amount"`
+	Title                string      `bson:"title" json:"title"`
+	Currency             string      `bson:"currency" json:"currency"`
+	Type                 string      `bson:"type" json:"type"`
+	Category             string      `bson:"category" json:"category"`
+	PaymentMethod        string      `bson:"paymentMethod" json:"paymentMethod"`
+	Description          string      `bson:"description,omitempty" json:"description,omitempty"`
+	AccountID            string      `bson:"accountId,omitempty" json:"accountId,omitempty"`
+	Frequency            string      `bson:"frequency" json:"frequency"`
+	Interval             int         `bson:"interval" json:"interval"`
+	StartDate            string      `bson:"startDate" json:"startDate"`
+	EndDate              string      `bson:"endDate,omitempty" json:"endDate,omitempty"`
+	MaxOccurrences       int         `bson:"occurrences,omitempty" json:"occurrences,omitempty"`
+	OccurrencesGenerated int         `bson:"occurrencesGenerated" json:"occurrencesGenerated"`
+	NextRunAt            string      `bson:"nextRunAt,omitempty" json:"nextRunAt,omitempty"`
+	Active               bool        `bson:"active" json:"active"`
+	CreatedAt            string      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt            string      `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,16 @@
+package dtos
+
+type SavedViewResponseDTO struct {
+	ID        string                `bson:"_id" json:"id"`
+	Name      string                `bson:"name" json:"name"`
+	Filters   TransactionsFilterDTO `bson:"filters" json:"filters"`
+	Limit     int                   `bson:"limit" json:"limit"`
+	Skip      int                   `bson:"skip" json:"skip"`
+	CreatedAt string                `bson:"createdAt" json:"createdAt"`
+	UpdatedAt string                `bson:"updatedAt" json:"updatedAt"`
+}
+
+type SavedViewTransactionsDTO struct {
+	View SavedViewResponseDTO
+	Page TransactionsPageDTO
+}
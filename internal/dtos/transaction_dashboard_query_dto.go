@@ -0,0 +1,8 @@
+package dtos
+
+type TransactionDashboardQueryDTO struct {
+	Currency string `form:"currency" binding:"omitempty,len=3,alpha"`
+	From     string `form:"from" binding:"omitempty,date,excluded_with=Period"`
+	To       string `form:"to" binding:"omitempty,date,excluded_with=Period"`
+	Period   string `form:"period" binding:"omitempty,oneof=this-month last-month ytd"`
+}
@@ -1,7 +1,21 @@
 package dtos
 
+import "encoding/json"
+
 type TransactionDashboardResponseDTO struct {
-	IncomeAmount  float64 `bson:"incomeAmount" json:"incomeAmount"`
-	ExpenseAmount float64 `bson:"expenseAmount" json:"expenseAmount"`
-	TotalAmount   float64 `bson:"totalAmount" json:"totalAmount"`
+	From          string                      `bson:"from,omitempty" json:"from,omitempty"`
+	To            string                      `bson:"to,omitempty" json:"to,omitempty"`
+	Currency      string                      `bson:"currency,omitempty" json:"currency,omitempty"`
+	IncomeAmount  json.Number                 `bson:"incomeAmount,omitempty" json:"incomeAmount,omitempty"`
+	ExpenseAmount json.Number                 `bson:"expenseAmount,omitempty" json:"expenseAmount,omitempty"`
+	TotalAmount   json.Number                 `bson:"totalAmount,omitempty" json:"totalAmount,omitempty"`
+	Totals        []DashboardCurrencyTotalDTO `bson:"totals" json:"totals"`
+	Accounts      []AccountBalanceResponseDTO `bson:"accounts" json:"accounts"`
+}
+
+type DashboardCurrencyTotalDTO struct {
+	Currency      string      `bson:"currency" json:"currency"`
+	IncomeAmount  json.Number `bson:"incomeAmount" json:"incomeAmount"`
+	ExpenseAmount json.Number `bson:"expenseAmount" json:"expenseAmount"`
+	TotalAmount   json.Number `bson:"totalAmount" json:"totalAmount"`
 }
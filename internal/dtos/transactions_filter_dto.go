@@ -0,0 +1,27 @@
+package dtos
+
+import "encoding/json"
+
+var TransactionSortFields = map[string]string{
+	"amount":    "amount",
+	"category":  "category",
+	"createdAt": "created_at",
+	"date":      "date",
+	"title":     "title",
+}
+
+type TransactionsFilterDTO struct {
+	Q             string      `form:"q" json:"q" binding:"omitempty,max=200"`
+	Filter        string      `form:"filter" json:"filter" binding:"omitempty,max=1000"`
+	Title         string      `form:"title" json:"title"`
+	Category      string      `form:"category" json:"category"`
+	Type          string      `form:"type" json:"type" binding:"omitempty,oneof=income expense transfer"`
+	Currency      string      `form:"currency" json:"currency" binding:"omitempty,len=3,alpha"`
+	PaymentMethod string      `form:"paymentMethod" json:"paymentMethod"`
+	From          string      `form:"from" json:"from" binding:"omitempty,date"`
+	To            string      `form:"to" json:"to" binding:"omitempty,date"`
+	MinAmount     json.Number `form:"minAmount" json:"minAmount" binding:"omitempty,money=Currency"`
+	MaxAmount     json.Number `form:"maxAmount" json:"maxAmount" binding:"omitempty,money=Currency"`
+	Sort          string      `form:"sort" json:"sort" binding:"omitempty,transaction_sort"`
+	Cursor        string      `form:"cursor" json:"-" binding:"omitempty,excluded_with=Sort Q"`
+}
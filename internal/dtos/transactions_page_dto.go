@@ -0,0 +1,8 @@
+package dtos
+
+type TransactionsPageDTO struct {
+	Entries []TransactionsEntryResponseDTO
+	Total   int64
+	Next    string
+	Prev    string
+}
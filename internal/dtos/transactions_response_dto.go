@@ -1,16 +1,24 @@
 package dtos
 
+import "encoding/json"
+
 type TransactionsEntryResponseDTO struct {
-	ID            string  `bson:"_id" json:"id"`
-	Amount        float64 `bson:"amount" json:"amount"`
-	Title         string  `bson:"title" json:"title"`
-	Currency      string  `bson:"currency" json:"currency"`
-	Type          string  `bson:"type" json:"type"`
-	Category      string  `bson:"category" json:"category"`
-	PaymentMethod string  `bson:"paymentMethod" json:"paymentMethod"`
-	Description   string  `bson:"description,omitempty" json:"description,omitempty"`
-	Date          string  `bson:"date" json:"date"`
-	Timestamp     int64   `bson:"timestamp" json:"timestamp"`
-	CreatedAt     string  `bson:"createdAt" json:"createdAt"`
-	UpdatedAt     string  `bson:"updatedAt" json:"updatedAt"`
+	ID                  string      `bson:"_id" json:"id"`
+	Amount              json.Number `bson:"amount" json:"amount"`
+	AmountDisplay       string      `bson:"-" json:"amountDisplay,omitempty"`
+	Title               string      `bson:"title" json:"title"`
+	Currency            string      `bson:"currency" json:"currency"`
+	Type                string      `bson:"type" json:"type"`
+	Category            string      `bson:"category" json:"category"`
+	PaymentMethod       string      `bson:"paymentMethod" json:"paymentMethod"`
+	Description         string      `bson:"description,omitempty" json:"description,omitempty"`
+	Date                string      `bson:"date" json:"date"`
+	AccountID           string      `bson:"accountId,omitempty" json:"accountId,omitempty"`
+	TransferDirection   string      `bson:"transferDirection,omitempty" json:"transferDirection,omitempty"`
+	LinkedTransactionID string      `bson:"linkedTransactionId,omitempty" json:"linkedTransactionId,omitempty"`
+	RecurringRuleID     string      `bson:"recurringRuleId,omitempty" json:"recurringRuleId,omitempty"`
+	Timestamp           int64       `bson:"timestamp" json:"timestamp"`
+	CreatedAt           string      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt           string      `bson:"updatedAt" json:"updatedAt"`
+	Version             int64       `bson:"version" json:"version"`
 }
@@ -0,0 +1,7 @@
+package dtos
+
+type TransferResponseDTO struct {
+	ID       string                       `bson:"_id" json:"id"`
+	Outgoing TransactionsEntryResponseDTO `bson:"outgoing" json:"outgoing"`
+	Incoming TransactionsEntryResponseDTO `bson:"incoming" json:"incoming"`
+}
@@ -0,0 +1,11 @@
+package dtos
+
+import "encoding/json"
+
+type UpdateAccountDTO struct {
+	Name           string      `json:"name" binding:"required"`
+	Type           string      `json:"type" binding:"required,oneof=checking savings credit_card cash investment"`
+	Currency       string      `json:"currency" binding:"required,len=3"`
+	OpeningBalance json.Number `json:"openingBalance,omitempty" binding:"omitempty,money=Currency"`
+	Description    string      `json:"description" binding:"omitempty"`
+}
@@ -0,0 +1,8 @@
+package dtos
+
+type UpdateSavedViewDTO struct {
+	Name    string                `json:"name" binding:"required,min=1,max=100"`
+	Filters TransactionsFilterDTO `json:"filters"`
+	Limit   int                   `json:"limit" binding:"omitempty,min=1,max=100"`
+	Skip    int                   `json:"skip" binding:"min=0"`
+}
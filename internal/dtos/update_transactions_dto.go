@@ -1,12 +1,38 @@
 package dtos
 
+import "encoding/json"
+
 type UpdateTransactionsEntryDTO struct {
-	Amount        float64 `json:"amount" binding:"required,gt=0"`
-	Title         string  `json:"title" binding:"required"`
-	Currency      string  `json:"currency" binding:"required,len=3"`
-	Type          string  `json:"type" binding:"required,oneof=income expense"`
-	Category      string  `json:"category" binding:"required,min=1"`
-	PaymentMethod string  `json:"paymentMethod" binding:"required,min=1"`
-	Description   string  `json:"description" binding:"min=1"`
-	Date          string  `json:"date" binding:"required,datetime=02/01/2006"`
+	Amount        json.Number `json:"amount" binding:"required,money_positive=Currency"`
+	AmountLocale  string      `json:"amountLocale" binding:"omitempty,amount_locale"`
+	Title         string      `json:"title" binding:"required"`
+	Currency      string      `json:"currency" binding:"required,len=3"`
+	Type          string      `json:"type" binding:"required,oneof=income expense"`
+	Category      string      `json:"category" binding:"required,min=1"`
+	PaymentMethod string      `json:"paymentMethod" binding:"required,min=1"`
+	Description   string      `json:"description" binding:"min=1"`
+	Date          string      `json:"date" binding:"required,date"`
+	AccountID     string      `json:"accountId" binding:"omitempty,mongodb"`
+}
+
+// UnmarshalJSON reads amount as a JSON number or as a string written for
+// amountLocale.
+func (e *UpdateTransactionsEntryDTO) UnmarshalJSON(data []byte) error {
+	type plain UpdateTransactionsEntryDTO
+	body := struct {
+		*plain
+		Amount amountInput `json:"amount"`
+	}{plain: (*plain)(e)}
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	amount, err := body.Amount.number(e.AmountLocale, e.Currency)
+	if err != nil {
+		return err
+	}
+	e.Amount = amount
+
+	return nil
 }
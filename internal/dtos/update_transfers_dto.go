@@ -0,0 +1,12 @@
+package dtos
+
+import "encoding/json"
+
+type UpdateTransferDTO struct {
+	FromAccountID string      `json:"fromAccountId" binding:"required,mongodb"`
+	ToAccountID   string      `json:"toAccountId" binding:"required,mongodb,nefield=FromAccountID"`
+	Amount        json.Number `json:"amount" binding:"required,money_positive"`
+	Title         string      `json:"title" binding:"omitempty"`
+	Description   string      `json:"description" binding:"omitempty"`
+	Date          string      `json:"date" binding:"required,date"`
+}
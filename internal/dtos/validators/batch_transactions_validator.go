@@ -0,0 +1,89 @@
+package validators
+
+import (
+	"strconv"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidateBatchTransactions rejects the whole request only when the envelope is
+// malformed. Operations whose data fails validation are kept and carry their
+// ValidationErrors so they can be reported per item.
+func ValidateBatchTransactions(ctx *gin.Context) (*dtos.BatchTransactionsDTO, bool) {
+	var batch dtos.BatchTransactionsDTO
+
+	atomic, err := strconv.ParseBool(ctx.DefaultQuery("atomic", "false"))
+	if err != nil {
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: "atomic", Detail: i18n.Message(Locale(ctx), i18n.FieldBoolean)})
+		return nil, false
+	}
+	batch.Atomic = atomic
+
+	if err := ctx.ShouldBindJSON(&batch); err != nil {
+		writeBodyError(ctx, err, &batch, getBatchTransactionsValidationMessage)
+		return nil, false
+	}
+
+	locale := Locale(ctx)
+	for i := range batch.Operations {
+		operation := &batch.Operations[i]
+
+		switch operation.Op {
+		case "create":
+			var entry dtos.CreateTransactionsEntryDTO
+			if err := binding.JSON.BindBody(operation.Data, &entry); err != nil {
+				operation.ValidationErrors = batchOperationErrors(locale, err, &entry, i, getCreateTransactionsValidationMessage)
+				continue
+			}
+			operation.Create = &entry
+		case "update":
+			var entry dtos.UpdateTransactionsEntryDTO
+			if err := binding.JSON.BindBody(operation.Data, &entry); err != nil {
+				operation.ValidationErrors = batchOperationErrors(locale, err, &entry, i, getUpdateTransactionsValidationMessage)
+				continue
+			}
+			operation.Update = &entry
+		}
+	}
+
+	return &batch, true
+}
+
+// batchOperationErrors reports an invalid operation payload with pointers
+// into the request body, e.g. "/operations/2/data/amount".
+func batchOperationErrors(locale i18n.Locale, err error, target any, index int, message validationMessage) []dtos.ProblemFieldErrorDTO {
+	prefix := jsonPointer([]string{"operations", strconv.Itoa(index), "data"})
+	if fieldErrors := bodyFieldErrors(locale, err, target, prefix, message); fieldErrors != nil {
+		return fieldErrors
+	}
+
+	return []dtos.ProblemFieldErrorDTO{{Pointer: prefix, Detail: err.Error()}}
+}
+
+func getBatchTransactionsValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Field() {
+	case "Operations":
+		if fieldError.Tag() == "required" {
+			return i18n.Message(locale, i18n.FieldRequired)
+		}
+		return i18n.Message(locale, i18n.FieldBatchOperations, dtos.MaxBatchOperations)
+	case "Op":
+		return i18n.Message(locale, i18n.FieldBatchOp)
+	case "ID":
+		if fieldError.Tag() == "mongodb" {
+			return i18n.Message(locale, i18n.FieldValidID)
+		}
+		return i18n.Message(locale, i18n.FieldRequiredForUpdate)
+	case "Version":
+		return i18n.Message(locale, i18n.FieldRequiredForUpdate)
+	case "Data":
+		return i18n.Message(locale, i18n.FieldRequiredForCreate)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
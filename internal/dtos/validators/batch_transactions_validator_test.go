@@ -0,0 +1,169 @@
+package validators
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBatchTransactions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validCreate := `{"op":"create","data":{"amount":"10.00","title":"Coffee","currency":"BRL","type":"expense","category":"food","paymentMethod":"pix","date":"01/09/2025"}}`
+	validUpdate := `{"op":"update","id":"507f1f77bcf86cd799439011","version":2,"data":{"amount":"12.00","title":"Coffee","currency":"BRL","type":"expense","category":"food","paymentMethod":"pix","date":"01/09/2025"}}`
+	validDelete := `{"op":"delete","id":"507f1f77bcf86cd799439011","version":1}`
+
+	tests := []struct {
+		name           string
+		query          string
+		body           string
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name:           "Valid operations",
+			body:           `{"operations":[` + validCreate + `,` + validUpdate + `,` + validDelete + `]}`,
+			expectedResult: true,
+		},
+		{
+			name:           "Atomic mode",
+			query:          "?atomic=true",
+			body:           `{"operations":[` + validDelete + `]}`,
+			expectedResult: true,
+		},
+		{
+			name:           "Invalid atomic flag",
+			query:          "?atomic=maybe",
+			body:           `{"operations":[` + validDelete + `]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing operations",
+			body:           `{}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Empty operations",
+			body:           `{"operations":[]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Too many operations",
+			body:           `{"operations":[` + strings.TrimSuffix(strings.Repeat(validDelete+",", 101), ",") + `]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown op",
+			body:           `{"operations":[{"op":"upsert","id":"507f1f77bcf86cd799439011"}]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Delete without ID",
+			body:           `{"operations":[{"op":"delete"}]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Delete without version",
+			body:           `{"operations":[{"op":"delete","id":"507f1f77bcf86cd799439011"}]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid ID",
+			body:           `{"operations":[{"op":"delete","id":"123"}]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Create without data",
+			body:           `{"operations":[{"op":"create"}]}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid JSON",
+			body:           `{"operations":`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/transactions/batch"+tt.query, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			batch, result := ValidateBatchTransactions(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, batch)
+				assert.Equal(t, tt.query == "?atomic=true", batch.Atomic)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestValidateBatchTransactionsOperationData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := fmt.Sprintf(`{"operations":[%s,%s,%s,%s]}`,
+		`{"op":"create","data":{"amount":"10.00","title":"Coffee","currency":"BRL","type":"expense","category":"food","paymentMethod":"pix","date":"01/09/2025"}}`,
+		`{"op":"create","data":{"amount":"10.001","title":"Coffee","currency":"BRL","type":"transfer","category":"food","paymentMethod":"pix","date":"01-09-2025"}}`,
+		`{"op":"update","id":"507f1f77bcf86cd799439011","version":1,"data":"not an object"}`,
+		`{"op":"update","id":"507f1f77bcf86cd799439011","version":1,"data":{"amount":"2.500","amountLocale":"pt-BR","title":"Rent","currency":"BRL","type":"expense","category":"home","paymentMethod":"pix","description":"September","date":"01/09/2025"}}`,
+	)
+
+	req, _ := http.NewRequest(http.MethodPost, "/transactions/batch", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	batch, result := ValidateBatchTransactions(ctx)
+
+	assert.True(t, result)
+	assert.Len(t, batch.Operations, 4)
+
+	assert.NotNil(t, batch.Operations[0].Create)
+	assert.Nil(t, batch.Operations[0].ValidationErrors)
+
+	assert.Nil(t, batch.Operations[1].Create)
+	assert.Contains(t, batch.Operations[1].ValidationErrors, dtos.ProblemFieldErrorDTO{Pointer: "/operations/1/data/amount", Detail: "Must be a positive amount with no more decimal places than the currency allows"})
+	assert.Contains(t, batch.Operations[1].ValidationErrors, dtos.ProblemFieldErrorDTO{Pointer: "/operations/1/data/type", Detail: "Must be either 'income' or 'expense'"})
+	assert.Contains(t, batch.Operations[1].ValidationErrors, dtos.ProblemFieldErrorDTO{Pointer: "/operations/1/data/date", Detail: "Date must be in DD/MM/YYYY or ISO 8601 (YYYY-MM-DD) format (e.g., 31/12/2025 or 2025-12-31)"})
+
+	assert.Nil(t, batch.Operations[2].Update)
+	assert.Len(t, batch.Operations[2].ValidationErrors, 1)
+	assert.Equal(t, "/operations/2/data", batch.Operations[2].ValidationErrors[0].Pointer)
+
+	assert.Nil(t, batch.Operations[3].Update)
+	assert.Equal(t, []dtos.ProblemFieldErrorDTO{{
+		Pointer: "/operations/3/data/amount",
+		Detail:  "Amount 2.500 is ambiguous; write it with decimal places (2.500,00) or without the thousands separator (2500)",
+	}}, batch.Operations[3].ValidationErrors)
+}
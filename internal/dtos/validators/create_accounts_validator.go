@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateAccount(ctx *gin.Context) (*dtos.CreateAccountDTO, bool) {
+	var account dtos.CreateAccountDTO
+
+	if err := ctx.ShouldBindJSON(&account); err != nil {
+		writeBodyError(ctx, err, &account, getAccountValidationMessage)
+		return nil, false
+	}
+
+	return &account, true
+}
+
+func getAccountValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "len":
+		return i18n.Message(locale, i18n.FieldThreeCharacters)
+	case "money":
+		return i18n.Message(locale, i18n.FieldAmount)
+	case "oneof":
+		return i18n.Message(locale, i18n.FieldAccountType)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
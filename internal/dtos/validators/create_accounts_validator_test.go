@@ -0,0 +1,107 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateAccount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "Valid request",
+			requestBody: map[string]interface{}{
+				"name":           "Nubank",
+				"type":           "checking",
+				"currency":       "BRL",
+				"openingBalance": 150.25,
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Negative opening balance",
+			requestBody: map[string]interface{}{
+				"name":           "Credit card",
+				"type":           "credit_card",
+				"currency":       "BRL",
+				"openingBalance": -300,
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Missing name",
+			requestBody: map[string]interface{}{
+				"type":     "checking",
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid type",
+			requestBody: map[string]interface{}{
+				"name":     "Nubank",
+				"type":     "crypto",
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid currency length",
+			requestBody: map[string]interface{}{
+				"name":     "Nubank",
+				"type":     "checking",
+				"currency": "REAL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid JSON",
+			requestBody: map[string]interface{}{
+				"openingBalance": "not-a-number",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPost, "/accounts", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			account, result := ValidateCreateAccount(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, account)
+				assert.Equal(t, tt.requestBody["name"], account.Name)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateBudget(ctx *gin.Context) (*dtos.CreateBudgetDTO, bool) {
+	var budget dtos.CreateBudgetDTO
+
+	if err := ctx.ShouldBindJSON(&budget); err != nil {
+		writeBodyError(ctx, err, &budget, getBudgetValidationMessage)
+		return nil, false
+	}
+
+	return &budget, true
+}
+
+func ValidateBudgetMonth(ctx *gin.Context) (string, bool) {
+	month := ctx.Param("month")
+	if !isValidBudgetMonth(month) {
+		writeInvalidBudgetMonth(ctx)
+		return "", false
+	}
+
+	return month, true
+}
+
+func ValidateGetAllBudgets(ctx *gin.Context) (string, bool) {
+	month := ctx.Query("month")
+	if month != "" && !isValidBudgetMonth(month) {
+		writeInvalidBudgetMonth(ctx)
+		return "", false
+	}
+
+	return month, true
+}
+
+func isValidBudgetMonth(month string) bool {
+	_, err := time.Parse("2006-01", month)
+	return err == nil
+}
+
+func writeInvalidBudgetMonth(ctx *gin.Context) {
+	writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: "month", Detail: i18n.Message(Locale(ctx), i18n.FieldMonthParameter)})
+}
+
+func getBudgetValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "min":
+		return i18n.Message(locale, i18n.FieldNotEmpty)
+	case "datetime":
+		return i18n.Message(locale, i18n.FieldMonth)
+	case "money_positive":
+		return i18n.Message(locale, i18n.FieldPositiveAmount)
+	case "len":
+		return i18n.Message(locale, i18n.FieldThreeCharacters)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
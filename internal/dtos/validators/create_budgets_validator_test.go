@@ -0,0 +1,167 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateBudget(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "Valid request",
+			requestBody: map[string]interface{}{
+				"category": "Food",
+				"month":    "2025-09",
+				"limit":    800.00,
+				"currency": "BRL",
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Missing category",
+			requestBody: map[string]interface{}{
+				"month":    "2025-09",
+				"limit":    800.00,
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid month format",
+			requestBody: map[string]interface{}{
+				"category": "Food",
+				"month":    "09/2025",
+				"limit":    800.00,
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Zero limit",
+			requestBody: map[string]interface{}{
+				"category": "Food",
+				"month":    "2025-09",
+				"limit":    0,
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid JSON",
+			requestBody: map[string]interface{}{
+				"limit": "not-a-number",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			budget, result := ValidateCreateBudget(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, budget)
+				assert.Equal(t, tt.requestBody["category"], budget.Category)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestValidateBudgetMonth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		month          string
+		expectedResult bool
+	}{
+		{name: "Valid month", month: "2025-09", expectedResult: true},
+		{name: "Invalid month number", month: "2025-13", expectedResult: false},
+		{name: "Wrong format", month: "09-2025", expectedResult: false},
+		{name: "Empty month", month: "", expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/budgets/"+tt.month+"/status", nil)
+			ctx.Params = []gin.Param{{Key: "month", Value: tt.month}}
+
+			month, result := ValidateBudgetMonth(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.Equal(t, tt.month, month)
+			} else {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func TestValidateGetAllBudgets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedMonth  string
+		expectedResult bool
+	}{
+		{name: "No month filter", query: "", expectedMonth: "", expectedResult: true},
+		{name: "Valid month filter", query: "?month=2025-09", expectedMonth: "2025-09", expectedResult: true},
+		{name: "Invalid month filter", query: "?month=september", expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/budgets"+tt.query, nil)
+
+			month, result := ValidateGetAllBudgets(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedMonth, month)
+
+			if !tt.expectedResult {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateExchangeRate(ctx *gin.Context) (*dtos.CreateExchangeRateDTO, bool) {
+	var exchangeRate dtos.CreateExchangeRateDTO
+
+	if err := ctx.ShouldBindJSON(&exchangeRate); err != nil {
+		writeBodyError(ctx, err, &exchangeRate, getExchangeRateValidationMessage)
+		return nil, false
+	}
+
+	return &exchangeRate, true
+}
+
+func getExchangeRateValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "len":
+		return i18n.Message(locale, i18n.FieldThreeCharacters)
+	case "nefield":
+		return i18n.Message(locale, i18n.FieldQuoteCurrency)
+	case "exchange_rate":
+		return i18n.Message(locale, i18n.FieldPositiveRate)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
@@ -0,0 +1,99 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateExchangeRate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		requestBody     map[string]interface{}
+		expectedResult  bool
+		expectedDetails map[string]string
+	}{
+		{
+			name: "Valid request",
+			requestBody: map[string]interface{}{
+				"base":  "USD",
+				"quote": "BRL",
+				"rate":  5.4321,
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Same currencies",
+			requestBody: map[string]interface{}{
+				"base":  "USD",
+				"quote": "USD",
+				"rate":  1,
+			},
+			expectedResult:  false,
+			expectedDetails: map[string]string{"/quote": "Quote currency must differ from base currency"},
+		},
+		{
+			name: "Zero rate",
+			requestBody: map[string]interface{}{
+				"base":  "USD",
+				"quote": "BRL",
+				"rate":  0,
+			},
+			expectedResult:  false,
+			expectedDetails: map[string]string{"/rate": "Must be a positive decimal number"},
+		},
+		{
+			name: "Negative rate",
+			requestBody: map[string]interface{}{
+				"base":  "USD",
+				"quote": "BRL",
+				"rate":  -5,
+			},
+			expectedResult:  false,
+			expectedDetails: map[string]string{"/rate": "Must be a positive decimal number"},
+		},
+		{
+			name: "Missing base",
+			requestBody: map[string]interface{}{
+				"quote": "BRL",
+				"rate":  5,
+			},
+			expectedResult:  false,
+			expectedDetails: map[string]string{"/base": "This field is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPost, "/exchange-rates", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			exchangeRate, result := ValidateCreateExchangeRate(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, exchangeRate)
+				assert.Equal(t, "5.4321", exchangeRate.Rate.String())
+				return
+			}
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			assert.Equal(t, tt.expectedDetails, problemFieldDetails(t, w.Body.Bytes()))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"reflect"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateRecurringRule(ctx *gin.Context) (*dtos.CreateRecurringRuleDTO, bool) {
+	var rule dtos.CreateRecurringRuleDTO
+
+	if err := ctx.ShouldBindJSON(&rule); err != nil {
+		writeBodyError(ctx, err, &rule, getRecurringRuleValidationMessage)
+		return nil, false
+	}
+
+	return &rule, true
+}
+
+func getRecurringRuleValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "money_positive":
+		return i18n.Message(locale, i18n.FieldPositiveAmount)
+	case "len":
+		return i18n.Message(locale, i18n.FieldThreeCharacters)
+	case "min":
+		if fieldError.Kind() == reflect.Int {
+			return i18n.Message(locale, i18n.FieldAtLeastOne)
+		}
+		return i18n.Message(locale, i18n.FieldTooShort)
+	case "oneof":
+		if fieldError.Field() == "Frequency" {
+			return i18n.Message(locale, i18n.FieldFrequency)
+		}
+		return i18n.Message(locale, i18n.FieldIncomeOrExpense)
+	case "date":
+		return i18n.Message(locale, i18n.FieldDateExample)
+	case "excluded_with":
+		return i18n.Message(locale, i18n.FieldEndDateOrOccurrences)
+	case "mongodb":
+		return i18n.Message(locale, i18n.FieldValidID)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
@@ -0,0 +1,119 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateRecurringRule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validRule := func() map[string]interface{} {
+		return map[string]interface{}{
+			"amount":        1500.00,
+			"title":         "Rent",
+			"currency":      "BRL",
+			"type":          "expense",
+			"category":      "Housing",
+			"paymentMethod": "pix",
+			"frequency":     "monthly",
+			"startDate":     "05/01/2025",
+		}
+	}
+
+	tests := []struct {
+		name           string
+		modify         func(body map[string]interface{})
+		expectedResult bool
+		expectedField  string
+	}{
+		{
+			name:           "Valid open-ended rule",
+			modify:         func(body map[string]interface{}) {},
+			expectedResult: true,
+		},
+		{
+			name: "Valid rule with end date",
+			modify: func(body map[string]interface{}) {
+				body["endDate"] = "05/12/2025"
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Valid rule with occurrences",
+			modify: func(body map[string]interface{}) {
+				body["occurrences"] = 12
+				body["interval"] = 2
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Invalid frequency",
+			modify: func(body map[string]interface{}) {
+				body["frequency"] = "hourly"
+			},
+			expectedResult: false,
+			expectedField:  "/frequency",
+		},
+		{
+			name: "End date and occurrences together",
+			modify: func(body map[string]interface{}) {
+				body["endDate"] = "05/12/2025"
+				body["occurrences"] = 12
+			},
+			expectedResult: false,
+			expectedField:  "/endDate",
+		},
+		{
+			name: "Invalid start date",
+			modify: func(body map[string]interface{}) {
+				body["startDate"] = "05-01-2025"
+			},
+			expectedResult: false,
+			expectedField:  "/startDate",
+		},
+		{
+			name: "Negative interval",
+			modify: func(body map[string]interface{}) {
+				body["interval"] = -1
+			},
+			expectedResult: false,
+			expectedField:  "/interval",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := validRule()
+			tt.modify(body)
+
+			jsonData, _ := json.Marshal(body)
+			req, _ := http.NewRequest(http.MethodPost, "/recurring-rules", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			rule, result := ValidateCreateRecurringRule(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, rule)
+				assert.Equal(t, "Rent", rule.Title)
+			} else {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+
+				assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), tt.expectedField)
+			}
+		})
+	}
+}
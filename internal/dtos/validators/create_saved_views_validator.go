@@ -0,0 +1,36 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateSavedView(ctx *gin.Context) (*dtos.CreateSavedViewDTO, bool) {
+	var view dtos.CreateSavedViewDTO
+
+	if err := ctx.ShouldBindJSON(&view); err != nil {
+		writeBodyError(ctx, err, &view, getSavedViewValidationMessage)
+		return nil, false
+	}
+
+	return &view, true
+}
+
+func getSavedViewValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Field() {
+	case "Name":
+		if fieldError.Tag() == "required" {
+			return i18n.Message(locale, i18n.FieldRequired)
+		}
+		return i18n.Message(locale, i18n.FieldNameLength)
+	case "Limit":
+		return i18n.Message(locale, i18n.FieldPageLimit)
+	case "Skip":
+		return i18n.Message(locale, i18n.FieldNotNegative)
+	default:
+		return getTransactionsFilterValidationMessage(locale, fieldError)
+	}
+}
@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateSavedView(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedField  string
+	}{
+		{
+			name: "Valid request",
+			requestBody: map[string]interface{}{
+				"name": "Food this year",
+				"filters": map[string]interface{}{
+					"category":  "food,bars",
+					"type":      "expense",
+					"from":      "01/01/2025",
+					"minAmount": "10.50",
+					"sort":      "-amount",
+				},
+				"limit": 20,
+			},
+			expectedResult: true,
+		},
+		{
+			name:           "Missing name",
+			requestBody:    map[string]interface{}{"limit": 20},
+			expectedResult: false,
+			expectedField:  "/name",
+		},
+		{
+			name:           "Limit above maximum",
+			requestBody:    map[string]interface{}{"name": "Food", "limit": 500},
+			expectedResult: false,
+			expectedField:  "/limit",
+		},
+		{
+			name:           "Negative skip",
+			requestBody:    map[string]interface{}{"name": "Food", "skip": -1},
+			expectedResult: false,
+			expectedField:  "/skip",
+		},
+		{
+			name: "Invalid filter type",
+			requestBody: map[string]interface{}{
+				"name":    "Refunds",
+				"filters": map[string]interface{}{"type": "refund"},
+			},
+			expectedResult: false,
+			expectedField:  "/filters/type",
+		},
+		{
+			name: "Unknown sort field",
+			requestBody: map[string]interface{}{
+				"name":    "By description",
+				"filters": map[string]interface{}{"sort": "description"},
+			},
+			expectedResult: false,
+			expectedField:  "/filters/sort",
+		},
+		{
+			name: "Too many decimals for currency",
+			requestBody: map[string]interface{}{
+				"name":    "Yen",
+				"filters": map[string]interface{}{"currency": "JPY", "maxAmount": "10.5"},
+			},
+			expectedResult: false,
+			expectedField:  "/filters/maxAmount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPost, "/views", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			view, result := ValidateCreateSavedView(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, view)
+				assert.Equal(t, tt.requestBody["name"], view.Name)
+				assert.Equal(t, "food,bars", view.Filters.Category)
+				assert.Equal(t, json.Number("10.50"), view.Filters.MinAmount)
+			} else {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+
+				assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), tt.expectedField)
+			}
+		})
+	}
+}
@@ -1,9 +1,8 @@
 package validators
 
 import (
-	"net/http"
-
 	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -13,43 +12,32 @@ func ValidateCreateTransactionsEntry(ctx *gin.Context) (*dtos.CreateTransactions
 	var entry dtos.CreateTransactionsEntryDTO
 
 	if err := ctx.ShouldBindJSON(&entry); err != nil {
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			errors := make(map[string]string)
-			for _, fieldError := range validationErrors {
-				errors[fieldError.Field()] = getCreateTransactionsValidationMessage(fieldError)
-			}
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error":   "Validation failed",
-				"details": errors,
-			})
-			return nil, false
-		}
-
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid JSON format",
-			"details": err.Error(),
-		})
+		writeBodyError(ctx, err, &entry, getCreateTransactionsValidationMessage)
 		return nil, false
 	}
 
 	return &entry, true
 }
 
-func getCreateTransactionsValidationMessage(fieldError validator.FieldError) string {
+func getCreateTransactionsValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
 	switch fieldError.Tag() {
 	case "required":
-		return "This field is required"
-	case "gt":
-		return "Value must be greater than 0"
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "money_positive":
+		return i18n.Message(locale, i18n.FieldPositiveAmount)
+	case "amount_locale":
+		return i18n.Message(locale, i18n.FieldAmountLocale)
 	case "len":
-		return "Must be exactly 3 characters"
+		return i18n.Message(locale, i18n.FieldThreeCharacters)
 	case "min":
-		return "Value is too short"
+		return i18n.Message(locale, i18n.FieldTooShort)
 	case "oneof":
-		return "Must be either 'income' or 'expense'"
-	case "datetime":
-		return "Date must be in DD/MM/YYYY format (e.g., 31/12/2025)"
+		return i18n.Message(locale, i18n.FieldIncomeOrExpense)
+	case "date":
+		return i18n.Message(locale, i18n.FieldDateExample)
+	case "mongodb":
+		return i18n.Message(locale, i18n.FieldValidID)
 	default:
-		return "Invalid value"
+		return i18n.Message(locale, i18n.FieldInvalid)
 	}
 }
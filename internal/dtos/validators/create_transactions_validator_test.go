@@ -7,6 +7,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"myfin-api/internal/i18n"
+
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +38,20 @@ func TestValidateCreateTransactionsEntry(t *testing.T) {
 			expectedResult: true,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "Amount with more decimals than the currency allows",
+			requestBody: map[string]interface{}{
+				"amount":        100.5,
+				"title":         "Test Entry",
+				"currency":      "JPY",
+				"type":          "income",
+				"category":      "Salary",
+				"paymentMethod": "Credit Card",
+				"date":          "01/01/2023",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Invalid amount",
 			requestBody: map[string]interface{}{
@@ -126,7 +142,7 @@ func TestValidateCreateTransactionsEntry(t *testing.T) {
 				"type":          "income",
 				"category":      "Salary",
 				"paymentMethod": "Credit Card",
-				"date":          "2023-01-01",
+				"date":          "01-01-2023",
 			},
 			expectedResult: false,
 			expectedStatus: http.StatusBadRequest,
@@ -167,7 +183,8 @@ func TestValidateCreateTransactionsEntry(t *testing.T) {
 
 				if entry != nil {
 					if val, ok := tt.requestBody["amount"].(float64); ok {
-						assert.Equal(t, val, entry.Amount)
+						amount, _ := entry.Amount.Float64()
+						assert.Equal(t, val, amount)
 					}
 					if val, ok := tt.requestBody["title"].(string); ok {
 						assert.Equal(t, val, entry.Title)
@@ -186,18 +203,159 @@ func TestValidateCreateTransactionsEntry(t *testing.T) {
 	}
 }
 
+func TestValidateCreateTransactionsEntryLocalised(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jsonData, _ := json.Marshal(map[string]interface{}{
+		"amount":        "10.00",
+		"currency":      "BRL",
+		"type":          "refund",
+		"category":      "Food",
+		"paymentMethod": "Pix",
+		"date":          "31-01-2025",
+	})
+	req, _ := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "pt-BR")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	_, result := ValidateCreateTransactionsEntry(ctx)
+
+	assert.False(t, result)
+	assert.Equal(t, "pt-BR", w.Header().Get("Content-Language"))
+	assert.Equal(t, map[string]string{
+		"/title": "Este campo é obrigatório",
+		"/type":  "Deve ser 'income' ou 'expense'",
+		"/date":  "A data deve estar no formato DD/MM/AAAA ou ISO 8601 (AAAA-MM-DD) (ex.: 31/12/2025 ou 2025-12-31)",
+	}, problemFieldDetails(t, w.Body.Bytes()))
+}
+
+func TestValidateCreateTransactionsEntryLocalizedAmount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		amount         interface{}
+		amountLocale   string
+		acceptLanguage string
+		expectedAmount json.Number
+		expectedErrors map[string]string
+	}{
+		{name: "Brazilian grouping", amount: "1.234,56", amountLocale: "pt-BR", expectedAmount: "1234.56"},
+		{name: "Currency symbol", amount: "R$ 50,00", amountLocale: "pt-BR", expectedAmount: "50.00"},
+		{name: "English grouping", amount: "1,234.56", amountLocale: "en", expectedAmount: "1234.56"},
+		{name: "JSON number ignores locale", amount: 1234.5, amountLocale: "pt-BR", expectedAmount: "1234.5"},
+		{name: "Plain string without locale", amount: "10.50", expectedAmount: "10.50"},
+		{
+			name:           "Ambiguous thousands separator",
+			amount:         "1.234",
+			amountLocale:   "pt-BR",
+			expectedErrors: map[string]string{"/amount": "Amount 1.234 is ambiguous; write it with decimal places (1.234,00) or without the thousands separator (1234)"},
+		},
+		{
+			name:           "Ambiguous in Portuguese",
+			amount:         "1.234",
+			amountLocale:   "pt-BR",
+			acceptLanguage: "pt-BR",
+			expectedErrors: map[string]string{"/amount": "O valor 1.234 é ambíguo; escreva-o com casas decimais (1.234,00) ou sem o separador de milhar (1234)"},
+		},
+		{
+			name:           "Ambiguous decimal separator",
+			amount:         "1,234",
+			amountLocale:   "pt-BR",
+			expectedErrors: map[string]string{"/amount": "Amount 1,234 is ambiguous; write it with another decimal place (1,2340) or, for a whole number, without the separator (1234)"},
+		},
+		{
+			name:           "Other currency's symbol",
+			amount:         "US$ 50,00",
+			amountLocale:   "pt-BR",
+			expectedErrors: map[string]string{"/amount": "Amount US$ 50,00 is written in another currency than BRL"},
+		},
+		{
+			name:           "Other currency's symbol in Portuguese",
+			amount:         "€ 50,00",
+			amountLocale:   "pt-BR",
+			acceptLanguage: "pt-BR",
+			expectedErrors: map[string]string{"/amount": "O valor € 50,00 está escrito em outra moeda que não BRL"},
+		},
+		{
+			name:           "Other locale's decimal separator",
+			amount:         "10.50",
+			amountLocale:   "pt-BR",
+			expectedErrors: map[string]string{"/amount": "Must be an amount written for pt-BR, e.g. 1.234,56"},
+		},
+		{
+			name:           "Localized amount without locale",
+			amount:         "1.234,56",
+			expectedErrors: map[string]string{"/amount": "Must be a positive amount with no more decimal places than the currency allows"},
+		},
+		{
+			name:           "Unsupported locale",
+			amount:         "1.234,56",
+			amountLocale:   "fr-FR",
+			expectedErrors: map[string]string{"/amount": "Must be a positive amount with no more decimal places than the currency allows", "/amountLocale": "Amount locale must be one of: pt-BR, en"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := map[string]interface{}{
+				"amount":        tt.amount,
+				"title":         "Groceries",
+				"currency":      "BRL",
+				"type":          "expense",
+				"category":      "food",
+				"paymentMethod": "pix",
+				"date":          "31/01/2025",
+			}
+			if tt.amountLocale != "" {
+				body["amountLocale"] = tt.amountLocale
+			}
+
+			jsonData, _ := json.Marshal(body)
+			req, _ := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			entry, result := ValidateCreateTransactionsEntry(ctx)
+
+			if tt.expectedErrors != nil {
+				assert.False(t, result)
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				assert.Equal(t, tt.expectedErrors, problemFieldDetails(t, w.Body.Bytes()))
+				return
+			}
+
+			assert.True(t, result)
+			assert.Equal(t, tt.expectedAmount, entry.Amount)
+			assert.Equal(t, "Groceries", entry.Title)
+		})
+	}
+}
+
 func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 	validate := validator.New()
+	validate.RegisterValidation("money_positive", validateMoneyPositive)
+	validate.RegisterValidation("date", validateDate)
 
 	type TestStruct struct {
-		Amount        float64 `validate:"required,gt=0"`
-		Title         string  `validate:"required"`
-		Currency      string  `validate:"required,len=3"`
-		Type          string  `validate:"required,oneof=income expense"`
-		Category      string  `validate:"min=1"`
-		PaymentMethod string  `validate:"required,min=1"`
-		Description   string  `validate:"min=1,lt=4"`
-		Date          string  `validate:"required,datetime=02/01/2006"`
+		Amount        json.Number `validate:"required,money_positive=Currency"`
+		Title         string      `validate:"required"`
+		Currency      string      `validate:"required,len=3"`
+		Type          string      `validate:"required,oneof=income expense"`
+		Category      string      `validate:"min=1"`
+		PaymentMethod string      `validate:"required,min=1"`
+		Description   string      `validate:"min=1,lt=4"`
+		Date          string      `validate:"required,date"`
 	}
 
 	tests := []struct {
@@ -210,7 +368,7 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Required field missing",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Currency:      "USD",
 				Type:          "income",
 				Category:      "Salary",
@@ -224,7 +382,7 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Amount not greater than zero",
 			testStruct: TestStruct{
-				Amount:        -1,
+				Amount:        "-1",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -233,13 +391,13 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 				Date:          "01/01/2023",
 			},
 			expectedField:  "Amount",
-			expectedTag:    "gt",
-			expectedErrMsg: "Value must be greater than 0",
+			expectedTag:    "money_positive",
+			expectedErrMsg: "Must be a positive amount with no more decimal places than the currency allows",
 		},
 		{
 			name: "Currency not 3 characters",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "US",
 				Type:          "income",
@@ -254,7 +412,7 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Invalid type value",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "invalid",
@@ -269,7 +427,7 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Category too short",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -285,22 +443,22 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Invalid date format",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
 				Category:      "Salary",
 				PaymentMethod: "Credit Card",
-				Date:          "2023-01-01",
+				Date:          "01-01-2023",
 			},
 			expectedField:  "Date",
-			expectedTag:    "datetime",
-			expectedErrMsg: "Date must be in DD/MM/YYYY format (e.g., 31/12/2025)",
+			expectedTag:    "date",
+			expectedErrMsg: "Date must be in DD/MM/YYYY or ISO 8601 (YYYY-MM-DD) format (e.g., 31/12/2025 or 2025-12-31)",
 		},
 		{
 			name: "Invalid field",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -322,7 +480,7 @@ func TestGetCreateTransactionsValidationMessage(t *testing.T) {
 				validationErrors := err.(validator.ValidationErrors)
 				for _, fieldError := range validationErrors {
 					if fieldError.Field() == tt.expectedField && fieldError.Tag() == tt.expectedTag {
-						message := getCreateTransactionsValidationMessage(fieldError)
+						message := getCreateTransactionsValidationMessage(i18n.English, fieldError)
 						assert.Equal(t, tt.expectedErrMsg, message)
 						return
 					}
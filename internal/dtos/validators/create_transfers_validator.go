@@ -0,0 +1,37 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateCreateTransfer(ctx *gin.Context) (*dtos.CreateTransferDTO, bool) {
+	var transfer dtos.CreateTransferDTO
+
+	if err := ctx.ShouldBindJSON(&transfer); err != nil {
+		writeBodyError(ctx, err, &transfer, getTransferValidationMessage)
+		return nil, false
+	}
+
+	return &transfer, true
+}
+
+func getTransferValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return i18n.Message(locale, i18n.FieldRequired)
+	case "money_positive":
+		return i18n.Message(locale, i18n.FieldPositiveAmount)
+	case "mongodb":
+		return i18n.Message(locale, i18n.FieldValidID)
+	case "nefield":
+		return i18n.Message(locale, i18n.FieldDestinationAccount)
+	case "date":
+		return i18n.Message(locale, i18n.FieldDateExample)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
@@ -0,0 +1,93 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateTransfer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedField  string
+	}{
+		{
+			name: "Valid request",
+			requestBody: map[string]interface{}{
+				"fromAccountId": "650000000000000000000001",
+				"toAccountId":   "650000000000000000000002",
+				"amount":        100.0,
+				"date":          "10/09/2025",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Same account",
+			requestBody: map[string]interface{}{
+				"fromAccountId": "650000000000000000000001",
+				"toAccountId":   "650000000000000000000001",
+				"amount":        100.0,
+				"date":          "10/09/2025",
+			},
+			expectedResult: false,
+			expectedField:  "/toAccountId",
+		},
+		{
+			name: "Invalid account ID",
+			requestBody: map[string]interface{}{
+				"fromAccountId": "checking",
+				"toAccountId":   "650000000000000000000002",
+				"amount":        100.0,
+				"date":          "10/09/2025",
+			},
+			expectedResult: false,
+			expectedField:  "/fromAccountId",
+		},
+		{
+			name: "Zero amount",
+			requestBody: map[string]interface{}{
+				"fromAccountId": "650000000000000000000001",
+				"toAccountId":   "650000000000000000000002",
+				"amount":        0,
+				"date":          "10/09/2025",
+			},
+			expectedResult: false,
+			expectedField:  "/amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPost, "/transfers", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			transfer, result := ValidateCreateTransfer(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, transfer)
+				return
+			}
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), tt.expectedField)
+		})
+	}
+}
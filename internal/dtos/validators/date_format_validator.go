@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"strings"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	DateFormatParameter     = "dateFormat"
+	PreferHeader            = "Prefer"
+	PreferenceAppliedHeader = "Preference-Applied"
+
+	dateFormatPreference = "date-format"
+)
+
+// ValidateDateFormat returns the layout dates are written in for this request.
+// The dateFormat query parameter takes precedence over a date-format
+// preference in the Prefer header. Without either, dates keep the DD/MM/YYYY
+// format existing clients expect. Unknown preferences are ignored, as RFC 7240
+// asks, but an unknown dateFormat parameter is rejected.
+func ValidateDateFormat(ctx *gin.Context) (string, bool) {
+	if format, ok := ctx.GetQuery(DateFormatParameter); ok {
+		layout, ok := dates.Layout(format)
+		if !ok {
+			writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: DateFormatParameter, Detail: i18n.Message(Locale(ctx), i18n.FieldDateFormat)})
+			return "", false
+		}
+
+		return layout, true
+	}
+
+	for _, header := range ctx.Request.Header.Values(PreferHeader) {
+		for _, preference := range strings.Split(header, ",") {
+			name, value, _ := strings.Cut(strings.SplitN(preference, ";", 2)[0], "=")
+			if !strings.EqualFold(strings.TrimSpace(name), dateFormatPreference) {
+				continue
+			}
+
+			format := strings.Trim(strings.TrimSpace(value), `"`)
+			if layout, ok := dates.Layout(format); ok {
+				ctx.Header(PreferenceAppliedHeader, dateFormatPreference+"="+strings.ToLower(format))
+				return layout, true
+			}
+		}
+	}
+
+	return dates.DefaultLayout, true
+}
@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dates"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDateFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name              string
+		query             string
+		prefer            string
+		expectedResult    bool
+		expectedLayout    string
+		expectedPreferred string
+	}{
+		{name: "Default", expectedResult: true, expectedLayout: dates.DefaultLayout},
+		{name: "ISO parameter", query: "?dateFormat=iso", expectedResult: true, expectedLayout: dates.ISOLayout},
+		{name: "Brazilian parameter", query: "?dateFormat=BR", expectedResult: true, expectedLayout: dates.BrazilianLayout},
+		{name: "Unknown parameter", query: "?dateFormat=us", expectedResult: false},
+		{name: "Empty parameter", query: "?dateFormat=", expectedResult: false},
+		{name: "Prefer header", prefer: "date-format=iso", expectedResult: true, expectedLayout: dates.ISOLayout, expectedPreferred: "date-format=iso"},
+		{name: "Prefer among other preferences", prefer: `return=minimal, date-format="ISO"; strict`, expectedResult: true, expectedLayout: dates.ISOLayout, expectedPreferred: "date-format=iso"},
+		{name: "Unknown preference is ignored", prefer: "date-format=us", expectedResult: true, expectedLayout: dates.DefaultLayout},
+		{name: "Parameter wins over Prefer", query: "?dateFormat=br", prefer: "date-format=iso", expectedResult: true, expectedLayout: dates.BrazilianLayout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/transactions"+tt.query, nil)
+			if tt.prefer != "" {
+				ctx.Request.Header.Set(PreferHeader, tt.prefer)
+			}
+
+			layout, result := ValidateDateFormat(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedPreferred, w.Header().Get(PreferenceAppliedHeader))
+			if tt.expectedResult {
+				assert.Equal(t, tt.expectedLayout, layout)
+				return
+			}
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, map[string]string{DateFormatParameter: "Date format must be one of: br, iso"}, problemFieldDetails(t, w.Body.Bytes()))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"myfin-api/internal/dates"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func init() {
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterValidation("date", validateDate)
+	}
+}
+
+func validateDate(fieldLevel validator.FieldLevel) bool {
+	_, err := dates.Parse(fieldLevel.Field().String())
+	return err == nil
+}
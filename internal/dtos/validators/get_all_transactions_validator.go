@@ -1,35 +1,98 @@
 package validators
 
 import (
-	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 )
 
+func init() {
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterValidation("transaction_sort", validateTransactionSort)
+	}
+}
+
 func ValidateGetAllPaginationParams(ctx *gin.Context) (int, int, bool) {
 	limitStr := ctx.DefaultQuery("limit", "10")
 	skipStr := ctx.DefaultQuery("skip", "0")
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid limit parameter",
-			"details": "Limit must be a valid integer",
-		})
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: "limit", Detail: i18n.Message(Locale(ctx), i18n.FieldLimitInteger)})
 
 		return 0, 0, false
 	}
 
 	skip, err := strconv.Atoi(skipStr)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid skip parameter",
-			"details": "Skip must be a valid integer",
-		})
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: "skip", Detail: i18n.Message(Locale(ctx), i18n.FieldSkipInteger)})
 
 		return 0, 0, false
 	}
 
 	return limit, skip, true
 }
+
+func ValidateGetAllTransactionsFilters(ctx *gin.Context) (*dtos.TransactionsFilterDTO, bool) {
+	var filter dtos.TransactionsFilterDTO
+
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		writeQueryError(ctx, err, &filter, getTransactionsFilterValidationMessage)
+		return nil, false
+	}
+
+	return &filter, true
+}
+
+func getTransactionsFilterValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "oneof":
+		return i18n.Message(locale, i18n.FieldFilterTypes)
+	case "len", "alpha":
+		return i18n.Message(locale, i18n.FieldCurrencyCode)
+	case "date":
+		return i18n.Message(locale, i18n.FieldDate)
+	case "money":
+		return i18n.Message(locale, i18n.FieldFilterAmount)
+	case "excluded_with":
+		return i18n.Message(locale, i18n.FieldCursorExclusive)
+	case "max":
+		return i18n.Message(locale, i18n.FieldMaxCharacters, fieldError.Param())
+	case "transaction_sort":
+		return i18n.Message(locale, i18n.FieldSort, strings.Join(sortableTransactionFields(), ", "))
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
+
+func validateTransactionSort(fieldLevel validator.FieldLevel) bool {
+	seen := make(map[string]bool)
+
+	for _, field := range strings.Split(fieldLevel.Field().String(), ",") {
+		field = strings.TrimPrefix(strings.TrimSpace(field), "-")
+
+		if _, ok := dtos.TransactionSortFields[field]; !ok || seen[field] {
+			return false
+		}
+		seen[field] = true
+	}
+
+	return true
+}
+
+func sortableTransactionFields() []string {
+	fields := make([]string, 0, len(dtos.TransactionSortFields))
+	for field := range dtos.TransactionSortFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"myfin-api/internal/dtos"
+
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 )
@@ -71,11 +74,7 @@ func TestValidateGetAllPaginationParams(t *testing.T) {
 		assert.False(t, isValid)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, "Invalid limit parameter", response["error"])
-		assert.Equal(t, "Limit must be a valid integer", response["details"])
+		assert.Equal(t, map[string]string{"limit": "Limit must be a valid integer"}, problemFieldDetails(t, w.Body.Bytes()))
 	})
 
 	t.Run("invalid_skip", func(t *testing.T) {
@@ -88,11 +87,7 @@ func TestValidateGetAllPaginationParams(t *testing.T) {
 		assert.False(t, isValid)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, "Invalid skip parameter", response["error"])
-		assert.Equal(t, "Skip must be a valid integer", response["details"])
+		assert.Equal(t, map[string]string{"skip": "Skip must be a valid integer"}, problemFieldDetails(t, w.Body.Bytes()))
 	})
 
 	t.Run("both_parameters_invalid", func(t *testing.T) {
@@ -105,10 +100,7 @@ func TestValidateGetAllPaginationParams(t *testing.T) {
 		assert.False(t, isValid)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, "Invalid limit parameter", response["error"])
+		assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), "limit")
 	})
 
 	t.Run("large_integer_values", func(t *testing.T) {
@@ -133,10 +125,7 @@ func TestValidateGetAllPaginationParams(t *testing.T) {
 		assert.False(t, isValid)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, "Invalid limit parameter", response["error"])
+		assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), "limit")
 	})
 
 	t.Run("empty_parameter_values", func(t *testing.T) {
@@ -149,9 +138,70 @@ func TestValidateGetAllPaginationParams(t *testing.T) {
 		assert.False(t, isValid)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, "Invalid limit parameter", response["error"])
+		assert.Contains(t, problemFieldDetails(t, w.Body.Bytes()), "limit")
 	})
 }
+
+func TestValidateGetAllTransactionsFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedFilter *dtos.TransactionsFilterDTO
+		expectedResult bool
+	}{
+		{name: "No filters", query: "", expectedFilter: &dtos.TransactionsFilterDTO{}, expectedResult: true},
+		{
+			name:  "All filters",
+			query: "?title=lunch&category=food,transport&type=expense&currency=BRL&paymentMethod=pix&from=01/09/2025&to=30/09/2025&minAmount=10.50&maxAmount=200",
+			expectedFilter: &dtos.TransactionsFilterDTO{
+				Title:         "lunch",
+				Category:      "food,transport",
+				Type:          "expense",
+				Currency:      "BRL",
+				PaymentMethod: "pix",
+				From:          "01/09/2025",
+				To:            "30/09/2025",
+				MinAmount:     json.Number("10.50"),
+				MaxAmount:     json.Number("200"),
+			},
+			expectedResult: true,
+		},
+		{name: "Unknown type", query: "?type=refund", expectedResult: false},
+		{name: "Invalid currency", query: "?currency=REAL", expectedResult: false},
+		{name: "ISO 8601 dates", query: "?from=2025-09-01&to=2025-09-30T23:59:59-03:00", expectedFilter: &dtos.TransactionsFilterDTO{From: "2025-09-01", To: "2025-09-30T23:59:59-03:00"}, expectedResult: true},
+		{name: "Invalid date", query: "?from=2025/09/01", expectedResult: false},
+		{name: "Invalid amount", query: "?minAmount=ten", expectedResult: false},
+		{name: "Too many decimals for currency", query: "?currency=JPY&maxAmount=10.5", expectedResult: false},
+		{name: "Sort fields", query: "?sort=amount,-date,title", expectedFilter: &dtos.TransactionsFilterDTO{Sort: "amount,-date,title"}, expectedResult: true},
+		{name: "Unknown sort field", query: "?sort=-description", expectedResult: false},
+		{name: "Repeated sort field", query: "?sort=date,-date", expectedResult: false},
+		{name: "Empty sort field", query: "?sort=amount,,date", expectedResult: false},
+		{name: "Cursor", query: "?cursor=abc123", expectedFilter: &dtos.TransactionsFilterDTO{Cursor: "abc123"}, expectedResult: true},
+		{name: "Cursor with sort", query: "?cursor=abc123&sort=amount", expectedResult: false},
+		{name: "Search", query: "?q=padaria+sao+joao", expectedFilter: &dtos.TransactionsFilterDTO{Q: "padaria sao joao"}, expectedResult: true},
+		{name: "Cursor with search", query: "?cursor=abc123&q=padaria", expectedResult: false},
+		{name: "Search too long", query: "?q=" + strings.Repeat("a", 201), expectedResult: false},
+		{name: "Filter expression", query: "?filter=amount+%3E+100+and+category+in+(food,+bars)", expectedFilter: &dtos.TransactionsFilterDTO{Filter: "amount > 100 and category in (food, bars)"}, expectedResult: true},
+		{name: "Filter too long", query: "?filter=" + strings.Repeat("a", 1001), expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/transactions"+tt.query, nil)
+
+			filter, result := ValidateGetAllTransactionsFilters(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedFilter, filter)
+
+			if !tt.expectedResult {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
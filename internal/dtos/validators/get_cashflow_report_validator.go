@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateGetCashflowReport(ctx *gin.Context) (*dtos.CashflowReportQueryDTO, bool) {
+	var query dtos.CashflowReportQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		writeQueryError(ctx, err, &query, getCashflowReportValidationMessage)
+		return nil, false
+	}
+
+	return &query, true
+}
+
+func getCashflowReportValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "date":
+		return i18n.Message(locale, i18n.FieldDate)
+	case "oneof":
+		return i18n.Message(locale, i18n.FieldCashflowInterval)
+	case "max":
+		return i18n.Message(locale, i18n.FieldFilterSize)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
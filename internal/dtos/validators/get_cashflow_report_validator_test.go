@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGetCashflowReport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedQuery  *dtos.CashflowReportQueryDTO
+		expectedResult bool
+	}{
+		{name: "No parameters", query: "", expectedQuery: &dtos.CashflowReportQueryDTO{}, expectedResult: true},
+		{name: "All parameters", query: "?from=01/01/2025&to=31/03/2025&interval=week", expectedQuery: &dtos.CashflowReportQueryDTO{From: "01/01/2025", To: "31/03/2025", Interval: "week"}, expectedResult: true},
+		{name: "Invalid date", query: "?from=2025/01/01", expectedResult: false},
+		{name: "Unknown interval", query: "?interval=year", expectedResult: false},
+		{name: "Filter expression", query: "?filter=type+%3D+expense", expectedQuery: &dtos.CashflowReportQueryDTO{Filter: "type = expense"}, expectedResult: true},
+		{name: "Filter too long", query: "?filter=" + strings.Repeat("a", 1001), expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/reports/cashflow"+tt.query, nil)
+
+			query, result := ValidateGetCashflowReport(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedQuery, query)
+
+			if !tt.expectedResult {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateGetCategoryReport(ctx *gin.Context) (*dtos.CategoryReportQueryDTO, bool) {
+	var query dtos.CategoryReportQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		writeQueryError(ctx, err, &query, getCategoryReportValidationMessage)
+		return nil, false
+	}
+
+	return &query, true
+}
+
+func getCategoryReportValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "date":
+		return i18n.Message(locale, i18n.FieldDate)
+	case "oneof":
+		return i18n.Message(locale, i18n.FieldReportTypes)
+	case "min":
+		return i18n.Message(locale, i18n.FieldTopPositive)
+	case "max":
+		return i18n.Message(locale, i18n.FieldFilterSize)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGetCategoryReport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedQuery  *dtos.CategoryReportQueryDTO
+		expectedResult bool
+	}{
+		{name: "No parameters", query: "", expectedQuery: &dtos.CategoryReportQueryDTO{}, expectedResult: true},
+		{name: "All parameters", query: "?from=01/09/2025&to=30/09/2025&type=income&top=5", expectedQuery: &dtos.CategoryReportQueryDTO{From: "01/09/2025", To: "30/09/2025", Type: "income", Top: 5}, expectedResult: true},
+		{name: "Invalid date", query: "?to=2025/09/30", expectedResult: false},
+		{name: "Transfer type", query: "?type=transfer", expectedResult: false},
+		{name: "Negative top", query: "?top=-1", expectedResult: false},
+		{name: "Non numeric top", query: "?top=five", expectedResult: false},
+		{name: "Filter expression", query: "?filter=category+!%3D+rent", expectedQuery: &dtos.CategoryReportQueryDTO{Filter: "category != rent"}, expectedResult: true},
+		{name: "Filter too long", query: "?filter=" + strings.Repeat("a", 1001), expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/reports/categories"+tt.query, nil)
+
+			query, result := ValidateGetCategoryReport(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedQuery, query)
+
+			if !tt.expectedResult {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
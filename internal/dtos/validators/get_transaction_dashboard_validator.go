@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateGetTransactionDashboard(ctx *gin.Context) (*dtos.TransactionDashboardQueryDTO, bool) {
+	var query dtos.TransactionDashboardQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		writeQueryError(ctx, err, &query, getTransactionDashboardValidationMessage)
+		return nil, false
+	}
+
+	return &query, true
+}
+
+func getTransactionDashboardValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "len", "alpha":
+		return i18n.Message(locale, i18n.FieldCurrencyCode)
+	case "date":
+		return i18n.Message(locale, i18n.FieldDate)
+	case "excluded_with":
+		return i18n.Message(locale, i18n.FieldExcludedWithPeriod)
+	case "oneof":
+		return i18n.Message(locale, i18n.FieldDashboardPeriod)
+	default:
+		return i18n.Message(locale, i18n.FieldInvalid)
+	}
+}
@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGetTransactionDashboard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedQuery  *dtos.TransactionDashboardQueryDTO
+		expectedResult bool
+	}{
+		{name: "No parameters", query: "", expectedQuery: &dtos.TransactionDashboardQueryDTO{}, expectedResult: true},
+		{name: "Valid currency", query: "?currency=BRL", expectedQuery: &dtos.TransactionDashboardQueryDTO{Currency: "BRL"}, expectedResult: true},
+		{name: "Lowercase currency", query: "?currency=usd", expectedQuery: &dtos.TransactionDashboardQueryDTO{Currency: "usd"}, expectedResult: true},
+		{name: "Too long", query: "?currency=EURO", expectedResult: false},
+		{name: "Digits", query: "?currency=U5D", expectedResult: false},
+		{name: "Date range", query: "?from=01/09/2025&to=30/09/2025", expectedQuery: &dtos.TransactionDashboardQueryDTO{From: "01/09/2025", To: "30/09/2025"}, expectedResult: true},
+		{name: "Only from", query: "?from=01/09/2025", expectedQuery: &dtos.TransactionDashboardQueryDTO{From: "01/09/2025"}, expectedResult: true},
+		{name: "Invalid date format", query: "?from=2025/09/01", expectedResult: false},
+		{name: "Period", query: "?period=last-month&currency=BRL", expectedQuery: &dtos.TransactionDashboardQueryDTO{Period: "last-month", Currency: "BRL"}, expectedResult: true},
+		{name: "Unknown period", query: "?period=last-week", expectedResult: false},
+		{name: "Period with dates", query: "?period=ytd&from=01/09/2025", expectedResult: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodGet, "/transactions/dashboard"+tt.query, nil)
+
+			query, result := ValidateGetTransactionDashboard(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedQuery, query)
+
+			if !tt.expectedResult {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
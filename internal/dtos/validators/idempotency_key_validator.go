@@ -0,0 +1,29 @@
+package validators
+
+import (
+	"strconv"
+	"strings"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
+)
+
+// ValidateIdempotencyKey returns the trimmed Idempotency-Key header. An absent
+// header is valid and yields an empty key.
+func ValidateIdempotencyKey(ctx *gin.Context) (string, bool) {
+	key := strings.TrimSpace(ctx.GetHeader(IdempotencyKeyHeader))
+
+	if len(key) > maxIdempotencyKeyLength {
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Header: IdempotencyKeyHeader, Detail: i18n.Message(Locale(ctx), i18n.FieldMaxCharacters, strconv.Itoa(maxIdempotencyKeyLength))})
+		return "", false
+	}
+
+	return key, true
+}
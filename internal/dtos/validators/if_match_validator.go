@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const IfMatchHeader = "If-Match"
+
+// ValidateIfMatch returns the precondition carried by the If-Match header.
+// Writes without it are refused so they cannot overwrite changes the client
+// has not seen. Well-formed tags that can never match, such as weak ones,
+// are kept out of the precondition so the write fails with 412 rather than
+// being reported as malformed.
+func ValidateIfMatch(ctx *gin.Context) (dtos.IfMatchDTO, bool) {
+	header := strings.TrimSpace(ctx.GetHeader(IfMatchHeader))
+	if header == "" {
+		WriteMessageProblem(ctx, http.StatusPreconditionRequired, i18n.IfMatchRequired)
+		return dtos.IfMatchDTO{}, false
+	}
+
+	ifMatch, ok := parseIfMatch(header)
+	if !ok {
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Header: IfMatchHeader, Detail: i18n.Message(Locale(ctx), i18n.FieldIfMatch)})
+		return dtos.IfMatchDTO{}, false
+	}
+
+	return ifMatch, true
+}
+
+// parseIfMatch reads "*" or a comma-separated list of entity tags (RFC 9110,
+// section 13.1.1).
+func parseIfMatch(header string) (dtos.IfMatchDTO, bool) {
+	if header == "*" {
+		return dtos.IfMatchDTO{Any: true}, true
+	}
+
+	var ifMatch dtos.IfMatchDTO
+	var tags int
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		tags++
+
+		opaque, weak, ok := parseEntityTag(tag)
+		if !ok {
+			return dtos.IfMatchDTO{}, false
+		}
+
+		// If-Match uses the strong comparison, so a weak tag never matches,
+		// and neither does a tag that is not one of our version numbers.
+		version, err := strconv.ParseInt(opaque, 10, 64)
+		if !weak && err == nil && version > 0 {
+			ifMatch.Versions = append(ifMatch.Versions, version)
+		}
+	}
+
+	return ifMatch, tags > 0
+}
+
+// parseEntityTag splits a well-formed entity tag into its opaque part and
+// whether it is weak.
+func parseEntityTag(tag string) (string, bool, bool) {
+	weak := strings.HasPrefix(tag, "W/")
+	tag = strings.TrimPrefix(tag, "W/")
+
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return "", false, false
+	}
+
+	opaque := tag[1 : len(tag)-1]
+	if strings.ContainsFunc(opaque, func(char rune) bool { return char == '"' || char < 0x21 || char == 0x7f }) {
+		return "", false, false
+	}
+
+	return opaque, weak, true
+}
@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		header          string
+		expectedResult  bool
+		expectedIfMatch dtos.IfMatchDTO
+		expectedStatus  int
+	}{
+		{
+			name:            "Valid ETag",
+			header:          `"3"`,
+			expectedResult:  true,
+			expectedIfMatch: dtos.IfMatchDTO{Versions: []int64{3}},
+		},
+		{
+			name:            "List of ETags",
+			header:          `"2", "3"`,
+			expectedResult:  true,
+			expectedIfMatch: dtos.IfMatchDTO{Versions: []int64{2, 3}},
+		},
+		{
+			name:            "Wildcard",
+			header:          "*",
+			expectedResult:  true,
+			expectedIfMatch: dtos.IfMatchDTO{Any: true},
+		},
+		{
+			name:           "Weak ETag never matches",
+			header:         `W/"3"`,
+			expectedResult: true,
+		},
+		{
+			name:            "Weak ETag in a list",
+			header:          `W/"2", "3"`,
+			expectedResult:  true,
+			expectedIfMatch: dtos.IfMatchDTO{Versions: []int64{3}},
+		},
+		{
+			name:           "Zero version never matches",
+			header:         `"0"`,
+			expectedResult: true,
+		},
+		{
+			name:           "Foreign ETag never matches",
+			header:         `"abc"`,
+			expectedResult: true,
+		},
+		{
+			name:           "Missing header",
+			header:         "",
+			expectedResult: false,
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:           "Unquoted ETag",
+			header:         "3",
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Malformed ETag in a list",
+			header:         `"2", 3`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Empty list",
+			header:         ", ,",
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodDelete, "/transactions/123", nil)
+			if tt.header != "" {
+				req.Header.Set(IfMatchHeader, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+
+			ifMatch, result := ValidateIfMatch(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.Equal(t, tt.expectedIfMatch, ifMatch)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package validators
+
+import (
+	"reflect"
+
+	"myfin-api/internal/money"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func init() {
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterValidation("money", validateMoney)
+		engine.RegisterValidation("money_positive", validateMoneyPositive)
+		engine.RegisterValidation("exchange_rate", validateExchangeRate)
+		engine.RegisterValidation("amount_locale", validateAmountLocale)
+	}
+}
+
+func validateMoney(fieldLevel validator.FieldLevel) bool {
+	_, ok := parseMoneyField(fieldLevel)
+	return ok
+}
+
+func validateMoneyPositive(fieldLevel validator.FieldLevel) bool {
+	amount, ok := parseMoneyField(fieldLevel)
+	return ok && amount > 0
+}
+
+func validateExchangeRate(fieldLevel validator.FieldLevel) bool {
+	_, err := money.ParseRate(fieldLevel.Field().String())
+	return err == nil
+}
+
+func validateAmountLocale(fieldLevel validator.FieldLevel) bool {
+	return money.SupportsLocale(fieldLevel.Field().String())
+}
+
+func parseMoneyField(fieldLevel validator.FieldLevel) (int64, bool) {
+	decimals := money.MaxDecimals
+
+	if currencyField := fieldLevel.Param(); currencyField != "" {
+		currency := reflect.Indirect(reflect.Indirect(fieldLevel.Parent()).FieldByName(currencyField))
+		if currency.IsValid() && currency.Kind() == reflect.String && currency.String() != "" {
+			decimals = money.Decimals(currency.String())
+		}
+	}
+
+	amount, err := money.ParseWithDecimals(fieldLevel.Field().String(), decimals)
+	return amount, err == nil
+}
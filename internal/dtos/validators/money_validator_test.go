@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyValidation(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterValidation("money", validateMoney)
+	validate.RegisterValidation("money_positive", validateMoneyPositive)
+
+	type TestStruct struct {
+		Currency string
+		Balance  json.Number `validate:"omitempty,money=Currency"`
+		Amount   json.Number `validate:"required,money_positive=Currency"`
+		Transfer json.Number `validate:"omitempty,money_positive"`
+	}
+
+	tests := []struct {
+		name          string
+		testStruct    TestStruct
+		expectedValid bool
+		expectedField string
+	}{
+		{name: "Valid BRL amount", testStruct: TestStruct{Currency: "BRL", Amount: "10.99"}, expectedValid: true},
+		{name: "Valid JPY amount", testStruct: TestStruct{Currency: "JPY", Amount: "1500"}, expectedValid: true},
+		{name: "Valid KWD amount", testStruct: TestStruct{Currency: "KWD", Amount: "1.125"}, expectedValid: true},
+		{name: "Negative balance allowed", testStruct: TestStruct{Currency: "BRL", Balance: "-300.50", Amount: "1"}, expectedValid: true},
+		{name: "Too many decimals for BRL", testStruct: TestStruct{Currency: "BRL", Amount: "10.005"}, expectedField: "Amount"},
+		{name: "Decimals on JPY", testStruct: TestStruct{Currency: "JPY", Amount: "100.5"}, expectedField: "Amount"},
+		{name: "Zero amount", testStruct: TestStruct{Currency: "BRL", Amount: "0"}, expectedField: "Amount"},
+		{name: "Negative amount", testStruct: TestStruct{Currency: "BRL", Amount: "-5"}, expectedField: "Amount"},
+		{name: "Balance with too many decimals", testStruct: TestStruct{Currency: "BRL", Balance: "1.001", Amount: "1"}, expectedField: "Balance"},
+		{name: "Without currency allows three decimals", testStruct: TestStruct{Currency: "BRL", Amount: "1", Transfer: "1.125"}, expectedValid: true},
+		{name: "Without currency rejects four decimals", testStruct: TestStruct{Currency: "BRL", Amount: "1", Transfer: "1.1255"}, expectedField: "Transfer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Struct(tt.testStruct)
+
+			if tt.expectedValid {
+				assert.NoError(t, err)
+				return
+			}
+
+			validationErrors, ok := err.(validator.ValidationErrors)
+			assert.True(t, ok)
+			assert.Len(t, validationErrors, 1)
+			assert.Equal(t, tt.expectedField, validationErrors[0].Field())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package validators
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"sort"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const MergePatchContentType = "application/merge-patch+json"
+
+// patchableTransactionFields maps each JSON member accepted by PATCH /transactions/:id
+// to whether it may be removed with a null value.
+var patchableTransactionFields = map[string]bool{
+	"amount":        false,
+	"amountLocale":  false,
+	"title":         false,
+	"currency":      false,
+	"type":          false,
+	"category":      false,
+	"paymentMethod": false,
+	"description":   true,
+	"date":          false,
+	"accountId":     true,
+}
+
+func ValidatePatchTransactionsEntry(ctx *gin.Context) (*dtos.PatchTransactionsEntryDTO, string, bool) {
+	var patch dtos.PatchTransactionsEntryDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(ctx.ContentType()); err != nil || (mediaType != MergePatchContentType && mediaType != binding.MIMEJSON) {
+		WriteMessageProblem(ctx, http.StatusUnsupportedMediaType, i18n.UnsupportedPatchContentType, MergePatchContentType, binding.MIMEJSON)
+		return nil, "", false
+	}
+
+	body, err := ctx.GetRawData()
+	if err != nil {
+		WriteMessageProblem(ctx, http.StatusBadRequest, i18n.UnreadableBody, err.Error())
+		return nil, "", false
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		WriteMessageProblem(ctx, http.StatusBadRequest, i18n.PatchNotObject)
+		return nil, "", false
+	}
+
+	if len(members) == 0 {
+		WriteMessageProblem(ctx, http.StatusBadRequest, i18n.PatchEmpty)
+		return nil, "", false
+	}
+
+	for _, name := range sortedMemberNames(members) {
+		nullable, ok := patchableTransactionFields[name]
+		if !ok {
+			writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Pointer: jsonPointer([]string{name}), Detail: i18n.Message(Locale(ctx), i18n.FieldNotPatchable)})
+			return nil, "", false
+		}
+
+		if string(members[name]) != "null" {
+			continue
+		}
+
+		if !nullable {
+			writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Pointer: jsonPointer([]string{name}), Detail: i18n.Message(Locale(ctx), i18n.FieldNotRemovable)})
+			return nil, "", false
+		}
+
+		patch.Remove = append(patch.Remove, name)
+	}
+
+	if err := binding.JSON.BindBody(body, &patch); err != nil {
+		writeBodyError(ctx, err, &patch, getCreateTransactionsValidationMessage)
+		return nil, "", false
+	}
+
+	return &patch, id, true
+}
+
+func sortedMemberNames(members map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
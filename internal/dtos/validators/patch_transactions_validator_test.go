@@ -0,0 +1,174 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePatchTransactionsEntry(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		contentType    string
+		body           string
+		expectedResult bool
+		expectedStatus int
+		expectedRemove []string
+		expectedAmount json.Number
+	}{
+		{
+			name:           "Valid merge patch",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"title":"Groceries","amount":"12.50"}`,
+			expectedResult: true,
+		},
+		{
+			name:           "Valid plain JSON",
+			id:             "123",
+			contentType:    "application/json; charset=utf-8",
+			body:           `{"category":"food"}`,
+			expectedResult: true,
+		},
+		{
+			name:           "Null removes optional field",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"description":null,"accountId":null}`,
+			expectedResult: true,
+			expectedRemove: []string{"accountId", "description"},
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			contentType:    MergePatchContentType,
+			body:           `{"title":"Groceries"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unsupported content type",
+			id:             "123",
+			contentType:    "text/plain",
+			body:           `{"title":"Groceries"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:           "Not an object",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `["title"]`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Empty patch",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown field",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"createdAt":"01/01/2023"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Null on required field",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"title":null}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid type",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"type":"transfer"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid amount",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"amount":"0"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid date",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"date":"01-01-2023"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Localized amount",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"amount":"R$ 1.234,50","amountLocale":"pt-BR"}`,
+			expectedResult: true,
+			expectedAmount: "1234.50",
+		},
+		{
+			name:           "Ambiguous localized amount",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"amount":"1.234","amountLocale":"pt-BR"}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Null amount locale",
+			id:             "123",
+			contentType:    MergePatchContentType,
+			body:           `{"amount":"12.50","amountLocale":null}`,
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPatch, "/transactions/"+tt.id, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			patch, id, result := ValidatePatchTransactionsEntry(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, patch)
+				assert.Equal(t, tt.id, id)
+				assert.Equal(t, tt.expectedRemove, patch.Remove)
+				if tt.expectedAmount != "" {
+					assert.Equal(t, tt.expectedAmount, *patch.Amount)
+				}
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
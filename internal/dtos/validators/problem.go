@@ -0,0 +1,212 @@
+package validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/money"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// validationMessage describes a failed validation rule in the given locale.
+type validationMessage func(locale i18n.Locale, fieldError validator.FieldError) string
+
+// Locale is the language negotiated for the request from its Accept-Language
+// header.
+func Locale(ctx *gin.Context) i18n.Locale {
+	return i18n.Negotiate(ctx.GetHeader("Accept-Language"))
+}
+
+// WriteProblem sends problem as the response body with the problem+json
+// media type.
+func WriteProblem(ctx *gin.Context, problem dtos.ProblemDTO) {
+	ctx.Header("Content-Type", dtos.ProblemContentType)
+	ctx.Header("Content-Language", string(Locale(ctx)))
+	ctx.JSON(problem.Status, problem)
+}
+
+// WriteMessageProblem sends a problem whose detail is the message for code in
+// the request locale.
+func WriteMessageProblem(ctx *gin.Context, status int, code i18n.Code, args ...any) {
+	locale := Locale(ctx)
+	WriteProblem(ctx, dtos.NewProblem(locale, status, i18n.Message(locale, code, args...)))
+}
+
+func writeValidationProblem(ctx *gin.Context, fieldErrors ...dtos.ProblemFieldErrorDTO) {
+	WriteProblem(ctx, dtos.NewValidationProblem(Locale(ctx), fieldErrors))
+}
+
+// ValidateID returns the id path parameter, rejecting the request when it is
+// empty.
+func ValidateID(ctx *gin.Context) (string, bool) {
+	id := ctx.Param("id")
+	if id == "" {
+		writeValidationProblem(ctx, dtos.ProblemFieldErrorDTO{Parameter: "id", Detail: i18n.Message(Locale(ctx), i18n.FieldIDRequired)})
+		return "", false
+	}
+
+	return id, true
+}
+
+// writeBodyError reports why a JSON body could not be bound to target. Field
+// errors point at the offending member; anything else means the body is not
+// JSON at all.
+func writeBodyError(ctx *gin.Context, err error, target any, message validationMessage) {
+	if fieldErrors := bodyFieldErrors(Locale(ctx), err, target, "", message); fieldErrors != nil {
+		writeValidationProblem(ctx, fieldErrors...)
+		return
+	}
+
+	WriteMessageProblem(ctx, http.StatusBadRequest, i18n.InvalidJSONBody, err.Error())
+}
+
+// bodyFieldErrors turns a bind error into one entry per invalid field, with
+// pointers relative to prefix. It returns nil when err is not about a field.
+func bodyFieldErrors(locale i18n.Locale, err error, target any, prefix string, message validationMessage) []dtos.ProblemFieldErrorDTO {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		fieldErrors := make([]dtos.ProblemFieldErrorDTO, 0, len(validationErrors))
+		for _, fieldError := range validationErrors {
+			fieldErrors = append(fieldErrors, dtos.ProblemFieldErrorDTO{
+				Pointer: prefix + jsonPointer(fieldPath(reflect.TypeOf(target), fieldError.StructNamespace(), "json")),
+				Detail:  message(locale, fieldError),
+			})
+		}
+		return fieldErrors
+	}
+
+	var amountError *dtos.LocalizedAmountError
+	if errors.As(err, &amountError) {
+		return []dtos.ProblemFieldErrorDTO{{
+			Pointer: prefix + jsonPointer([]string{"amount"}),
+			Detail:  localizedAmountMessage(locale, amountError),
+		}}
+	}
+
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) && typeError.Field != "" {
+		return []dtos.ProblemFieldErrorDTO{{
+			Pointer: prefix + jsonPointer(strings.Split(typeError.Field, ".")),
+			Detail:  i18n.Message(locale, jsonTypeMessage(typeError.Type)),
+		}}
+	}
+
+	return nil
+}
+
+// localizedAmountMessage explains how to write the amount unambiguously in
+// the locale the client declared.
+func localizedAmountMessage(locale i18n.Locale, amountError *dtos.LocalizedAmountError) string {
+	switch {
+	case errors.Is(amountError, money.ErrAmbiguousAmount) && strings.Contains(amountError.Normalized, "."):
+		withAnotherPlace := money.Localize(amountError.Normalized+"0", amountError.Locale)
+		wholeNumber := strings.Replace(amountError.Normalized, ".", "", 1)
+		return i18n.Message(locale, i18n.FieldAmbiguousDecimal, amountError.Value, withAnotherPlace, wholeNumber)
+	case errors.Is(amountError, money.ErrAmbiguousAmount):
+		withDecimals := money.Localize(amountError.Normalized+".00", amountError.Locale)
+		return i18n.Message(locale, i18n.FieldAmbiguousAmount, amountError.Value, withDecimals, amountError.Normalized)
+	case errors.Is(amountError, money.ErrCurrencyMismatch):
+		return i18n.Message(locale, i18n.FieldAmountCurrency, amountError.Value, strings.ToUpper(amountError.Currency))
+	}
+
+	return i18n.Message(locale, i18n.FieldLocalizedAmount, amountError.Locale, money.Localize("1234.56", amountError.Locale))
+}
+
+// writeQueryError reports why the query string could not be bound to target.
+func writeQueryError(ctx *gin.Context, err error, target any, message validationMessage) {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		WriteMessageProblem(ctx, http.StatusBadRequest, i18n.InvalidQueryParameters, err.Error())
+		return
+	}
+
+	locale := Locale(ctx)
+
+	fieldErrors := make([]dtos.ProblemFieldErrorDTO, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, dtos.ProblemFieldErrorDTO{
+			Parameter: strings.Join(fieldPath(reflect.TypeOf(target), fieldError.StructNamespace(), "form"), "."),
+			Detail:    message(locale, fieldError),
+		})
+	}
+
+	writeValidationProblem(ctx, fieldErrors...)
+}
+
+// fieldPath converts a validator namespace such as "BatchTransactionsDTO.Operations[2].Op"
+// into the names the client used, taken from the given struct tag.
+func fieldPath(structType reflect.Type, namespace string, tag string) []string {
+	segments := strings.Split(namespace, ".")[1:]
+	path := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		name, index, indexed := strings.Cut(segment, "[")
+
+		structType = indirectType(structType)
+		field, ok := structType.FieldByName(name)
+		if !ok {
+			path = append(path, segment)
+			continue
+		}
+
+		path = append(path, tagName(field, tag))
+		structType = field.Type
+
+		if indexed {
+			path = append(path, strings.TrimSuffix(index, "]"))
+			structType = indirectType(structType).Elem()
+		}
+	}
+
+	return path
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func tagName(field reflect.StructField, tag string) string {
+	name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// jsonPointer builds an RFC 6901 pointer from path segments.
+func jsonPointer(path []string) string {
+	var pointer strings.Builder
+	for _, segment := range path {
+		pointer.WriteString("/")
+		pointer.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(segment))
+	}
+
+	return pointer.String()
+}
+
+func jsonTypeMessage(t reflect.Type) i18n.Code {
+	switch indirectType(t).Kind() {
+	case reflect.String:
+		return i18n.FieldString
+	case reflect.Bool:
+		return i18n.FieldBoolean
+	case reflect.Slice, reflect.Array:
+		return i18n.FieldArray
+	case reflect.Map, reflect.Struct:
+		return i18n.FieldObject
+	default:
+		return i18n.FieldNumber
+	}
+}
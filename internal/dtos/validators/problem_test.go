@@ -0,0 +1,153 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// problemFieldDetails decodes a problem document and indexes its field errors
+// by pointer, parameter or header.
+func problemFieldDetails(t *testing.T, body []byte) map[string]string {
+	t.Helper()
+
+	var problem dtos.ProblemDTO
+	assert.NoError(t, json.Unmarshal(body, &problem))
+
+	details := make(map[string]string)
+	for _, fieldError := range problem.Errors {
+		switch {
+		case fieldError.Pointer != "":
+			details[fieldError.Pointer] = fieldError.Detail
+		case fieldError.Parameter != "":
+			details[fieldError.Parameter] = fieldError.Detail
+		default:
+			details[fieldError.Header] = fieldError.Detail
+		}
+	}
+
+	return details
+}
+
+func TestWriteProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request, _ = http.NewRequest(http.MethodGet, "/transactions/1", nil)
+
+	WriteProblem(ctx, dtos.NewProblem(i18n.English, http.StatusNotFound, "Entry not found"))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, dtos.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "en", w.Header().Get("Content-Language"))
+
+	var problem dtos.ProblemDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, dtos.ProblemDTO{
+		Type:   dtos.ProblemTypeBlank,
+		Title:  "Not Found",
+		Status: http.StatusNotFound,
+		Detail: "Entry not found",
+	}, problem)
+}
+
+func TestValidateID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request, _ = http.NewRequest(http.MethodGet, "/transactions/", nil)
+
+	id, isValid := ValidateID(ctx)
+
+	assert.False(t, isValid)
+	assert.Empty(t, id)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, map[string]string{"id": "ID is required"}, problemFieldDetails(t, w.Body.Bytes()))
+}
+
+func TestWriteMessageProblemLocalised(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request, _ = http.NewRequest(http.MethodPatch, "/transactions/1", nil)
+	ctx.Request.Header.Set("Accept-Language", "pt-BR,pt;q=0.9,en;q=0.8")
+
+	WriteMessageProblem(ctx, http.StatusBadRequest, i18n.PatchEmpty)
+
+	assert.Equal(t, "pt-BR", w.Header().Get("Content-Language"))
+
+	var problem dtos.ProblemDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "Requisição inválida", problem.Title)
+	assert.Equal(t, "O patch deve conter pelo menos um campo", problem.Detail)
+}
+
+func TestWriteBodyError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		body            string
+		expectedDetails map[string]string
+		expectedDetail  string
+	}{
+		{
+			name:            "Validation errors point at JSON members",
+			body:            `{"operations": [{"op": "create", "data": {}}, {"op": "merge", "id": "507f1f77bcf86cd799439011", "version": 1, "data": {}}]}`,
+			expectedDetails: map[string]string{"/operations/1/op": "Must be one of 'create', 'update' or 'delete'"},
+		},
+		{
+			name:            "Type errors point at JSON members",
+			body:            `{"operations": [{"op": 1}]}`,
+			expectedDetails: map[string]string{"/operations/0/op": "Must be a string"},
+		},
+		{
+			name:           "Malformed JSON is not a field error",
+			body:           `{"operations":`,
+			expectedDetail: "Request body must be valid JSON: unexpected EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest(http.MethodPost, "/transactions/batch", bytes.NewBufferString(tt.body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			var batch dtos.BatchTransactionsDTO
+			writeBodyError(ctx, ctx.ShouldBindJSON(&batch), &batch, getBatchTransactionsValidationMessage)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, dtos.ProblemContentType, w.Header().Get("Content-Type"))
+
+			var problem dtos.ProblemDTO
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+
+			if tt.expectedDetails != nil {
+				assert.Equal(t, tt.expectedDetails, problemFieldDetails(t, w.Body.Bytes()))
+				return
+			}
+
+			assert.Empty(t, problem.Errors)
+			assert.Equal(t, tt.expectedDetail, problem.Detail)
+		})
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	assert.Equal(t, "", jsonPointer(nil))
+	assert.Equal(t, "/operations/0/data/amount", jsonPointer([]string{"operations", "0", "data", "amount"}))
+	assert.Equal(t, "/a~1b/m~0n", jsonPointer([]string{"a/b", "m~n"}))
+}
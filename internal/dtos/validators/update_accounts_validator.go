@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateAccount(ctx *gin.Context) (*dtos.UpdateAccountDTO, string, bool) {
+	var account dtos.UpdateAccountDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&account); err != nil {
+		writeBodyError(ctx, err, &account, getAccountValidationMessage)
+		return nil, "", false
+	}
+
+	return &account, id, true
+}
@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateAccount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "Valid request",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"name":     "Savings",
+				"type":     "savings",
+				"currency": "BRL",
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			requestBody:    map[string]interface{}{},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Missing currency",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"name": "Savings",
+				"type": "savings",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPut, "/accounts/"+tt.id, bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			account, id, result := ValidateUpdateAccount(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, account)
+				assert.Equal(t, tt.id, id)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateBudget(ctx *gin.Context) (*dtos.UpdateBudgetDTO, string, bool) {
+	var budget dtos.UpdateBudgetDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&budget); err != nil {
+		writeBodyError(ctx, err, &budget, getBudgetValidationMessage)
+		return nil, "", false
+	}
+
+	return &budget, id, true
+}
@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateBudget(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "Valid request",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"category": "Food",
+				"month":    "2025-09",
+				"limit":    900.00,
+				"currency": "BRL",
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			requestBody:    map[string]interface{}{},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Negative limit",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"category": "Food",
+				"month":    "2025-09",
+				"limit":    -10,
+				"currency": "BRL",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPut, "/budgets/"+tt.id, bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			budget, id, result := ValidateUpdateBudget(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, budget)
+				assert.Equal(t, tt.id, id)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
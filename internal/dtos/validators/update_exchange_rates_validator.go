@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateExchangeRate(ctx *gin.Context) (*dtos.UpdateExchangeRateDTO, string, bool) {
+	var exchangeRate dtos.UpdateExchangeRateDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&exchangeRate); err != nil {
+		writeBodyError(ctx, err, &exchangeRate, getExchangeRateValidationMessage)
+		return nil, "", false
+	}
+
+	return &exchangeRate, id, true
+}
@@ -0,0 +1,75 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateExchangeRate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    map[string]interface{}
+		expectedResult bool
+	}{
+		{
+			name: "Valid request",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"base":  "USD",
+				"quote": "BRL",
+				"rate":  5.5,
+			},
+			expectedResult: true,
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			requestBody:    map[string]interface{}{},
+			expectedResult: false,
+		},
+		{
+			name: "Invalid currency length",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"base":  "US",
+				"quote": "BRL",
+				"rate":  5.5,
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPut, "/exchange-rates/"+tt.id, bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			exchangeRate, id, result := ValidateUpdateExchangeRate(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, exchangeRate)
+				assert.Equal(t, tt.id, id)
+			} else {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateRecurringRule(ctx *gin.Context) (*dtos.UpdateRecurringRuleDTO, string, bool) {
+	var rule dtos.UpdateRecurringRuleDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&rule); err != nil {
+		writeBodyError(ctx, err, &rule, getRecurringRuleValidationMessage)
+		return nil, "", false
+	}
+
+	return &rule, id, true
+}
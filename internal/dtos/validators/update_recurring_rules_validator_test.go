@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateRecurringRule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    map[string]interface{}
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "Valid request",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"amount":        3200.00,
+				"title":         "Salary",
+				"currency":      "BRL",
+				"type":          "income",
+				"category":      "Salary",
+				"paymentMethod": "transfer",
+				"frequency":     "monthly",
+				"startDate":     "01/01/2025",
+			},
+			expectedResult: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			requestBody:    map[string]interface{}{},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Missing frequency",
+			id:   "123",
+			requestBody: map[string]interface{}{
+				"amount":        3200.00,
+				"title":         "Salary",
+				"currency":      "BRL",
+				"type":          "income",
+				"category":      "Salary",
+				"paymentMethod": "transfer",
+				"startDate":     "01/01/2025",
+			},
+			expectedResult: false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPut, "/recurring-rules/"+tt.id, bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			rule, id, result := ValidateUpdateRecurringRule(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, rule)
+				assert.Equal(t, tt.id, id)
+			} else {
+				assert.Equal(t, tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateSavedView(ctx *gin.Context) (*dtos.UpdateSavedViewDTO, string, bool) {
+	var view dtos.UpdateSavedViewDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&view); err != nil {
+		writeBodyError(ctx, err, &view, getSavedViewValidationMessage)
+		return nil, "", false
+	}
+
+	return &view, id, true
+}
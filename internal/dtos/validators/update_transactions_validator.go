@@ -1,9 +1,8 @@
 package validators
 
 import (
-	"net/http"
-
 	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -12,54 +11,43 @@ import (
 func ValidateUpdateTransactionsEntry(ctx *gin.Context) (*dtos.UpdateTransactionsEntryDTO, string, bool) {
 	var entry dtos.UpdateTransactionsEntryDTO
 
-	id := ctx.Param("id")
-	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "ID is required",
-		})
+	id, isValid := ValidateID(ctx)
+	if !isValid {
 		return nil, "", false
 	}
 
 	if err := ctx.ShouldBindJSON(&entry); err != nil {
-		var errorMessage string
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			for _, fieldError := range validationErrors {
-				errorMessage = getUpdateTransactionsValidationMessage(fieldError)
-				break
-			}
-		} else {
-			errorMessage = err.Error()
-		}
-
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": errorMessage,
-		})
+		writeBodyError(ctx, err, &entry, getUpdateTransactionsValidationMessage)
 		return nil, "", false
 	}
 
 	return &entry, id, true
 }
 
-func getUpdateTransactionsValidationMessage(fieldError validator.FieldError) string {
+func getUpdateTransactionsValidationMessage(locale i18n.Locale, fieldError validator.FieldError) string {
 	switch fieldError.Field() {
 	case "Amount":
-		return "Amount must be greater than 0"
+		return i18n.Message(locale, i18n.FieldUpdateAmount)
+	case "AmountLocale":
+		return i18n.Message(locale, i18n.FieldAmountLocale)
 	case "Title":
-		return "Title is required"
+		return i18n.Message(locale, i18n.FieldUpdateTitle)
 	case "Currency":
-		return "Currency must be a 3-letter code"
+		return i18n.Message(locale, i18n.FieldCurrencyCode)
 	case "Type":
-		return "Type must be either 'income' or 'expense'"
+		return i18n.Message(locale, i18n.FieldUpdateType)
 	case "Category":
-		return "Category is required"
+		return i18n.Message(locale, i18n.FieldUpdateCategory)
 	case "PaymentMethod":
-		return "Payment method is required"
+		return i18n.Message(locale, i18n.FieldUpdatePaymentMethod)
 	case "Description":
 		if fieldError.Tag() == "min" {
-			return "Description must not be empty"
+			return i18n.Message(locale, i18n.FieldUpdateDescription)
 		}
 	case "Date":
-		return "Date must be in format DD/MM/YYYY"
+		return i18n.Message(locale, i18n.FieldUpdateDate)
+	case "AccountID":
+		return i18n.Message(locale, i18n.FieldUpdateAccountID)
 	}
 	return fieldError.Error()
 }
@@ -7,6 +7,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"myfin-api/internal/i18n"
+
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
@@ -128,17 +130,18 @@ func TestValidateUpdateTransactionsEntry(t *testing.T) {
 
 func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 	validate := validator.New()
+	validate.RegisterValidation("money_positive", validateMoneyPositive)
 
 	type TestStruct struct {
-		Amount        float64 `validate:"required,gt=0"`
-		Title         string  `validate:"required"`
-		Currency      string  `validate:"required,len=3"`
-		Type          string  `validate:"required,oneof=income expense"`
-		Category      string  `validate:"required"`
-		PaymentMethod string  `validate:"required"`
-		Description   string  `validate:"min=1"`
-		Date          string  `validate:"required"`
-		Test          string  `validate:"required"`
+		Amount        json.Number `validate:"required,money_positive=Currency"`
+		Title         string      `validate:"required"`
+		Currency      string      `validate:"required,len=3"`
+		Type          string      `validate:"required,oneof=income expense"`
+		Category      string      `validate:"required"`
+		PaymentMethod string      `validate:"required"`
+		Description   string      `validate:"min=1"`
+		Date          string      `validate:"required"`
+		Test          string      `validate:"required"`
 	}
 
 	tests := []struct {
@@ -150,7 +153,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Invalid amount",
 			testStruct: TestStruct{
-				Amount:        0,
+				Amount:        "0",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -159,12 +162,12 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 				Date:          "01/01/2023",
 			},
 			expectedField:  "Amount",
-			expectedErrMsg: "Amount must be greater than 0",
+			expectedErrMsg: "Amount must be a positive value with no more decimal places than the currency allows",
 		},
 		{
 			name: "Missing title",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "",
 				Currency:      "USD",
 				Type:          "income",
@@ -178,7 +181,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Invalid currency",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "US",
 				Type:          "income",
@@ -192,7 +195,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Invalid type",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "invalid",
@@ -206,7 +209,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Missing category",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -220,7 +223,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Missing payment method",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -234,7 +237,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Empty description",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -249,7 +252,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 		{
 			name: "Missing date",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -258,12 +261,12 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 				Date:          "",
 			},
 			expectedField:  "Date",
-			expectedErrMsg: "Date must be in format DD/MM/YYYY",
+			expectedErrMsg: "Date must be in format DD/MM/YYYY or ISO 8601 (YYYY-MM-DD)",
 		},
 		{
 			name: "Invalid value",
 			testStruct: TestStruct{
-				Amount:        100,
+				Amount:        "100",
 				Title:         "Test",
 				Currency:      "USD",
 				Type:          "income",
@@ -284,7 +287,7 @@ func TestGetUpdateTransactionsValidationMessage(t *testing.T) {
 				validationErrors := err.(validator.ValidationErrors)
 				for _, fieldError := range validationErrors {
 					if fieldError.Field() == tt.expectedField {
-						message := getUpdateTransactionsValidationMessage(fieldError)
+						message := getUpdateTransactionsValidationMessage(i18n.English, fieldError)
 						assert.Equal(t, tt.expectedErrMsg, message)
 						return
 					}
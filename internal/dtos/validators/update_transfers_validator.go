@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ValidateUpdateTransfer(ctx *gin.Context) (*dtos.UpdateTransferDTO, string, bool) {
+	var transfer dtos.UpdateTransferDTO
+
+	id, isValid := ValidateID(ctx)
+	if !isValid {
+		return nil, "", false
+	}
+
+	if err := ctx.ShouldBindJSON(&transfer); err != nil {
+		writeBodyError(ctx, err, &transfer, getTransferValidationMessage)
+		return nil, "", false
+	}
+
+	return &transfer, id, true
+}
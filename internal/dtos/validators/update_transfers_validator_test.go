@@ -0,0 +1,79 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateTransfer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validBody := map[string]interface{}{
+		"fromAccountId": "650000000000000000000001",
+		"toAccountId":   "650000000000000000000002",
+		"amount":        100.0,
+		"date":          "10/09/2025",
+	}
+
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    map[string]interface{}
+		expectedResult bool
+	}{
+		{
+			name:           "Valid request",
+			id:             "650000000000000000000010",
+			requestBody:    validBody,
+			expectedResult: true,
+		},
+		{
+			name:           "Missing ID",
+			id:             "",
+			requestBody:    validBody,
+			expectedResult: false,
+		},
+		{
+			name: "Invalid date",
+			id:   "650000000000000000000010",
+			requestBody: map[string]interface{}{
+				"fromAccountId": "650000000000000000000001",
+				"toAccountId":   "650000000000000000000002",
+				"amount":        100.0,
+				"date":          "10-09-2025",
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(http.MethodPut, "/transfers/"+tt.id, bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = req
+			ctx.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			transfer, id, result := ValidateUpdateTransfer(ctx)
+
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedResult {
+				assert.NotNil(t, transfer)
+				assert.Equal(t, tt.id, id)
+			} else {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
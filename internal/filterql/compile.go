@@ -0,0 +1,313 @@
+package filterql
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const DateLayout = "2006-01-02"
+
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindMoney
+	KindDate
+)
+
+type Field struct {
+	Path         string
+	Kind         Kind
+	Values       []string
+	CurrencyPath string
+}
+
+func Compile(input string, fields map[string]Field) (bson.M, error) {
+	expr, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return compileExpr(expr, fields)
+}
+
+func compileExpr(expr Expr, fields map[string]Field) (bson.M, error) {
+	switch expr := expr.(type) {
+	case *Logical:
+		left, err := compileExpr(expr.Left, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := compileExpr(expr.Right, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		operator := "$" + expr.Op
+		return bson.M{operator: append(flatten(left, operator), flatten(right, operator)...)}, nil
+	case *Not:
+		inner, err := compileExpr(expr.Expr, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		return bson.M{"$nor": bson.A{inner}}, nil
+	case *Comparison:
+		return compileComparison(expr, fields)
+	default:
+		return nil, newError(expr.position(), i18n.FilterUnsupportedExpression)
+	}
+}
+
+func flatten(query bson.M, operator string) bson.A {
+	if nested, ok := query[operator].(bson.A); ok && len(query) == 1 {
+		return nested
+	}
+
+	return bson.A{query}
+}
+
+func compileComparison(comparison *Comparison, fields map[string]Field) (bson.M, error) {
+	field, ok := fields[comparison.Field]
+	if !ok {
+		return nil, newError(comparison.pos, i18n.FilterUnknownField, quote(comparison.Field), strings.Join(fieldNames(fields), ", "))
+	}
+
+	switch field.Kind {
+	case KindMoney:
+		return compileMoney(comparison, field)
+	case KindDate:
+		return compileDate(comparison, field)
+	default:
+		return compileString(comparison, field)
+	}
+}
+
+func compileString(comparison *Comparison, field Field) (bson.M, error) {
+	patterns := make(bson.A, 0, len(comparison.Values))
+
+	for _, value := range comparison.Values {
+		if len(field.Values) > 0 && !slices.ContainsFunc(field.Values, func(allowed string) bool { return strings.EqualFold(allowed, value.Text) }) {
+			return nil, newError(value.pos, i18n.FilterInvalidValue, quote(value.Text), comparison.Field, strings.Join(field.Values, ", "))
+		}
+
+		patterns = append(patterns, primitive.Regex{Pattern: "^" + regexp.QuoteMeta(value.Text) + "$", Options: "i"})
+	}
+
+	switch comparison.Op {
+	case OpEqual:
+		return bson.M{field.Path: patterns[0]}, nil
+	case OpNotEqual:
+		return bson.M{field.Path: bson.M{"$not": patterns[0]}}, nil
+	case OpIn:
+		return bson.M{field.Path: bson.M{"$in": patterns}}, nil
+	case OpNotIn:
+		return bson.M{field.Path: bson.M{"$nin": patterns}}, nil
+	default:
+		return nil, unsupportedOperator(comparison)
+	}
+}
+
+func compileDate(comparison *Comparison, field Field) (bson.M, error) {
+	days := make([]time.Time, 0, len(comparison.Values))
+
+	for _, value := range comparison.Values {
+		day, err := time.Parse(DateLayout, value.Text)
+		if err != nil {
+			return nil, newError(value.pos, i18n.FilterInvalidDate, quote(value.Text), comparison.Field)
+		}
+		days = append(days, day)
+	}
+
+	dayRange := func(day time.Time) bson.M {
+		return bson.M{field.Path: bson.M{"$gte": day, "$lt": day.AddDate(0, 0, 1)}}
+	}
+
+	switch comparison.Op {
+	case OpEqual:
+		return dayRange(days[0]), nil
+	case OpNotEqual:
+		return bson.M{"$nor": bson.A{dayRange(days[0])}}, nil
+	case OpGreater:
+		return bson.M{field.Path: bson.M{"$gte": days[0].AddDate(0, 0, 1)}}, nil
+	case OpGreaterEqual:
+		return bson.M{field.Path: bson.M{"$gte": days[0]}}, nil
+	case OpLess:
+		return bson.M{field.Path: bson.M{"$lt": days[0]}}, nil
+	case OpLessEqual:
+		return bson.M{field.Path: bson.M{"$lt": days[0].AddDate(0, 0, 1)}}, nil
+	}
+
+	ranges := make(bson.A, 0, len(days))
+	for _, day := range days {
+		ranges = append(ranges, dayRange(day))
+	}
+
+	if comparison.Op == OpIn {
+		return bson.M{"$or": ranges}, nil
+	}
+	return bson.M{"$nor": ranges}, nil
+}
+
+var currencyGroups = []struct {
+	decimals int
+	currency any
+}{
+	{decimals: 0, currency: currencyPattern(money.CurrenciesWithDecimals(0))},
+	{decimals: 3, currency: currencyPattern(money.CurrenciesWithDecimals(3))},
+	{decimals: money.DefaultDecimals, currency: bson.M{"$not": currencyPattern(
+		append(money.CurrenciesWithDecimals(0), money.CurrenciesWithDecimals(3)...),
+	)}},
+}
+
+func currencyPattern(currencies []string) primitive.Regex {
+	return primitive.Regex{Pattern: "^(?:" + strings.Join(currencies, "|") + ")$", Options: "i"}
+}
+
+func compileMoney(comparison *Comparison, field Field) (bson.M, error) {
+	amounts := make([]int64, 0, len(comparison.Values))
+
+	for _, value := range comparison.Values {
+		amount, err := money.ParseWithDecimals(value.Text, money.MaxDecimals)
+		if err != nil {
+			return nil, newError(value.pos, i18n.FilterInvalidAmount, quote(value.Text), comparison.Field)
+		}
+		amounts = append(amounts, amount)
+	}
+
+	branches := make(bson.A, 0, len(currencyGroups))
+
+	for _, group := range currencyGroups {
+		condition, matchesAll, ok := moneyCondition(comparison.Op, amounts, group.decimals)
+		if !ok {
+			continue
+		}
+
+		branch := bson.M{field.CurrencyPath: group.currency}
+		if !matchesAll {
+			branch[field.Path] = condition
+		}
+		branches = append(branches, branch)
+	}
+
+	if len(branches) == 0 {
+		return bson.M{field.Path: bson.M{"$in": bson.A{}}}, nil
+	}
+
+	return bson.M{"$or": branches}, nil
+}
+
+// CompileMoneyRange matches amounts of field between min and max, both
+// inclusive and either of them optional. As in money comparisons, the bounds
+// are decimals compared in each currency's own minor units, so a minimum of
+// 10.5 keeps 10.50 BRL but no JPY amount below 11.
+func CompileMoneyRange(field Field, min, max string) (bson.M, error) {
+	type bound struct {
+		operator string
+		amount   int64
+	}
+
+	bounds := make([]bound, 0, 2)
+	for _, candidate := range []struct{ operator, value string }{{OpGreaterEqual, min}, {OpLessEqual, max}} {
+		if candidate.value == "" {
+			continue
+		}
+
+		amount, err := money.ParseWithDecimals(candidate.value, money.MaxDecimals)
+		if err != nil {
+			return nil, err
+		}
+		bounds = append(bounds, bound{operator: candidate.operator, amount: amount})
+	}
+
+	if len(bounds) == 0 {
+		return nil, nil
+	}
+
+	branches := make(bson.A, 0, len(currencyGroups))
+
+	for _, group := range currencyGroups {
+		condition := bson.M{}
+		for _, bound := range bounds {
+			operatorCondition, _, _ := moneyCondition(bound.operator, []int64{bound.amount}, group.decimals)
+			maps.Copy(condition, operatorCondition)
+		}
+
+		branches = append(branches, bson.M{field.CurrencyPath: group.currency, field.Path: condition})
+	}
+
+	return bson.M{"$or": branches}, nil
+}
+
+func moneyCondition(operator string, amounts []int64, decimals int) (condition bson.M, matchesAll bool, ok bool) {
+	divisor := int64(1)
+	for i := decimals; i < money.MaxDecimals; i++ {
+		divisor *= 10
+	}
+
+	floor := func(amount int64) int64 {
+		quotient := amount / divisor
+		if amount%divisor != 0 && amount < 0 {
+			quotient--
+		}
+		return quotient
+	}
+	ceil := func(amount int64) int64 {
+		if amount%divisor == 0 {
+			return amount / divisor
+		}
+		return floor(amount) + 1
+	}
+
+	exact := make(bson.A, 0, len(amounts))
+	for _, amount := range amounts {
+		if amount%divisor == 0 {
+			exact = append(exact, amount/divisor)
+		}
+	}
+
+	switch operator {
+	case OpGreater:
+		return bson.M{"$gt": floor(amounts[0])}, false, true
+	case OpGreaterEqual:
+		return bson.M{"$gte": ceil(amounts[0])}, false, true
+	case OpLess:
+		return bson.M{"$lt": ceil(amounts[0])}, false, true
+	case OpLessEqual:
+		return bson.M{"$lte": floor(amounts[0])}, false, true
+	case OpEqual, OpIn:
+		if len(exact) == 0 {
+			return nil, false, false
+		}
+		return bson.M{"$in": exact}, false, true
+	default:
+		if len(exact) == 0 {
+			return nil, true, true
+		}
+		return bson.M{"$nin": exact}, false, true
+	}
+}
+
+func unsupportedOperator(comparison *Comparison) *Error {
+	return newError(comparison.pos, i18n.FilterUnsupportedOperator, quote(comparison.Op), comparison.Field)
+}
+
+func fieldNames(fields map[string]Field) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,187 @@
+package filterql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"myfin-api/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var testFields = map[string]Field{
+	"amount":   {Path: "amount", Kind: KindMoney, CurrencyPath: "currency"},
+	"category": {Path: "category"},
+	"date":     {Path: "date", Kind: KindDate},
+	"type":     {Path: "type", Values: []string{"income", "expense"}},
+}
+
+func TestCompileStringComparisons(t *testing.T) {
+	query, err := Compile("category in (food, 'c++') and type != income", testFields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"$and": bson.A{
+		bson.M{"category": bson.M{"$in": bson.A{
+			primitive.Regex{Pattern: "^food$", Options: "i"},
+			primitive.Regex{Pattern: `^c\+\+$`, Options: "i"},
+		}}},
+		bson.M{"type": bson.M{"$not": primitive.Regex{Pattern: "^income$", Options: "i"}}},
+	}}, query)
+}
+
+func TestCompileFlattensLogicalOperators(t *testing.T) {
+	query, err := Compile("category = a or category = b or not category = c", testFields)
+
+	assert.NoError(t, err)
+
+	branches := query["$or"].(bson.A)
+	assert.Len(t, branches, 3)
+	assert.Equal(t, bson.M{"$nor": bson.A{bson.M{"category": primitive.Regex{Pattern: "^c$", Options: "i"}}}}, branches[2])
+}
+
+func TestCompileDateComparisons(t *testing.T) {
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+
+	tests := []struct {
+		input    string
+		expected bson.M
+	}{
+		{input: "date = 2025-01-01", expected: bson.M{"date": bson.M{"$gte": day, "$lt": nextDay}}},
+		{input: "date > 2025-01-01", expected: bson.M{"date": bson.M{"$gte": nextDay}}},
+		{input: "date >= 2025-01-01", expected: bson.M{"date": bson.M{"$gte": day}}},
+		{input: "date < 2025-01-01", expected: bson.M{"date": bson.M{"$lt": day}}},
+		{input: "date <= 2025-01-01", expected: bson.M{"date": bson.M{"$lt": nextDay}}},
+		{input: "date != 2025-01-01", expected: bson.M{"$nor": bson.A{bson.M{"date": bson.M{"$gte": day, "$lt": nextDay}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			query, err := Compile(tt.input, testFields)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, query)
+		})
+	}
+}
+
+func TestCompileMoneyUsesCurrencyMinorUnits(t *testing.T) {
+	query, err := Compile("amount > 100.5", testFields)
+
+	assert.NoError(t, err)
+
+	branches := query["$or"].(bson.A)
+	assert.Len(t, branches, 3)
+
+	zeroDecimals := branches[0].(bson.M)
+	assert.Contains(t, zeroDecimals["currency"].(primitive.Regex).Pattern, "JPY")
+	assert.Equal(t, bson.M{"$gt": int64(100)}, zeroDecimals["amount"])
+
+	threeDecimals := branches[1].(bson.M)
+	assert.Contains(t, threeDecimals["currency"].(primitive.Regex).Pattern, "KWD")
+	assert.Equal(t, bson.M{"$gt": int64(100500)}, threeDecimals["amount"])
+
+	defaultDecimals := branches[2].(bson.M)
+	assert.Contains(t, defaultDecimals["currency"], "$not")
+	assert.Equal(t, bson.M{"$gt": int64(10050)}, defaultDecimals["amount"])
+}
+
+func TestCompileMoneyRounding(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bson.M
+	}{
+		{input: "amount >= 10.5", expected: bson.M{"$gte": int64(11)}},
+		{input: "amount < 10.5", expected: bson.M{"$lt": int64(11)}},
+		{input: "amount <= 10.5", expected: bson.M{"$lte": int64(10)}},
+		{input: "amount > -10.5", expected: bson.M{"$gt": int64(-11)}},
+		{input: "amount in (10, 10.5)", expected: bson.M{"$in": bson.A{int64(10)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			query, err := Compile(tt.input, testFields)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, query["$or"].(bson.A)[0].(bson.M)["amount"])
+		})
+	}
+}
+
+func TestCompileMoneyEqualityBetweenMinorUnits(t *testing.T) {
+	query, err := Compile("amount = 0.001", testFields)
+
+	assert.NoError(t, err)
+	assert.Len(t, query["$or"].(bson.A), 1)
+
+	query, err = Compile("amount != 0.5", testFields)
+
+	assert.NoError(t, err)
+
+	zeroDecimals := query["$or"].(bson.A)[0].(bson.M)
+	assert.NotContains(t, zeroDecimals, "amount")
+}
+
+func TestCompileMoneyRange(t *testing.T) {
+	field := testFields["amount"]
+
+	query, err := CompileMoneyRange(field, "10.5", "20.0005")
+
+	assert.Error(t, err)
+	assert.Nil(t, query)
+
+	query, err = CompileMoneyRange(field, "10.5", "20.25")
+
+	assert.NoError(t, err)
+
+	branches := query["$or"].(bson.A)
+	assert.Len(t, branches, 3)
+
+	zeroDecimals := branches[0].(bson.M)
+	assert.Contains(t, zeroDecimals["currency"].(primitive.Regex).Pattern, "JPY")
+	assert.Equal(t, bson.M{"$gte": int64(11), "$lte": int64(20)}, zeroDecimals["amount"])
+
+	threeDecimals := branches[1].(bson.M)
+	assert.Contains(t, threeDecimals["currency"].(primitive.Regex).Pattern, "KWD")
+	assert.Equal(t, bson.M{"$gte": int64(10500), "$lte": int64(20250)}, threeDecimals["amount"])
+
+	defaultDecimals := branches[2].(bson.M)
+	assert.Equal(t, bson.M{"$gte": int64(1050), "$lte": int64(2025)}, defaultDecimals["amount"])
+
+	query, err = CompileMoneyRange(field, "", "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, query)
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		position int
+		message  string
+	}{
+		{input: "password = x", position: 1, message: "unknown field \"password\", expected one of: amount, category, date, type"},
+		{input: "category > food", position: 1, message: "operator \">\" is not supported for category"},
+		{input: "type = refund", position: 8, message: "invalid value \"refund\" for type, expected one of: income, expense"},
+		{input: "date >= 01/01/2025", position: 9, message: "invalid date \"01/01/2025\" for date, expected YYYY-MM-DD"},
+		{input: "amount in (1, ten)", position: 15, message: "invalid amount \"ten\" for amount"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			query, err := Compile(tt.input, testFields)
+
+			assert.Nil(t, query)
+			assert.True(t, errors.Is(err, ErrInvalidExpression))
+
+			var compileError *Error
+			assert.True(t, errors.As(err, &compileError))
+			assert.Equal(t, tt.position, compileError.Position)
+			assert.Equal(t, fmt.Sprintf("%s at position %d", tt.message, tt.position), compileError.Message(i18n.English))
+		})
+	}
+}
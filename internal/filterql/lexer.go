@@ -0,0 +1,130 @@
+package filterql
+
+import (
+	"strings"
+	"unicode"
+
+	"myfin-api/internal/i18n"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) describe() any {
+	switch t.kind {
+	case tokenEOF:
+		return phrase{code: i18n.FilterEndOfInput}
+	case tokenString:
+		return phrase{code: i18n.FilterStringToken, args: []any{quote(t.text)}}
+	default:
+		return quote(t.text)
+	}
+}
+
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func tokenize(input string) ([]token, error) {
+	tokens := make([]token, 0)
+	runes := []rune(input)
+
+	for pos := 0; pos < len(runes); {
+		char := runes[pos]
+
+		switch {
+		case unicode.IsSpace(char):
+			pos++
+		case char == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", pos: pos})
+			pos++
+		case char == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", pos: pos})
+			pos++
+		case char == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: pos})
+			pos++
+		case char == '\'' || char == '"':
+			text, end, err := readString(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: pos})
+			pos = end
+		case strings.ContainsRune("=!<>", char):
+			operator, width, err := readOperator(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, pos: pos})
+			pos += width
+		default:
+			start := pos
+			for pos < len(runes) && isWordRune(runes[pos]) {
+				pos++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:pos]), pos: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func readString(runes []rune, start int) (string, int, error) {
+	delimiter := runes[start]
+
+	var text strings.Builder
+	for pos := start + 1; pos < len(runes); pos++ {
+		switch runes[pos] {
+		case '\\':
+			if pos+1 < len(runes) {
+				pos++
+				text.WriteRune(runes[pos])
+			}
+		case delimiter:
+			return text.String(), pos + 1, nil
+		default:
+			text.WriteRune(runes[pos])
+		}
+	}
+
+	return "", 0, newError(start, i18n.FilterUnterminatedString)
+}
+
+func readOperator(runes []rune, pos int) (string, int, error) {
+	if pos+1 < len(runes) {
+		switch pair := string(runes[pos : pos+2]); pair {
+		case "==":
+			return "=", 2, nil
+		case "!=", ">=", "<=":
+			return pair, 2, nil
+		case "<>":
+			return "!=", 2, nil
+		}
+	}
+
+	if runes[pos] == '!' {
+		return "", 0, newError(pos, i18n.FilterBangWithoutEquals)
+	}
+
+	return string(runes[pos]), 1, nil
+}
+
+func isWordRune(char rune) bool {
+	return !unicode.IsSpace(char) && !strings.ContainsRune("()=!<>,'\"", char)
+}
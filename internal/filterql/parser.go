@@ -0,0 +1,284 @@
+package filterql
+
+import (
+	"strconv"
+	"strings"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/i18n"
+)
+
+const (
+	OpEqual        = "="
+	OpNotEqual     = "!="
+	OpGreater      = ">"
+	OpGreaterEqual = ">="
+	OpLess         = "<"
+	OpLessEqual    = "<="
+	OpIn           = "in"
+	OpNotIn        = "not in"
+)
+
+var ErrInvalidExpression = domain.NewError(domain.ErrValidation, i18n.InvalidFilterExpression)
+
+// Error points at the part of an expression that is invalid. Its message is
+// kept as a catalogue code so clients can read it in their own language.
+type Error struct {
+	Position int
+	Code     i18n.Code
+	Args     []any
+}
+
+func (e *Error) Error() string {
+	return e.Message(i18n.English)
+}
+
+// Message renders the error in locale, translating the arguments that are
+// phrases themselves, such as the description of an unexpected token.
+func (e *Error) Message(locale i18n.Locale) string {
+	args := make([]any, len(e.Args))
+	for i, arg := range e.Args {
+		if phrase, ok := arg.(phrase); ok {
+			arg = i18n.Message(locale, phrase.code, phrase.args...)
+		}
+		args[i] = arg
+	}
+
+	return i18n.Message(locale, i18n.FilterAtPosition, i18n.Message(locale, e.Code, args...), e.Position)
+}
+
+func (e *Error) Unwrap() error {
+	return ErrInvalidExpression
+}
+
+func newError(pos int, code i18n.Code, args ...any) *Error {
+	return &Error{Position: pos + 1, Code: code, Args: args}
+}
+
+// phrase is an error argument looked up in the catalogue when the error is
+// rendered.
+type phrase struct {
+	code i18n.Code
+	args []any
+}
+
+type Expr interface {
+	position() int
+}
+
+type Logical struct {
+	Op    string
+	Left  Expr
+	Right Expr
+	pos   int
+}
+
+type Not struct {
+	Expr Expr
+	pos  int
+}
+
+type Comparison struct {
+	Field  string
+	Op     string
+	Values []Value
+	pos    int
+}
+
+type Value struct {
+	Text string
+	pos  int
+}
+
+func (e *Logical) position() int    { return e.pos }
+func (e *Not) position() int        { return e.pos }
+func (e *Comparison) position() int { return e.pos }
+
+type parser struct {
+	tokens  []token
+	current int
+}
+
+func Parse(input string) (Expr, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, newError(next.pos, i18n.FilterExpectedLogical, next.describe())
+	}
+
+	return expr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) advance() token {
+	next := p.tokens[p.current]
+	if next.kind != tokenEOF {
+		p.current++
+	}
+	return next
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("or") {
+		operator := p.advance()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "or", Left: left, Right: right, pos: operator.pos}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("and") {
+		operator := p.advance()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "and", Left: left, Right: right, pos: operator.pos}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.peek().isKeyword("not") {
+		operator := p.advance()
+
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr, pos: operator.pos}, nil
+	}
+
+	if p.peek().kind == tokenLeftParen {
+		p.advance()
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if next := p.advance(); next.kind != tokenRightParen {
+			return nil, newError(next.pos, i18n.FilterExpectedCloseParen, next.describe())
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	field := p.advance()
+	if field.kind != tokenWord || isReserved(field.text) {
+		return nil, newError(field.pos, i18n.FilterExpectedField, field.describe())
+	}
+
+	comparison := &Comparison{Field: field.text, pos: field.pos}
+
+	operator := p.advance()
+	switch {
+	case operator.kind == tokenOperator:
+		comparison.Op = operator.text
+	case operator.isKeyword("in"):
+		comparison.Op = OpIn
+	case operator.isKeyword("not") && p.peek().isKeyword("in"):
+		p.advance()
+		comparison.Op = OpNotIn
+	default:
+		return nil, newError(operator.pos, i18n.FilterExpectedOperator, operator.describe(), quote(field.text))
+	}
+
+	if comparison.Op == OpIn || comparison.Op == OpNotIn {
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = values
+		return comparison, nil
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Values = []Value{value}
+
+	return comparison, nil
+}
+
+func (p *parser) parseList() ([]Value, error) {
+	if next := p.advance(); next.kind != tokenLeftParen {
+		return nil, newError(next.pos, i18n.FilterExpectedOpenParen, next.describe())
+	}
+
+	values := make([]Value, 0)
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		next := p.advance()
+		switch next.kind {
+		case tokenComma:
+			continue
+		case tokenRightParen:
+			return values, nil
+		default:
+			return nil, newError(next.pos, i18n.FilterExpectedListSeparator, next.describe())
+		}
+	}
+}
+
+func (p *parser) parseValue() (Value, error) {
+	next := p.advance()
+	if next.kind == tokenString || (next.kind == tokenWord && !isReserved(next.text)) {
+		return Value{Text: next.text, pos: next.pos}, nil
+	}
+
+	return Value{}, newError(next.pos, i18n.FilterExpectedValue, next.describe())
+}
+
+func isReserved(word string) bool {
+	switch strings.ToLower(word) {
+	case "and", "or", "not", "in":
+		return true
+	default:
+		return false
+	}
+}
+
+func quote(text string) string {
+	return strconv.Quote(text)
+}
@@ -0,0 +1,126 @@
+package filterql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"myfin-api/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrecedence(t *testing.T) {
+	expr, err := Parse("type = expense or amount > 100 and not category in (food, 'fast food')")
+
+	assert.NoError(t, err)
+
+	or, ok := expr.(*Logical)
+	assert.True(t, ok)
+	assert.Equal(t, "or", or.Op)
+
+	left, ok := or.Left.(*Comparison)
+	assert.True(t, ok)
+	assert.Equal(t, "type", left.Field)
+	assert.Equal(t, OpEqual, left.Op)
+	assert.Equal(t, "expense", left.Values[0].Text)
+
+	and, ok := or.Right.(*Logical)
+	assert.True(t, ok)
+	assert.Equal(t, "and", and.Op)
+
+	not, ok := and.Right.(*Not)
+	assert.True(t, ok)
+
+	in, ok := not.Expr.(*Comparison)
+	assert.True(t, ok)
+	assert.Equal(t, OpIn, in.Op)
+	assert.Equal(t, []Value{{Text: "food", pos: 52}, {Text: "fast food", pos: 58}}, in.Values)
+}
+
+func TestParseOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "amount = 1", expected: OpEqual},
+		{input: "amount == 1", expected: OpEqual},
+		{input: "amount != 1", expected: OpNotEqual},
+		{input: "amount <> 1", expected: OpNotEqual},
+		{input: "amount > 1", expected: OpGreater},
+		{input: "amount >= 1", expected: OpGreaterEqual},
+		{input: "amount < 1", expected: OpLess},
+		{input: "amount <= 1", expected: OpLessEqual},
+		{input: "amount IN (1, 2)", expected: OpIn},
+		{input: "amount not in (1)", expected: OpNotIn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Parse(tt.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, expr.(*Comparison).Op)
+		})
+	}
+}
+
+func TestParseParenthesesAndStrings(t *testing.T) {
+	expr, err := Parse(`(title = "Padaria \"Sao\" Joao" or title = 'and') and date >= 2025-01-01`)
+
+	assert.NoError(t, err)
+
+	and := expr.(*Logical)
+	or := and.Left.(*Logical)
+	assert.Equal(t, `Padaria "Sao" Joao`, or.Left.(*Comparison).Values[0].Text)
+	assert.Equal(t, "and", or.Right.(*Comparison).Values[0].Text)
+	assert.Equal(t, "2025-01-01", and.Right.(*Comparison).Values[0].Text)
+}
+
+func TestParseSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		position int
+		message  string
+	}{
+		{input: "", position: 1, message: "unexpected end of input, expected field name"},
+		{input: "amount", position: 7, message: "unexpected end of input, expected operator after \"amount\""},
+		{input: "amount >", position: 9, message: "unexpected end of input, expected value"},
+		{input: "amount > 1 and", position: 15, message: "unexpected end of input, expected field name"},
+		{input: "amount > 1 category = food", position: 12, message: "unexpected \"category\", expected \"and\", \"or\" or end of input"},
+		{input: "(amount > 1", position: 12, message: "unexpected end of input, expected \")\""},
+		{input: "category in food", position: 13, message: "unexpected \"food\", expected \"(\""},
+		{input: "category in (food bars)", position: 19, message: "unexpected \"bars\", expected \",\" or \")\""},
+		{input: "title = 'lunch", position: 9, message: "unterminated string"},
+		{input: "amount ! 1", position: 8, message: "unexpected \"!\", did you mean \"!=\"?"},
+		{input: "and = 1", position: 1, message: "unexpected \"and\", expected field name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Parse(tt.input)
+
+			assert.Nil(t, expr)
+			assert.True(t, errors.Is(err, ErrInvalidExpression))
+
+			var syntaxError *Error
+			assert.True(t, errors.As(err, &syntaxError))
+			assert.Equal(t, tt.position, syntaxError.Position)
+			assert.Equal(t, fmt.Sprintf("%s at position %d", tt.message, tt.position), syntaxError.Message(i18n.English))
+		})
+	}
+}
+
+func TestErrorMessageIsLocalized(t *testing.T) {
+	_, err := Parse("amount >")
+
+	var syntaxError *Error
+	assert.True(t, errors.As(err, &syntaxError))
+	assert.Equal(t, "unexpected end of input, expected value at position 9", syntaxError.Error())
+	assert.Equal(t, "fim da expressão inesperado, esperado um valor na posição 9", syntaxError.Message(i18n.BrazilianPortuguese))
+
+	_, err = Parse("title = 'lunch' 'dinner'")
+
+	assert.True(t, errors.As(err, &syntaxError))
+	assert.Equal(t, "texto \"dinner\" inesperado, esperado \"and\", \"or\" ou fim da expressão na posição 17", syntaxError.Message(i18n.BrazilianPortuguese))
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountsHandler interface {
+	Save(ctx *gin.Context)
+	GetAll(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetBalance(ctx *gin.Context)
+}
+
+type accountsHandler struct {
+	accountsService services.AccountsService
+}
+
+func NewAccountsHandler(accountsService services.AccountsService) AccountsHandler {
+	return &accountsHandler{
+		accountsService: accountsService,
+	}
+}
+
+func (h *accountsHandler) Save(ctx *gin.Context) {
+	account, isValid := validators.ValidateCreateAccount(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.accountsService.CreateAccount(*account)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateAccount, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *accountsHandler) GetAll(ctx *gin.Context) {
+	accounts, err := h.accountsService.GetAllAccounts()
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveAccounts, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": accounts,
+	})
+}
+
+func (h *accountsHandler) GetByID(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	account, err := h.accountsService.GetAccountByID(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveAccount, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+func (h *accountsHandler) Update(ctx *gin.Context) {
+	account, id, isValid := validators.ValidateUpdateAccount(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.accountsService.UpdateAccount(id, *account)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToUpdateAccount, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.AccountUpdated),
+		"data":    response,
+	})
+}
+
+func (h *accountsHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.accountsService.DeleteAccount(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToDeleteAccount, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.AccountDeleted),
+		"id":      id,
+	})
+}
+
+func (h *accountsHandler) GetBalance(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	balance, err := h.accountsService.GetAccountBalance(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveAccountBalance, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balance)
+}
@@ -0,0 +1,320 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAccountsService struct {
+	mock.Mock
+}
+
+func (m *MockAccountsService) CreateAccount(account dtos.CreateAccountDTO) (dtos.AccountResponseDTO, error) {
+	args := m.Called(account)
+	return args.Get(0).(dtos.AccountResponseDTO), args.Error(1)
+}
+
+func (m *MockAccountsService) GetAllAccounts() ([]dtos.AccountResponseDTO, error) {
+	args := m.Called()
+	return args.Get(0).([]dtos.AccountResponseDTO), args.Error(1)
+}
+
+func (m *MockAccountsService) GetAccountByID(id string) (dtos.AccountResponseDTO, error) {
+	args := m.Called(id)
+	return args.Get(0).(dtos.AccountResponseDTO), args.Error(1)
+}
+
+func (m *MockAccountsService) UpdateAccount(id string, account dtos.UpdateAccountDTO) (dtos.AccountResponseDTO, error) {
+	args := m.Called(id, account)
+	return args.Get(0).(dtos.AccountResponseDTO), args.Error(1)
+}
+
+func (m *MockAccountsService) DeleteAccount(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockAccountsService) GetAccountBalance(id string) (dtos.AccountBalanceResponseDTO, error) {
+	args := m.Called(id)
+	return args.Get(0).(dtos.AccountBalanceResponseDTO), args.Error(1)
+}
+
+func TestAccountsHandlerSave(t *testing.T) {
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/accounts", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		validAccount := dtos.CreateAccountDTO{
+			Name:           "Nubank",
+			Type:           "checking",
+			Currency:       "BRL",
+			OpeningBalance: json.Number("100.00"),
+		}
+
+		expectedResponse := dtos.AccountResponseDTO{
+			ID:             "123456789012345678901234",
+			Name:           "Nubank",
+			Type:           "checking",
+			Currency:       "BRL",
+			OpeningBalance: json.Number("100.00"),
+		}
+
+		mockService.On("CreateAccount", validAccount).Return(expectedResponse, nil)
+
+		jsonPayload, _ := json.Marshal(validAccount)
+		req, _ := http.NewRequest("POST", "/accounts", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.AccountResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/accounts", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		jsonPayload, _ := json.Marshal(map[string]interface{}{"name": "Nubank", "type": "unknown", "currency": "BRL"})
+		req, _ := http.NewRequest("POST", "/accounts", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateAccount", mock.Anything)
+	})
+}
+
+func TestAccountsHandlerGetAll(t *testing.T) {
+	t.Run("successful_retrieval", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/accounts", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllAccounts").Return([]dtos.AccountResponseDTO{
+			{ID: "123456789012345678901234", Name: "Nubank"},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/accounts", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, data, 1)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/accounts", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllAccounts").Return([]dtos.AccountResponseDTO{}, errors.New("database error"))
+
+		req, _ := http.NewRequest("GET", "/accounts", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestAccountsHandlerUpdate(t *testing.T) {
+	t.Run("successful_update", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.PUT("/accounts/:id", func(c *gin.Context) {
+			handler.Update(c)
+		})
+
+		id := "123456789012345678901234"
+		updateAccount := dtos.UpdateAccountDTO{Name: "Savings", Type: "savings", Currency: "BRL"}
+
+		mockService.On("UpdateAccount", id, updateAccount).Return(dtos.AccountResponseDTO{ID: id, Name: "Savings"}, nil)
+
+		jsonPayload, _ := json.Marshal(updateAccount)
+		req, _ := http.NewRequest("PUT", "/accounts/"+id, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "Account updated successfully", response["message"])
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestAccountsHandlerDelete(t *testing.T) {
+	t.Run("successful_deletion", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/accounts/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "123456789012345678901234"
+		mockService.On("DeleteAccount", id).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/accounts/"+id, nil)
+		req.Header.Set("Accept-Language", "pt-BR")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "Conta excluída com sucesso", response["message"])
+		mockService.AssertExpectations(t)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/accounts/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "123456789012345678901234"
+		mockService.On("DeleteAccount", id).Return(domain.NewError(domain.ErrNotFound, i18n.AccountNotFound))
+
+		req, _ := http.NewRequest("DELETE", "/accounts/"+id, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestAccountsHandlerGetBalance(t *testing.T) {
+	t.Run("successful_retrieval", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/accounts/:id/balance", func(c *gin.Context) {
+			handler.GetBalance(c)
+		})
+
+		id := "123456789012345678901234"
+		expectedBalance := dtos.AccountBalanceResponseDTO{
+			AccountID:      id,
+			Name:           "Nubank",
+			Currency:       "BRL",
+			OpeningBalance: json.Number("100.00"),
+			IncomeAmount:   json.Number("50.00"),
+			ExpenseAmount:  json.Number("20.00"),
+			TransfersIn:    json.Number("0.00"),
+			TransfersOut:   json.Number("0.00"),
+			Balance:        json.Number("130.00"),
+		}
+
+		mockService.On("GetAccountBalance", id).Return(expectedBalance, nil)
+
+		req, _ := http.NewRequest("GET", "/accounts/"+id+"/balance", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.AccountBalanceResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBalance, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockAccountsService)
+		handler := NewAccountsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/accounts/:id/balance", func(c *gin.Context) {
+			handler.GetBalance(c)
+		})
+
+		id := "123456789012345678901234"
+		mockService.On("GetAccountBalance", id).Return(dtos.AccountBalanceResponseDTO{}, errors.New("database error"))
+
+		req, _ := http.NewRequest("GET", "/accounts/"+id+"/balance", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var response dtos.ProblemDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Contains(t, response.Detail, "Failed to retrieve account balance")
+
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/money"
+
+	"github.com/gin-gonic/gin"
+)
+
+// displayAmount fills the formatted amount shown next to the exact one, in the
+// language negotiated for the request.
+func displayAmount(ctx *gin.Context, entry *dtos.TransactionsEntryResponseDTO) {
+	if entry.Amount == "" {
+		return
+	}
+
+	entry.AmountDisplay = money.Display(entry.Amount.String(), entry.Currency, string(validators.Locale(ctx)))
+}
+
+func displayAmounts(ctx *gin.Context, entries []dtos.TransactionsEntryResponseDTO) {
+	for i := range entries {
+		displayAmount(ctx, &entries[i])
+	}
+}
+
+func displayTransferAmounts(ctx *gin.Context, transfer *dtos.TransferResponseDTO) {
+	displayAmount(ctx, &transfer.Outgoing)
+	displayAmount(ctx, &transfer.Incoming)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountDisplay(t *testing.T) {
+	validID := "123456789012345678901234"
+	entry := dtos.TransactionsEntryResponseDTO{
+		ID:       validID,
+		Amount:   json.Number("1234.56"),
+		Currency: "BRL",
+		Date:     "06/09/2025",
+		Version:  1,
+	}
+
+	tests := []struct {
+		name            string
+		acceptLanguage  string
+		expectedDisplay string
+	}{
+		{name: "Default language", expectedDisplay: "R$ 1,234.56"},
+		{name: "Brazilian Portuguese", acceptLanguage: "pt-BR", expectedDisplay: "R$ 1.234,56"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockTransactionsService)
+			handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+			router := setupRouter()
+
+			router.GET("/transactions/:id", func(c *gin.Context) {
+				handler.GetByID(c)
+			})
+
+			mockService.On("GetTransactionsEntryByID", validID, services.DateFormat).Return(entry, nil)
+
+			req, _ := http.NewRequest("GET", "/transactions/"+validID, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response dtos.TransactionsEntryResponseDTO
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, json.Number("1234.56"), response.Amount)
+			assert.Equal(t, tt.expectedDisplay, response.AmountDisplay)
+		})
+	}
+}
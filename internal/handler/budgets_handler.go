@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetsHandler interface {
+	Save(ctx *gin.Context)
+	GetAll(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetStatus(ctx *gin.Context)
+}
+
+type budgetsHandler struct {
+	budgetsService services.BudgetsService
+}
+
+func NewBudgetsHandler(budgetsService services.BudgetsService) BudgetsHandler {
+	return &budgetsHandler{
+		budgetsService: budgetsService,
+	}
+}
+
+func (h *budgetsHandler) Save(ctx *gin.Context) {
+	budget, isValid := validators.ValidateCreateBudget(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.budgetsService.CreateBudget(*budget)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateBudget, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *budgetsHandler) GetAll(ctx *gin.Context) {
+	month, isValid := validators.ValidateGetAllBudgets(ctx)
+	if !isValid {
+		return
+	}
+
+	budgets, err := h.budgetsService.GetAllBudgets(month)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveBudgets, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": budgets,
+	})
+}
+
+func (h *budgetsHandler) Update(ctx *gin.Context) {
+	budget, id, isValid := validators.ValidateUpdateBudget(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.budgetsService.UpdateBudget(id, *budget)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToUpdateBudget, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.BudgetUpdated),
+		"data":    response,
+	})
+}
+
+func (h *budgetsHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.budgetsService.DeleteBudget(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToDeleteBudget, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.BudgetDeleted),
+		"id":      id,
+	})
+}
+
+func (h *budgetsHandler) GetStatus(ctx *gin.Context) {
+	month, isValid := validators.ValidateBudgetMonth(ctx)
+	if !isValid {
+		return
+	}
+
+	status, err := h.budgetsService.GetBudgetStatus(month)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveBudgetStatus, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
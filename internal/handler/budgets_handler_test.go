@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBudgetsService struct {
+	mock.Mock
+}
+
+func (m *MockBudgetsService) CreateBudget(budget dtos.CreateBudgetDTO) (dtos.BudgetResponseDTO, error) {
+	args := m.Called(budget)
+	return args.Get(0).(dtos.BudgetResponseDTO), args.Error(1)
+}
+
+func (m *MockBudgetsService) GetAllBudgets(month string) ([]dtos.BudgetResponseDTO, error) {
+	args := m.Called(month)
+	return args.Get(0).([]dtos.BudgetResponseDTO), args.Error(1)
+}
+
+func (m *MockBudgetsService) UpdateBudget(id string, budget dtos.UpdateBudgetDTO) (dtos.BudgetResponseDTO, error) {
+	args := m.Called(id, budget)
+	return args.Get(0).(dtos.BudgetResponseDTO), args.Error(1)
+}
+
+func (m *MockBudgetsService) DeleteBudget(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockBudgetsService) GetBudgetStatus(month string) (dtos.BudgetStatusResponseDTO, error) {
+	args := m.Called(month)
+	return args.Get(0).(dtos.BudgetStatusResponseDTO), args.Error(1)
+}
+
+func TestBudgetsHandlerSave(t *testing.T) {
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/budgets", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		validBudget := dtos.CreateBudgetDTO{
+			Category: "Food",
+			Month:    "2025-09",
+			Limit:    json.Number("800.00"),
+			Currency: "BRL",
+		}
+
+		expectedResponse := dtos.BudgetResponseDTO{
+			ID:       "123456789012345678901234",
+			Category: "Food",
+			Month:    "2025-09",
+			Limit:    json.Number("800.00"),
+			Currency: "BRL",
+		}
+
+		mockService.On("CreateBudget", validBudget).Return(expectedResponse, nil)
+
+		jsonPayload, _ := json.Marshal(validBudget)
+		req, _ := http.NewRequest("POST", "/budgets", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.BudgetResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("duplicate_budget", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/budgets", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		validBudget := dtos.CreateBudgetDTO{
+			Category: "Food",
+			Month:    "2025-09",
+			Limit:    json.Number("800.00"),
+			Currency: "BRL",
+		}
+
+		mockService.On("CreateBudget", validBudget).Return(dtos.BudgetResponseDTO{}, services.ErrBudgetAlreadyExists)
+
+		jsonPayload, _ := json.Marshal(validBudget)
+		req, _ := http.NewRequest("POST", "/budgets", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestBudgetsHandlerGetAll(t *testing.T) {
+	t.Run("filtered_by_month", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/budgets", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllBudgets", "2025-09").Return([]dtos.BudgetResponseDTO{
+			{ID: "123456789012345678901234", Category: "Food", Month: "2025-09"},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/budgets?month=2025-09", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, data, 1)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_month", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/budgets", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/budgets?month=2025-9", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAllBudgets", mock.Anything)
+	})
+}
+
+func TestBudgetsHandlerDelete(t *testing.T) {
+	t.Run("successful_deletion", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/budgets/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "123456789012345678901234"
+		mockService.On("DeleteBudget", id).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/budgets/"+id, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestBudgetsHandlerGetStatus(t *testing.T) {
+	t.Run("successful_retrieval", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/budgets/:month/status", func(c *gin.Context) {
+			handler.GetStatus(c)
+		})
+
+		expectedStatus := dtos.BudgetStatusResponseDTO{
+			Month: "2025-09",
+			Categories: []dtos.BudgetCategoryStatusDTO{
+				{BudgetID: "123456789012345678901234", Category: "Food", Currency: "BRL", Limit: json.Number("800.00"), Spent: json.Number("600.00"), Remaining: json.Number("200.00"), PercentUsed: 75},
+			},
+		}
+
+		mockService.On("GetBudgetStatus", "2025-09").Return(expectedStatus, nil)
+
+		req, _ := http.NewRequest("GET", "/budgets/2025-09/status", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.BudgetStatusResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStatus, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockBudgetsService)
+		handler := NewBudgetsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/budgets/:month/status", func(c *gin.Context) {
+			handler.GetStatus(c)
+		})
+
+		mockService.On("GetBudgetStatus", "2025-09").Return(dtos.BudgetStatusResponseDTO{}, errors.New("database error"))
+
+		req, _ := http.NewRequest("GET", "/budgets/2025-09/status", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos/validators"
+
+	"github.com/gin-gonic/gin"
+)
+
+const dateLayoutKey = "dateLayout"
+
+// DateFormat resolves the format response dates are written in for the
+// handlers behind it.
+func DateFormat(ctx *gin.Context) {
+	ctx.Writer.Header().Add("Vary", validators.PreferHeader)
+
+	layout, isValid := validators.ValidateDateFormat(ctx)
+	if !isValid {
+		ctx.Abort()
+		return
+	}
+
+	ctx.Set(dateLayoutKey, layout)
+	ctx.Next()
+}
+
+// dateLayout returns the layout DateFormat resolved for the request, which
+// handlers pass on to the services that build their responses.
+func dateLayout(ctx *gin.Context) string {
+	if layout := ctx.GetString(dateLayoutKey); layout != "" {
+		return layout
+	}
+
+	return dates.DefaultLayout
+}
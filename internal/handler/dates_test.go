@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDateFormat(t *testing.T) {
+	validID := "123456789012345678901234"
+
+	tests := []struct {
+		name                      string
+		query                     string
+		prefer                    string
+		expectedStatus            int
+		expectedLayout            string
+		expectedPreferenceApplied string
+	}{
+		{name: "Default format", expectedStatus: http.StatusOK, expectedLayout: dates.BrazilianLayout},
+		{name: "ISO parameter", query: "?dateFormat=iso", expectedStatus: http.StatusOK, expectedLayout: dates.ISOLayout},
+		{name: "Prefer header", prefer: "date-format=iso", expectedStatus: http.StatusOK, expectedLayout: dates.ISOLayout, expectedPreferenceApplied: "date-format=iso"},
+		{name: "Unknown parameter", query: "?dateFormat=us", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockTransactionsService)
+			handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+			router := setupRouter()
+			router.Use(DateFormat)
+
+			router.GET("/transactions/:id", func(c *gin.Context) {
+				handler.GetByID(c)
+			})
+
+			entry := dtos.TransactionsEntryResponseDTO{ID: validID, Amount: json.Number("150.75"), Date: "2025-09-06", Version: 1}
+			mockService.On("GetTransactionsEntryByID", validID, tt.expectedLayout).Return(entry, nil)
+
+			req, _ := http.NewRequest("GET", "/transactions/"+validID+tt.query, nil)
+			if tt.prefer != "" {
+				req.Header.Set("Prefer", tt.prefer)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedPreferenceApplied, w.Header().Get("Preference-Applied"))
+			assert.Contains(t, w.Header().Values("Vary"), "Prefer")
+
+			if tt.expectedStatus != http.StatusOK {
+				mockService.AssertNotCalled(t, "GetTransactionsEntryByID", validID, mock.Anything)
+				return
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorStatus maps the domain error categories to HTTP statuses. Errors outside
+// those categories are unexpected and reported as 500.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidID):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrValidation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, domain.ErrUnsupported):
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError reports err as a problem document whose detail is prefixed with
+// the action that failed, e.g. "Failed to update entry: ...".
+func writeError(ctx *gin.Context, status int, action i18n.Code, err error) {
+	locale := validators.Locale(ctx)
+	validators.WriteProblem(ctx, dtos.NewProblem(locale, status, errorDetail(locale, action, err)))
+}
+
+func errorDetail(locale i18n.Locale, action i18n.Code, err error) string {
+	return i18n.Message(locale, action) + ": " + errorMessage(locale, err)
+}
+
+// localizedError is implemented by errors that carry their own detail in the
+// catalogue, such as filter expression errors.
+type localizedError interface {
+	Message(locale i18n.Locale) string
+}
+
+// errorMessage translates the domain error behind err. Context added when it
+// was wrapped, such as the offending ID, is kept as is unless the wrapping
+// error can translate it too. Errors outside the domain are unexpected and
+// have no translation.
+func errorMessage(locale i18n.Locale, err error) string {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return err.Error()
+	}
+
+	message := i18n.Message(locale, domainErr.Code)
+
+	var localized localizedError
+	if errors.As(err, &localized) {
+		return message + ": " + localized.Message(locale)
+	}
+
+	switch full := err.Error(); {
+	case full == domainErr.Error():
+		return message
+	case strings.HasPrefix(full, domainErr.Error()):
+		return message + strings.TrimPrefix(full, domainErr.Error())
+	default:
+		return message + ": " + full
+	}
+}
+
+// NotFound answers requests for routes that do not exist.
+func NotFound(ctx *gin.Context) {
+	validators.WriteMessageProblem(ctx, http.StatusNotFound, i18n.RouteNotFound, ctx.Request.URL.Path)
+}
+
+// MethodNotAllowed answers requests whose path exists but not for the method.
+func MethodNotAllowed(ctx *gin.Context) {
+	validators.WriteMessageProblem(ctx, http.StatusMethodNotAllowed, i18n.MethodNotAllowed, ctx.Request.Method, ctx.Request.URL.Path)
+}
+
+// Recovered reports a panic caught by the recovery middleware without leaking
+// its value to the client.
+func Recovered(ctx *gin.Context, _ any) {
+	validators.WriteMessageProblem(ctx, http.StatusInternalServerError, i18n.UnexpectedError)
+	ctx.Abort()
+}
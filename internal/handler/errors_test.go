@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "invalid_id", err: fmt.Errorf("%w: %q", domain.ErrInvalidID, "abc"), expected: http.StatusBadRequest},
+		{name: "not_found", err: domain.NewError(domain.ErrNotFound, i18n.AccountNotFound), expected: http.StatusNotFound},
+		{name: "conflict", err: services.ErrBudgetAlreadyExists, expected: http.StatusConflict},
+		{name: "validation", err: services.ErrTransferLeg, expected: http.StatusUnprocessableEntity},
+		{name: "unsupported", err: repository.ErrTransactionsUnsupported, expected: http.StatusNotImplemented},
+		{name: "money", err: money.ErrTooManyDecimals, expected: http.StatusUnprocessableEntity},
+		{name: "date", err: dates.ErrInvalidDate, expected: http.StatusUnprocessableEntity},
+		{name: "unexpected", err: errors.New("database connection failed"), expected: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errorStatus(tt.err))
+		})
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   i18n.Locale
+		err      error
+		expected string
+	}{
+		{name: "english", locale: i18n.English, err: services.ErrTransferLeg, expected: services.ErrTransferLeg.Error()},
+		{name: "translated", locale: i18n.BrazilianPortuguese, err: services.ErrTransferLeg, expected: "a transação faz parte de uma transferência e deve ser alterada por /transfers"},
+		{name: "wrapped_context_is_kept", locale: i18n.BrazilianPortuguese, err: fmt.Errorf("%w: %q", domain.ErrInvalidID, "abc"), expected: `ID inválido: "abc"`},
+		{name: "translated_money", locale: i18n.BrazilianPortuguese, err: money.ErrInvalidAmount, expected: "o valor deve ser um número decimal"},
+		{name: "unexpected", locale: i18n.BrazilianPortuguese, err: errors.New("database connection failed"), expected: "database connection failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errorMessage(tt.locale, tt.err))
+		})
+	}
+}
+
+func TestRouteProblems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.CustomRecovery(Recovered))
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(NotFound)
+	router.NoMethod(MethodNotAllowed)
+	router.GET("/transactions", func(c *gin.Context) {
+		panic("unexpected")
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected dtos.ProblemDTO
+	}{
+		{
+			name:     "unknown_route",
+			method:   http.MethodGet,
+			path:     "/unknown",
+			expected: dtos.NewProblem(i18n.English, http.StatusNotFound, "No route matches /unknown"),
+		},
+		{
+			name:     "method_not_allowed",
+			method:   http.MethodDelete,
+			path:     "/transactions",
+			expected: dtos.NewProblem(i18n.English, http.StatusMethodNotAllowed, "Method DELETE is not allowed on /transactions"),
+		},
+		{
+			name:     "panic",
+			method:   http.MethodGet,
+			path:     "/transactions",
+			expected: dtos.NewProblem(i18n.English, http.StatusInternalServerError, "An unexpected error occurred"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.Equal(t, dtos.ProblemContentType, w.Header().Get("Content-Type"))
+
+			var response dtos.ProblemDTO
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
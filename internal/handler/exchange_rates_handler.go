@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExchangeRatesHandler interface {
+	Save(ctx *gin.Context)
+	GetAll(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type exchangeRatesHandler struct {
+	exchangeRatesService services.ExchangeRatesService
+}
+
+func NewExchangeRatesHandler(exchangeRatesService services.ExchangeRatesService) ExchangeRatesHandler {
+	return &exchangeRatesHandler{
+		exchangeRatesService: exchangeRatesService,
+	}
+}
+
+func (h *exchangeRatesHandler) Save(ctx *gin.Context) {
+	exchangeRate, isValid := validators.ValidateCreateExchangeRate(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.exchangeRatesService.CreateExchangeRate(*exchangeRate)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateExchangeRate, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *exchangeRatesHandler) GetAll(ctx *gin.Context) {
+	exchangeRates, err := h.exchangeRatesService.GetAllExchangeRates()
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveExchangeRates, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": exchangeRates,
+	})
+}
+
+func (h *exchangeRatesHandler) Update(ctx *gin.Context) {
+	exchangeRate, id, isValid := validators.ValidateUpdateExchangeRate(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.exchangeRatesService.UpdateExchangeRate(id, *exchangeRate)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToUpdateExchangeRate, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.ExchangeRateUpdated),
+		"data":    response,
+	})
+}
+
+func (h *exchangeRatesHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.exchangeRatesService.DeleteExchangeRate(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToDeleteExchangeRate, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.ExchangeRateDeleted),
+		"id":      id,
+	})
+}
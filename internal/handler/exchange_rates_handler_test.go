@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExchangeRatesService struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRatesService) CreateExchangeRate(exchangeRate dtos.CreateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error) {
+	args := m.Called(exchangeRate)
+	return args.Get(0).(dtos.ExchangeRateResponseDTO), args.Error(1)
+}
+
+func (m *MockExchangeRatesService) GetAllExchangeRates() ([]dtos.ExchangeRateResponseDTO, error) {
+	args := m.Called()
+	return args.Get(0).([]dtos.ExchangeRateResponseDTO), args.Error(1)
+}
+
+func (m *MockExchangeRatesService) UpdateExchangeRate(id string, exchangeRate dtos.UpdateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error) {
+	args := m.Called(id, exchangeRate)
+	return args.Get(0).(dtos.ExchangeRateResponseDTO), args.Error(1)
+}
+
+func (m *MockExchangeRatesService) DeleteExchangeRate(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func TestExchangeRatesHandlerSave(t *testing.T) {
+	validExchangeRate := dtos.CreateExchangeRateDTO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BRL",
+		Rate:          json.Number("5.4321"),
+	}
+
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockExchangeRatesService)
+		handler := NewExchangeRatesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/exchange-rates", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		expectedResponse := dtos.ExchangeRateResponseDTO{
+			ID:            "123456789012345678901234",
+			BaseCurrency:  "USD",
+			QuoteCurrency: "BRL",
+			Rate:          json.Number("5.4321"),
+		}
+
+		mockService.On("CreateExchangeRate", validExchangeRate).Return(expectedResponse, nil)
+
+		jsonPayload, _ := json.Marshal(validExchangeRate)
+		req, _ := http.NewRequest("POST", "/exchange-rates", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.ExchangeRateResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("duplicate_pair", func(t *testing.T) {
+		mockService := new(MockExchangeRatesService)
+		handler := NewExchangeRatesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/exchange-rates", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		mockService.On("CreateExchangeRate", validExchangeRate).Return(dtos.ExchangeRateResponseDTO{}, services.ErrExchangeRateAlreadyExists)
+
+		jsonPayload, _ := json.Marshal(validExchangeRate)
+		req, _ := http.NewRequest("POST", "/exchange-rates", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_rate", func(t *testing.T) {
+		mockService := new(MockExchangeRatesService)
+		handler := NewExchangeRatesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/exchange-rates", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		req, _ := http.NewRequest("POST", "/exchange-rates", bytes.NewBufferString(`{"base": "USD", "quote": "BRL", "rate": 0}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateExchangeRate", mock.Anything)
+	})
+}
+
+func TestExchangeRatesHandlerGetAll(t *testing.T) {
+	mockService := new(MockExchangeRatesService)
+	handler := NewExchangeRatesHandler(mockService)
+	router := setupRouter()
+
+	router.GET("/exchange-rates", func(c *gin.Context) {
+		handler.GetAll(c)
+	})
+
+	mockService.On("GetAllExchangeRates").Return([]dtos.ExchangeRateResponseDTO{
+		{ID: "123456789012345678901234", BaseCurrency: "USD", QuoteCurrency: "BRL", Rate: json.Number("5.25")},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/exchange-rates", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data, ok := response["data"].([]interface{})
+	assert.True(t, ok)
+	assert.Len(t, data, 1)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestExchangeRatesHandlerUpdate(t *testing.T) {
+	mockService := new(MockExchangeRatesService)
+	handler := NewExchangeRatesHandler(mockService)
+	router := setupRouter()
+
+	router.PUT("/exchange-rates/:id", func(c *gin.Context) {
+		handler.Update(c)
+	})
+
+	id := "123456789012345678901234"
+	updateExchangeRate := dtos.UpdateExchangeRateDTO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BRL",
+		Rate:          json.Number("5.30"),
+	}
+
+	mockService.On("UpdateExchangeRate", id, updateExchangeRate).Return(dtos.ExchangeRateResponseDTO{ID: id, Rate: json.Number("5.30")}, nil)
+
+	jsonPayload, _ := json.Marshal(updateExchangeRate)
+	req, _ := http.NewRequest("PUT", "/exchange-rates/"+id, bytes.NewBuffer(jsonPayload))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestExchangeRatesHandlerDelete(t *testing.T) {
+	mockService := new(MockExchangeRatesService)
+	handler := NewExchangeRatesHandler(mockService)
+	router := setupRouter()
+
+	router.DELETE("/exchange-rates/:id", func(c *gin.Context) {
+		handler.Delete(c)
+	})
+
+	id := "123456789012345678901234"
+	mockService.On("DeleteExchangeRate", id).Return(nil)
+
+	req, _ := http.NewRequest("DELETE", "/exchange-rates/"+id, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecurringRulesHandler interface {
+	Save(ctx *gin.Context)
+	GetAll(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type recurringRulesHandler struct {
+	recurringService services.RecurringRulesService
+}
+
+func NewRecurringRulesHandler(recurringService services.RecurringRulesService) RecurringRulesHandler {
+	return &recurringRulesHandler{
+		recurringService: recurringService,
+	}
+}
+
+func (h *recurringRulesHandler) Save(ctx *gin.Context) {
+	rule, isValid := validators.ValidateCreateRecurringRule(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.recurringService.CreateRecurringRule(*rule, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateRecurringRule, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *recurringRulesHandler) GetAll(ctx *gin.Context) {
+	rules, err := h.recurringService.GetAllRecurringRules(dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveRecurringRules, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": rules,
+	})
+}
+
+func (h *recurringRulesHandler) GetByID(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	rule, err := h.recurringService.GetRecurringRuleByID(id, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveRecurringRule, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rule)
+}
+
+func (h *recurringRulesHandler) Update(ctx *gin.Context) {
+	rule, id, isValid := validators.ValidateUpdateRecurringRule(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.recurringService.UpdateRecurringRule(id, *rule, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToUpdateRecurringRule, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.RecurringRuleUpdated),
+		"data":    response,
+	})
+}
+
+func (h *recurringRulesHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.recurringService.DeleteRecurringRule(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToDeleteRecurringRule, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.RecurringRuleDeleted),
+		"id":      id,
+	})
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRecurringRulesService struct {
+	mock.Mock
+}
+
+func (m *MockRecurringRulesService) CreateRecurringRule(rule dtos.CreateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(rule, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) GetAllRecurringRules(layout string) ([]dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(layout)
+	return args.Get(0).([]dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) GetRecurringRuleByID(id string, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) UpdateRecurringRule(id string, rule dtos.UpdateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(id, rule, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) DeleteRecurringRule(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRecurringRulesService) GenerateDueOccurrences(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+func TestRecurringRulesHandlerSave(t *testing.T) {
+	validRule := dtos.CreateRecurringRuleDTO{
+		Amount:        json.Number("1500.00"),
+		Title:         "Rent",
+		Currency:      "BRL",
+		Type:          "expense",
+		Category:      "Housing",
+		PaymentMethod: "pix",
+		Frequency:     "monthly",
+		StartDate:     "05/01/2025",
+	}
+
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		handler := NewRecurringRulesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/recurring-rules", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		expectedResponse := dtos.RecurringRuleResponseDTO{
+			ID:        "123456789012345678901234",
+			Amount:    json.Number("1500.00"),
+			Title:     "Rent",
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: "05/01/2025",
+			NextRunAt: "05/01/2025",
+			Active:    true,
+		}
+
+		mockService.On("CreateRecurringRule", validRule, services.DateFormat).Return(expectedResponse, nil)
+
+		jsonPayload, _ := json.Marshal(validRule)
+		req, _ := http.NewRequest("POST", "/recurring-rules", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.RecurringRuleResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("end_date_before_start_date", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		handler := NewRecurringRulesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/recurring-rules", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		invalidRule := validRule
+		invalidRule.EndDate = "01/01/2025"
+
+		mockService.On("CreateRecurringRule", invalidRule, services.DateFormat).Return(dtos.RecurringRuleResponseDTO{}, services.ErrRecurringEndBeforeStart)
+
+		jsonPayload, _ := json.Marshal(invalidRule)
+		req, _ := http.NewRequest("POST", "/recurring-rules", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		handler := NewRecurringRulesHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/recurring-rules", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		invalidRule := validRule
+		invalidRule.Frequency = "hourly"
+
+		jsonPayload, _ := json.Marshal(invalidRule)
+		req, _ := http.NewRequest("POST", "/recurring-rules", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateRecurringRule", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRecurringRulesHandlerGetAll(t *testing.T) {
+	t.Run("successful_retrieval", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		handler := NewRecurringRulesHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/recurring-rules", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllRecurringRules", services.DateFormat).Return([]dtos.RecurringRuleResponseDTO{
+			{ID: "123456789012345678901234", Title: "Rent"},
+			{ID: "123456789012345678901235", Title: "Salary"},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/recurring-rules", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, data, 2)
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestRecurringRulesHandlerDelete(t *testing.T) {
+	t.Run("successful_deletion", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		handler := NewRecurringRulesHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/recurring-rules/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "123456789012345678901234"
+		mockService.On("DeleteRecurringRule", id).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/recurring-rules/"+id, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportsHandler interface {
+	GetCategories(ctx *gin.Context)
+	GetCashflow(ctx *gin.Context)
+}
+
+type reportsHandler struct {
+	reportsService services.ReportsService
+}
+
+func NewReportsHandler(reportsService services.ReportsService) ReportsHandler {
+	return &reportsHandler{
+		reportsService: reportsService,
+	}
+}
+
+func (h *reportsHandler) GetCategories(ctx *gin.Context) {
+	query, isValid := validators.ValidateGetCategoryReport(ctx)
+	if !isValid {
+		return
+	}
+
+	report, err := h.reportsService.GetCategoryReport(*query, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, reportErrorStatus(err), i18n.FailedToRetrieveCategoryReport, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (h *reportsHandler) GetCashflow(ctx *gin.Context) {
+	query, isValid := validators.ValidateGetCashflowReport(ctx)
+	if !isValid {
+		return
+	}
+
+	report, err := h.reportsService.GetCashflowReport(*query, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, reportErrorStatus(err), i18n.FailedToRetrieveCashflowReport, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func reportErrorStatus(err error) int {
+	if errors.Is(err, services.ErrInvalidDateRange) || errors.Is(err, services.ErrTooManyBuckets) || errors.Is(err, filterql.ErrInvalidExpression) ||
+		errors.Is(err, dates.ErrInvalidDate) {
+		return http.StatusBadRequest
+	}
+
+	return errorStatus(err)
+}
@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReportsService struct {
+	mock.Mock
+}
+
+func (m *MockReportsService) GetCategoryReport(query dtos.CategoryReportQueryDTO, layout string) (dtos.CategoryReportResponseDTO, error) {
+	args := m.Called(query, layout)
+	return args.Get(0).(dtos.CategoryReportResponseDTO), args.Error(1)
+}
+
+func (m *MockReportsService) GetCashflowReport(query dtos.CashflowReportQueryDTO, layout string) (dtos.CashflowReportResponseDTO, error) {
+	args := m.Called(query, layout)
+	return args.Get(0).(dtos.CashflowReportResponseDTO), args.Error(1)
+}
+
+func TestReportsHandlerGetCategories(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/categories", func(c *gin.Context) {
+			handler.GetCategories(c)
+		})
+
+		query := dtos.CategoryReportQueryDTO{From: "01/09/2025", To: "30/09/2025", Type: "expense", Top: 3}
+		expectedResponse := dtos.CategoryReportResponseDTO{
+			From: "01/09/2025",
+			To:   "30/09/2025",
+			Type: "expense",
+			Currencies: []dtos.CategoryReportCurrencyDTO{
+				{
+					Currency: "BRL",
+					Total:    json.Number("1000.00"),
+					Count:    4,
+					Categories: []dtos.CategoryReportItemDTO{
+						{Category: "food", Total: json.Number("1000.00"), Count: 4, Share: 100, AverageTicket: json.Number("250.00")},
+					},
+				},
+			},
+		}
+
+		mockService.On("GetCategoryReport", query, services.DateFormat).Return(expectedResponse, nil)
+
+		req, _ := http.NewRequest("GET", "/reports/categories?from=01/09/2025&to=30/09/2025&type=expense&top=3", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.CategoryReportResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/categories", func(c *gin.Context) {
+			handler.GetCategories(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/reports/categories?type=transfer", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetCategoryReport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("inverted_date_range", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/categories", func(c *gin.Context) {
+			handler.GetCategories(c)
+		})
+
+		query := dtos.CategoryReportQueryDTO{From: "30/09/2025", To: "01/09/2025"}
+		mockService.On("GetCategoryReport", query, services.DateFormat).Return(dtos.CategoryReportResponseDTO{}, services.ErrInvalidDateRange)
+
+		req, _ := http.NewRequest("GET", "/reports/categories?from=30/09/2025&to=01/09/2025", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_filter_expression", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/categories", func(c *gin.Context) {
+			handler.GetCategories(c)
+		})
+
+		query := dtos.CategoryReportQueryDTO{Filter: "secret = 1"}
+		mockService.On("GetCategoryReport", query, services.DateFormat).Return(dtos.CategoryReportResponseDTO{}, &filterql.Error{Position: 1, Code: i18n.FilterUnknownField, Args: []any{"\"secret\"", "category"}})
+
+		req, _ := http.NewRequest("GET", "/reports/categories?filter=secret%20%3D%201", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/categories", func(c *gin.Context) {
+			handler.GetCategories(c)
+		})
+
+		expectedError := errors.New("database connection failed")
+		mockService.On("GetCategoryReport", dtos.CategoryReportQueryDTO{}, services.DateFormat).Return(dtos.CategoryReportResponseDTO{}, expectedError)
+
+		req, _ := http.NewRequest("GET", "/reports/categories", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var response dtos.ProblemDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Contains(t, response.Detail, "Failed to retrieve category report")
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestReportsHandlerGetCashflow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/cashflow", func(c *gin.Context) {
+			handler.GetCashflow(c)
+		})
+
+		query := dtos.CashflowReportQueryDTO{From: "01/09/2025", To: "30/09/2025", Interval: "month"}
+		expectedResponse := dtos.CashflowReportResponseDTO{
+			From:     "01/09/2025",
+			To:       "30/09/2025",
+			Interval: "month",
+			Currencies: []dtos.CashflowReportCurrencyDTO{
+				{
+					Currency:       "BRL",
+					OpeningBalance: json.Number("100.00"),
+					Buckets: []dtos.CashflowBucketDTO{
+						{Start: "01/09/2025", End: "30/09/2025", IncomeAmount: json.Number("50.00"), ExpenseAmount: json.Number("20.00"), NetAmount: json.Number("30.00"), CumulativeBalance: json.Number("130.00")},
+					},
+				},
+			},
+		}
+
+		mockService.On("GetCashflowReport", query, services.DateFormat).Return(expectedResponse, nil)
+
+		req, _ := http.NewRequest("GET", "/reports/cashflow?from=01/09/2025&to=30/09/2025&interval=month", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.CashflowReportResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_interval", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/cashflow", func(c *gin.Context) {
+			handler.GetCashflow(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/reports/cashflow?interval=quarter", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetCashflowReport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too_many_buckets", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/cashflow", func(c *gin.Context) {
+			handler.GetCashflow(c)
+		})
+
+		query := dtos.CashflowReportQueryDTO{From: "01/01/2000", Interval: "day"}
+		mockService.On("GetCashflowReport", query, services.DateFormat).Return(dtos.CashflowReportResponseDTO{}, services.ErrTooManyBuckets)
+
+		req, _ := http.NewRequest("GET", "/reports/cashflow?from=01/01/2000&interval=day", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockReportsService)
+		handler := NewReportsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/reports/cashflow", func(c *gin.Context) {
+			handler.GetCashflow(c)
+		})
+
+		mockService.On("GetCashflowReport", dtos.CashflowReportQueryDTO{}, services.DateFormat).Return(dtos.CashflowReportResponseDTO{}, errors.New("database connection failed"))
+
+		req, _ := http.NewRequest("GET", "/reports/cashflow", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
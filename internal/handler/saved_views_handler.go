@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SavedViewsHandler interface {
+	Save(ctx *gin.Context)
+	GetAll(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetTransactions(ctx *gin.Context)
+}
+
+type savedViewsHandler struct {
+	savedViewsService services.SavedViewsService
+}
+
+func NewSavedViewsHandler(savedViewsService services.SavedViewsService) SavedViewsHandler {
+	return &savedViewsHandler{
+		savedViewsService: savedViewsService,
+	}
+}
+
+func (h *savedViewsHandler) Save(ctx *gin.Context) {
+	view, isValid := validators.ValidateCreateSavedView(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.savedViewsService.CreateSavedView(*view, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, savedViewErrorStatus(err), i18n.FailedToCreateSavedView, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *savedViewsHandler) GetAll(ctx *gin.Context) {
+	views, err := h.savedViewsService.GetAllSavedViews(dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveSavedViews, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": views,
+	})
+}
+
+func (h *savedViewsHandler) GetByID(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	view, err := h.savedViewsService.GetSavedViewByID(id, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveSavedView, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, view)
+}
+
+func (h *savedViewsHandler) Update(ctx *gin.Context) {
+	view, id, isValid := validators.ValidateUpdateSavedView(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.savedViewsService.UpdateSavedView(id, *view, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, savedViewErrorStatus(err), i18n.FailedToUpdateSavedView, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.SavedViewUpdated),
+		"data":    response,
+	})
+}
+
+func (h *savedViewsHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.savedViewsService.DeleteSavedView(id)
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToDeleteSavedView, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.SavedViewDeleted),
+		"id":      id,
+	})
+}
+
+func (h *savedViewsHandler) GetTransactions(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	cursor := ctx.Query("cursor")
+
+	result, err := h.savedViewsService.GetSavedViewTransactions(id, cursor, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, transactionsFilterErrorStatus(err), i18n.FailedToRetrieveEntries, err)
+		return
+	}
+
+	if links := paginationLinks(ctx.Request.URL, result.Page); links != "" {
+		ctx.Header("Link", links)
+	}
+
+	skip := result.View.Skip
+	if cursor != "" {
+		skip = 0
+	}
+
+	displayAmounts(ctx, result.Page.Entries)
+	body := transactionsPageBody(result.Page, result.View.Limit, skip, result.View.Filters)
+	body["view"] = gin.H{
+		"id":   result.View.ID,
+		"name": result.View.Name,
+	}
+
+	ctx.JSON(http.StatusOK, body)
+}
+
+func savedViewErrorStatus(err error) int {
+	if errors.Is(err, filterql.ErrInvalidExpression) {
+		return http.StatusBadRequest
+	}
+
+	return errorStatus(err)
+}
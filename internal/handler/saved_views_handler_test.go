@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSavedViewsService struct {
+	mock.Mock
+}
+
+func (m *MockSavedViewsService) CreateSavedView(view dtos.CreateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error) {
+	args := m.Called(view, layout)
+	return args.Get(0).(dtos.SavedViewResponseDTO), args.Error(1)
+}
+
+func (m *MockSavedViewsService) GetAllSavedViews(layout string) ([]dtos.SavedViewResponseDTO, error) {
+	args := m.Called(layout)
+	return args.Get(0).([]dtos.SavedViewResponseDTO), args.Error(1)
+}
+
+func (m *MockSavedViewsService) GetSavedViewByID(id string, layout string) (dtos.SavedViewResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.SavedViewResponseDTO), args.Error(1)
+}
+
+func (m *MockSavedViewsService) UpdateSavedView(id string, view dtos.UpdateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error) {
+	args := m.Called(id, view, layout)
+	return args.Get(0).(dtos.SavedViewResponseDTO), args.Error(1)
+}
+
+func (m *MockSavedViewsService) DeleteSavedView(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockSavedViewsService) GetSavedViewTransactions(id, cursor string, layout string) (dtos.SavedViewTransactionsDTO, error) {
+	args := m.Called(id, cursor, layout)
+	return args.Get(0).(dtos.SavedViewTransactionsDTO), args.Error(1)
+}
+
+func TestSavedViewsHandlerSave(t *testing.T) {
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/views", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		validView := dtos.CreateSavedViewDTO{
+			Name:    "Food",
+			Filters: dtos.TransactionsFilterDTO{Category: "food", Sort: "-amount"},
+			Limit:   20,
+		}
+
+		expectedResponse := dtos.SavedViewResponseDTO{
+			ID:      "123456789012345678901234",
+			Name:    "Food",
+			Filters: dtos.TransactionsFilterDTO{Category: "food", Sort: "-amount"},
+			Limit:   20,
+		}
+
+		mockService.On("CreateSavedView", validView, services.DateFormat).Return(expectedResponse, nil)
+
+		req, _ := http.NewRequest("POST", "/views", bytes.NewBufferString(`{"name":"Food","filters":{"category":"food","sort":"-amount"},"limit":20}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.SavedViewResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse.ID, response.ID)
+		assert.Equal(t, "food", response.Filters.Category)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_filters", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/views", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		req, _ := http.NewRequest("POST", "/views", bytes.NewBufferString(`{"name":"Food","filters":{"type":"refund"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateSavedView", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_filter_expression", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/views", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		view := dtos.CreateSavedViewDTO{Name: "Food", Filters: dtos.TransactionsFilterDTO{Filter: "secret = 1"}}
+		mockService.On("CreateSavedView", view, services.DateFormat).Return(dtos.SavedViewResponseDTO{}, &filterql.Error{Position: 1, Code: i18n.FilterUnknownField, Args: []any{`"secret"`, "amount"}})
+
+		req, _ := http.NewRequest("POST", "/views", bytes.NewBufferString(`{"name":"Food","filters":{"filter":"secret = 1"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_date_range", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/views", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		view := dtos.CreateSavedViewDTO{Name: "Food", Filters: dtos.TransactionsFilterDTO{From: "2025-09-10", To: "2025-09-01"}}
+		mockService.On("CreateSavedView", view, services.DateFormat).Return(dtos.SavedViewResponseDTO{}, services.ErrInvalidDateRange)
+
+		req, _ := http.NewRequest("POST", "/views", bytes.NewBufferString(`{"name":"Food","filters":{"from":"2025-09-10","to":"2025-09-01"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestSavedViewsHandlerGetAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/views", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllSavedViews", services.DateFormat).Return([]dtos.SavedViewResponseDTO{{ID: "1", Name: "Food"}}, nil)
+
+		req, _ := http.NewRequest("GET", "/views", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data []dtos.SavedViewResponseDTO `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/views", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllSavedViews", services.DateFormat).Return([]dtos.SavedViewResponseDTO{}, errors.New("database connection failed"))
+
+		req, _ := http.NewRequest("GET", "/views", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestSavedViewsHandlerUpdate(t *testing.T) {
+	t.Run("successful_update", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.PUT("/views/:id", func(c *gin.Context) {
+			handler.Update(c)
+		})
+
+		view := dtos.UpdateSavedViewDTO{Name: "Food", Filters: dtos.TransactionsFilterDTO{Filter: "amount > 100"}}
+		mockService.On("UpdateSavedView", "123", view, services.DateFormat).Return(dtos.SavedViewResponseDTO{ID: "123", Name: "Food"}, nil)
+
+		req, _ := http.NewRequest("PUT", "/views/123", bytes.NewBufferString(`{"name":"Food","filters":{"filter":"amount > 100"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestSavedViewsHandlerDelete(t *testing.T) {
+	t.Run("successful_deletion", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/views/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		mockService.On("DeleteSavedView", "123").Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/views/123", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestSavedViewsHandlerGetTransactions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/views/:id/transactions", func(c *gin.Context) {
+			handler.GetTransactions(c)
+		})
+
+		mockService.On("GetSavedViewTransactions", "123", "", services.DateFormat).Return(dtos.SavedViewTransactionsDTO{
+			View: dtos.SavedViewResponseDTO{ID: "123", Name: "Food", Filters: dtos.TransactionsFilterDTO{Category: "food"}, Limit: 1, Skip: 5},
+			Page: dtos.TransactionsPageDTO{
+				Entries: []dtos.TransactionsEntryResponseDTO{{ID: "1", Category: "food"}},
+				Total:   8,
+				Next:    "next-cursor",
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/views/123/transactions", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `</views/123/transactions?cursor=next-cursor>; rel="next"`, w.Header().Get("Link"))
+
+		var response struct {
+			Data       []dtos.TransactionsEntryResponseDTO `json:"data"`
+			Pagination map[string]interface{}              `json:"pagination"`
+			Filters    map[string]interface{}              `json:"filters"`
+			View       map[string]string                   `json:"view"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, float64(1), response.Pagination["limit"])
+		assert.Equal(t, float64(5), response.Pagination["skip"])
+		assert.Equal(t, float64(8), response.Pagination["total"])
+		assert.Equal(t, "food", response.Filters["category"])
+		assert.Equal(t, "Food", response.View["name"])
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/views/:id/transactions", func(c *gin.Context) {
+			handler.GetTransactions(c)
+		})
+
+		mockService.On("GetSavedViewTransactions", "123", "garbage", services.DateFormat).Return(dtos.SavedViewTransactionsDTO{}, services.ErrInvalidCursor)
+
+		req, _ := http.NewRequest("GET", "/views/123/transactions?cursor=garbage", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockSavedViewsService)
+		handler := NewSavedViewsHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/views/:id/transactions", func(c *gin.Context) {
+			handler.GetTransactions(c)
+		})
+
+		mockService.On("GetSavedViewTransactions", "123", "", services.DateFormat).Return(dtos.SavedViewTransactionsDTO{}, errors.New("database connection failed"))
+
+		req, _ := http.NewRequest("GET", "/views/123/transactions", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
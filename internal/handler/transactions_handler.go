@@ -1,47 +1,80 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
 	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/money"
 	"myfin-api/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
 type TransactionsHandler interface {
 	Save(ctx *gin.Context)
 	GetAll(ctx *gin.Context)
 	Delete(ctx *gin.Context)
 	Update(ctx *gin.Context)
+	Patch(ctx *gin.Context)
+	Batch(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
 	GetTransactionDashboardData(ctx *gin.Context)
 }
 
 type transactionsHandler struct {
 	transactionsService services.TransactionsService
+	idempotencyService  services.IdempotencyService
 }
 
-func NewTransactionsHandler(transactionsService services.TransactionsService) TransactionsHandler {
+func NewTransactionsHandler(transactionsService services.TransactionsService, idempotencyService services.IdempotencyService) TransactionsHandler {
 	return &transactionsHandler{
 		transactionsService: transactionsService,
+		idempotencyService:  idempotencyService,
 	}
 }
 
 func (h *transactionsHandler) Save(ctx *gin.Context) {
+	key, isValid := validators.ValidateIdempotencyKey(ctx)
+	if !isValid {
+		return
+	}
+
 	entry, isValid := validators.ValidateCreateTransactionsEntry(ctx)
 	if !isValid {
 		return
 	}
 
-	response, err := h.transactionsService.CreateTransactionsEntry(*entry)
+	var response dtos.TransactionsEntryResponseDTO
+	var replayed bool
+	var err error
+
+	if key == "" {
+		response, err = h.transactionsService.CreateTransactionsEntry(*entry, dateLayout(ctx))
+	} else {
+		response, replayed, err = h.idempotencyService.CreateTransactionsEntry(key, *entry, dateLayout(ctx))
+	}
+
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateEntry, err)
 		return
 	}
 
+	if replayed {
+		ctx.Header(idempotentReplayedHeader, "true")
+	}
+
+	displayAmount(ctx, &response)
 	ctx.JSON(http.StatusCreated, response)
 }
 
@@ -51,52 +84,54 @@ func (h *transactionsHandler) GetAll(ctx *gin.Context) {
 		return
 	}
 
-	titleFilter := ctx.Query("title")
-	categoryFilter := ctx.Query("category")
+	filter, isValid := validators.ValidateGetAllTransactionsFilters(ctx)
+	if !isValid {
+		return
+	}
+
+	if filter.Cursor != "" {
+		skip = 0
+	}
 
-	entries, err := h.transactionsService.GetAllTransactionsEntries(limit, skip, titleFilter, categoryFilter)
+	layout := dateLayout(ctx)
+
+	page, err := h.transactionsService.GetAllTransactionsEntries(limit, skip, *filter, layout)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to retrieve entries",
-			"details": err.Error(),
-		})
+		writeError(ctx, transactionsFilterErrorStatus(err), i18n.FailedToRetrieveEntries, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{
-		"data": entries,
-		"pagination": gin.H{
-			"limit": limit,
-			"skip":  skip,
-			"count": len(entries),
-		},
-		"filters": gin.H{
-			"title":    titleFilter,
-			"category": categoryFilter,
-		},
-	})
+	if links := paginationLinks(ctx.Request.URL, page); links != "" {
+		ctx.Header("Link", links)
+	}
+
+	// The filters are echoed in the response layout, whichever one was sent.
+	echoed := *filter
+	echoed.From, echoed.To = dates.Format(filter.From, layout), dates.Format(filter.To, layout)
+
+	displayAmounts(ctx, page.Entries)
+	ctx.JSON(http.StatusOK, transactionsPageBody(page, limit, skip, echoed))
 }
 
 func (h *transactionsHandler) Delete(ctx *gin.Context) {
-	id := ctx.Param("id")
-	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "ID is required",
-		})
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
 		return
 	}
 
-	err := h.transactionsService.DeleteTransactionsEntry(id)
+	ifMatch, isValid := validators.ValidateIfMatch(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.transactionsService.DeleteTransactionsEntry(id, ifMatch)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to delete entry",
-			"details": err.Error(),
-		})
+		writeError(ctx, transactionWriteErrorStatus(err), i18n.FailedToDeleteEntry, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
-		"message": "Entry deleted successfully",
+		"message": i18n.Message(validators.Locale(ctx), i18n.EntryDeleted),
 		"id":      id,
 	})
 }
@@ -107,52 +142,226 @@ func (h *transactionsHandler) Update(ctx *gin.Context) {
 		return
 	}
 
-	response, err := h.transactionsService.UpdateTransactionsEntry(id, *entry)
+	ifMatch, isValid := validators.ValidateIfMatch(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.transactionsService.UpdateTransactionsEntry(id, ifMatch, *entry, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, transactionWriteErrorStatus(err), i18n.FailedToUpdateEntry, err)
+		return
+	}
+
+	ctx.Header("ETag", entityTag(response.Version))
+	displayAmount(ctx, &response)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.EntryUpdated),
+		"data":    response,
+	})
+}
+
+func (h *transactionsHandler) Patch(ctx *gin.Context) {
+	patch, id, isValid := validators.ValidatePatchTransactionsEntry(ctx)
+	if !isValid {
+		return
+	}
+
+	ifMatch, isValid := validators.ValidateIfMatch(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.transactionsService.PatchTransactionsEntry(id, ifMatch, *patch, dateLayout(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to update entry",
-			"details": err.Error(),
-		})
+		writeError(ctx, transactionWriteErrorStatus(err), i18n.FailedToUpdateEntry, err)
 		return
 	}
 
+	ctx.Header("ETag", entityTag(response.Version))
+	displayAmount(ctx, &response)
 	ctx.JSON(http.StatusOK, gin.H{
-		"message": "Entry updated successfully",
+		"message": i18n.Message(validators.Locale(ctx), i18n.EntryUpdated),
 		"data":    response,
 	})
 }
 
+func (h *transactionsHandler) Batch(ctx *gin.Context) {
+	batch, isValid := validators.ValidateBatchTransactions(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.transactionsService.BatchTransactionsEntries(*batch, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToApplyBatch, err)
+		return
+	}
+
+	locale := validators.Locale(ctx)
+	status := http.StatusOK
+	for i := range response.Results {
+		result := &response.Results[i]
+		if result.Data != nil {
+			displayAmount(ctx, result.Data)
+		}
+
+		if result.Err == nil {
+			result.Status = batchSuccessStatus(result.Op)
+			continue
+		}
+
+		result.Error = batchProblem(locale, result)
+		result.Status = result.Error.Status
+
+		if !batch.Atomic {
+			status = http.StatusMultiStatus
+		} else if status == http.StatusOK && result.Status != http.StatusFailedDependency {
+			status = result.Status
+		}
+	}
+
+	ctx.JSON(status, response)
+}
+
 func (h *transactionsHandler) GetByID(ctx *gin.Context) {
-	id := ctx.Param("id")
-	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "ID is required",
-		})
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
 		return
 	}
 
-	entry, err := h.transactionsService.GetTransactionsEntryByID(id)
+	entry, err := h.transactionsService.GetTransactionsEntryByID(id, dateLayout(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to retrieve entry",
-			"details": err.Error(),
-		})
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveEntry, err)
 		return
 	}
 
+	ctx.Header("ETag", entityTag(entry.Version))
+	displayAmount(ctx, &entry)
 	ctx.JSON(http.StatusOK, entry)
 }
 
 func (h *transactionsHandler) GetTransactionDashboardData(ctx *gin.Context) {
-	data, err := h.transactionsService.GetTransactionDashboardData()
+	query, isValid := validators.ValidateGetTransactionDashboard(ctx)
+	if !isValid {
+		return
+	}
+
+	data, err := h.transactionsService.GetTransactionDashboardData(*query, dateLayout(ctx))
 
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to retrieve dashboard data",
-			"details": err.Error(),
-		})
+		writeError(ctx, dashboardErrorStatus(err), i18n.FailedToRetrieveDashboardData, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, data)
 }
+
+func transactionsFilterErrorStatus(err error) int {
+	if errors.Is(err, services.ErrInvalidDateRange) || errors.Is(err, services.ErrInvalidAmountRange) || errors.Is(err, services.ErrInvalidCursor) ||
+		errors.Is(err, filterql.ErrInvalidExpression) || errors.Is(err, dates.ErrInvalidDate) || isInvalidAmount(err) {
+		return http.StatusBadRequest
+	}
+
+	return errorStatus(err)
+}
+
+// isInvalidAmount reports whether err comes from parsing a money amount, which
+// in a query string is a malformed parameter rather than an invalid entity.
+func isInvalidAmount(err error) bool {
+	return errors.Is(err, money.ErrInvalidAmount) || errors.Is(err, money.ErrTooManyDecimals) || errors.Is(err, money.ErrAmountOutOfRange)
+}
+
+func transactionWriteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrVersionMismatch):
+		return http.StatusPreconditionFailed
+	default:
+		return errorStatus(err)
+	}
+}
+
+func entityTag(version int64) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(version, 10))
+}
+
+func batchSuccessStatus(op string) int {
+	if op == "create" {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func batchErrorStatus(op string, err error) (int, i18n.Code) {
+	switch {
+	case errors.Is(err, services.ErrInvalidOperation):
+		return http.StatusBadRequest, i18n.OperationValidationFailed
+	case errors.Is(err, services.ErrBatchAborted):
+		return http.StatusFailedDependency, i18n.OperationNotApplied
+	}
+
+	switch op {
+	case "create":
+		return errorStatus(err), i18n.FailedToCreateEntry
+	case "update":
+		return transactionWriteErrorStatus(err), i18n.FailedToUpdateEntry
+	default:
+		return transactionWriteErrorStatus(err), i18n.FailedToDeleteEntry
+	}
+}
+
+// batchProblem describes why a single batch operation failed. Operations
+// rejected by validation carry the pointers of their invalid fields.
+func batchProblem(locale i18n.Locale, result *dtos.BatchTransactionResultDTO) *dtos.ProblemDTO {
+	status, action := batchErrorStatus(result.Op, result.Err)
+	if result.ValidationErrors != nil {
+		problem := dtos.NewValidationProblem(locale, result.ValidationErrors)
+		return &problem
+	}
+
+	problem := dtos.NewProblem(locale, status, errorDetail(locale, action, result.Err))
+	return &problem
+}
+
+func transactionsPageBody(page dtos.TransactionsPageDTO, limit, skip int, filter dtos.TransactionsFilterDTO) gin.H {
+	return gin.H{
+		"data": page.Entries,
+		"pagination": gin.H{
+			"limit": limit,
+			"skip":  skip,
+			"count": len(page.Entries),
+			"total": page.Total,
+			"next":  page.Next,
+			"prev":  page.Prev,
+		},
+		"filters": filter,
+	}
+}
+
+func paginationLinks(requestURL *url.URL, page dtos.TransactionsPageDTO) string {
+	links := make([]string, 0, 2)
+
+	for _, link := range []struct{ rel, cursor string }{{"next", page.Next}, {"prev", page.Prev}} {
+		if link.cursor == "" {
+			continue
+		}
+
+		query := requestURL.Query()
+		query.Set("cursor", link.cursor)
+		query.Del("skip")
+
+		linkURL := url.URL{Path: requestURL.Path, RawQuery: query.Encode()}
+		links = append(links, fmt.Sprintf("<%s>; rel=\"%s\"", linkURL.String(), link.rel))
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func dashboardErrorStatus(err error) int {
+	if errors.Is(err, services.ErrInvalidDateRange) || errors.Is(err, dates.ErrInvalidDate) {
+		return http.StatusBadRequest
+	}
+
+	return errorStatus(err)
+}
@@ -4,11 +4,19 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
 	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/repository"
+	"myfin-api/internal/services"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -19,36 +27,55 @@ type MockTransactionsService struct {
 	mock.Mock
 }
 
-func (m *MockTransactionsService) CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error) {
-	args := m.Called(entry)
+func (m *MockTransactionsService) CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(entry, layout)
 	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
 }
 
-func (m *MockTransactionsService) GetAllTransactionsEntries(limit, skip int, titleFilter, categoryFilter string) ([]dtos.TransactionsEntryResponseDTO, error) {
-	args := m.Called(limit, skip, titleFilter, categoryFilter)
-	return args.Get(0).([]dtos.TransactionsEntryResponseDTO), args.Error(1)
+func (m *MockTransactionsService) GetAllTransactionsEntries(limit, skip int, filter dtos.TransactionsFilterDTO, layout string) (dtos.TransactionsPageDTO, error) {
+	args := m.Called(limit, skip, filter, layout)
+	return args.Get(0).(dtos.TransactionsPageDTO), args.Error(1)
 }
 
-func (m *MockTransactionsService) DeleteTransactionsEntry(id string) error {
-	args := m.Called(id)
+func (m *MockTransactionsService) DeleteTransactionsEntry(id string, ifMatch dtos.IfMatchDTO) error {
+	args := m.Called(id, ifMatch)
 	return args.Error(0)
 }
 
-func (m *MockTransactionsService) UpdateTransactionsEntry(id string, entry dtos.UpdateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error) {
-	args := m.Called(id, entry)
+func (m *MockTransactionsService) UpdateTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, entry dtos.UpdateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, ifMatch, entry, layout)
 	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
 }
 
-func (m *MockTransactionsService) GetTransactionsEntryByID(id string) (dtos.TransactionsEntryResponseDTO, error) {
-	args := m.Called(id)
+func (m *MockTransactionsService) PatchTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, patch dtos.PatchTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, ifMatch, patch, layout)
 	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
 }
 
-func (m *MockTransactionsService) GetTransactionDashboardData() (dtos.TransactionDashboardResponseDTO, error) {
-	args := m.Called()
+func (m *MockTransactionsService) BatchTransactionsEntries(batch dtos.BatchTransactionsDTO, layout string) (dtos.BatchTransactionsResponseDTO, error) {
+	args := m.Called(batch, layout)
+	return args.Get(0).(dtos.BatchTransactionsResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) GetTransactionsEntryByID(id string, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) GetTransactionDashboardData(query dtos.TransactionDashboardQueryDTO, layout string) (dtos.TransactionDashboardResponseDTO, error) {
+	args := m.Called(query, layout)
 	return args.Get(0).(dtos.TransactionDashboardResponseDTO), args.Error(1)
 }
 
+type MockIdempotencyService struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyService) CreateTransactionsEntry(key string, entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, bool, error) {
+	args := m.Called(key, entry, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Bool(1), args.Error(2)
+}
+
 func setupRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.Default()
@@ -58,7 +85,7 @@ func setupRouter() *gin.Engine {
 func TestSaveHandler(t *testing.T) {
 	t.Run("successful_creation", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.POST("/transactions", func(c *gin.Context) {
@@ -66,7 +93,7 @@ func TestSaveHandler(t *testing.T) {
 		})
 
 		validEntry := dtos.CreateTransactionsEntryDTO{
-			Amount:        100.0,
+			Amount:        json.Number("100.00"),
 			Title:         "Test Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -78,7 +105,7 @@ func TestSaveHandler(t *testing.T) {
 
 		expectedResponse := dtos.TransactionsEntryResponseDTO{
 			ID:            "123456789012345678901234",
-			Amount:        100.0,
+			Amount:        json.Number("100.00"),
 			Title:         "Test Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -91,7 +118,7 @@ func TestSaveHandler(t *testing.T) {
 			UpdatedAt:     "2025-03-15T10:30:00Z",
 		}
 
-		mockService.On("CreateTransactionsEntry", mock.AnythingOfType("dtos.CreateTransactionsEntryDTO")).Return(expectedResponse, nil)
+		mockService.On("CreateTransactionsEntry", mock.AnythingOfType("dtos.CreateTransactionsEntryDTO"), services.DateFormat).Return(expectedResponse, nil)
 
 		jsonPayload, _ := json.Marshal(validEntry)
 
@@ -115,7 +142,7 @@ func TestSaveHandler(t *testing.T) {
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.POST("/transactions", func(c *gin.Context) {
@@ -123,7 +150,7 @@ func TestSaveHandler(t *testing.T) {
 		})
 
 		validEntry := dtos.CreateTransactionsEntryDTO{
-			Amount:        100.0,
+			Amount:        json.Number("100.00"),
 			Title:         "Test Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -134,7 +161,7 @@ func TestSaveHandler(t *testing.T) {
 		}
 
 		expectedError := errors.New("database error")
-		mockService.On("CreateTransactionsEntry", mock.AnythingOfType("dtos.CreateTransactionsEntryDTO")).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		mockService.On("CreateTransactionsEntry", mock.AnythingOfType("dtos.CreateTransactionsEntryDTO"), services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
 
 		jsonPayload, _ := json.Marshal(validEntry)
 
@@ -146,17 +173,17 @@ func TestSaveHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, expectedError.Error(), response["error"])
+		assert.Equal(t, "Failed to create entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("invalid_request_body", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.POST("/transactions", func(c *gin.Context) {
@@ -177,10 +204,88 @@ func TestSaveHandler(t *testing.T) {
 	})
 }
 
+func TestSaveHandlerIdempotencyKey(t *testing.T) {
+	validEntry := dtos.CreateTransactionsEntryDTO{
+		Amount:        json.Number("100.00"),
+		Title:         "Test Entry",
+		Currency:      "USD",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "credit_card",
+		Date:          "15/03/2025",
+	}
+	jsonPayload, _ := json.Marshal(validEntry)
+
+	tests := []struct {
+		name             string
+		replayed         bool
+		err              error
+		expectedStatus   int
+		expectedReplayed string
+	}{
+		{name: "first_request", expectedStatus: http.StatusCreated},
+		{name: "replayed_request", replayed: true, expectedStatus: http.StatusCreated, expectedReplayed: "true"},
+		{name: "different_body", err: services.ErrIdempotencyKeyReused, expectedStatus: http.StatusUnprocessableEntity},
+		{name: "in_progress", err: services.ErrIdempotencyKeyInProgress, expectedStatus: http.StatusConflict},
+		{name: "service_error", err: errors.New("database connection failed"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockTransactionsService)
+			mockIdempotency := new(MockIdempotencyService)
+			handler := NewTransactionsHandler(mockService, mockIdempotency)
+			router := setupRouter()
+
+			router.POST("/transactions", func(c *gin.Context) {
+				handler.Save(c)
+			})
+
+			expectedResponse := dtos.TransactionsEntryResponseDTO{ID: "123456789012345678901234", Title: "Test Entry"}
+			mockIdempotency.On("CreateTransactionsEntry", "retry-123", validEntry, services.DateFormat).Return(expectedResponse, tt.replayed, tt.err)
+
+			req, _ := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotency-Key", " retry-123 ")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedReplayed, w.Header().Get("Idempotent-Replayed"))
+
+			mockIdempotency.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("key_too_long", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		mockIdempotency := new(MockIdempotencyService)
+		handler := NewTransactionsHandler(mockService, mockIdempotency)
+		router := setupRouter()
+
+		router.POST("/transactions", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		req, _ := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", strings.Repeat("k", 256))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockIdempotency.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+	})
+}
+
 func TestGetAllHandler(t *testing.T) {
 	t.Run("successful_retrieval", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions", func(c *gin.Context) {
@@ -190,7 +295,7 @@ func TestGetAllHandler(t *testing.T) {
 		expectedEntries := []dtos.TransactionsEntryResponseDTO{
 			{
 				ID:            "123456789012345678901234",
-				Amount:        100.0,
+				Amount:        json.Number("100.00"),
 				Title:         "Entry 1",
 				Currency:      "USD",
 				Type:          "expense",
@@ -204,7 +309,7 @@ func TestGetAllHandler(t *testing.T) {
 			},
 			{
 				ID:            "234567890123456789012345",
-				Amount:        200.0,
+				Amount:        json.Number("200.00"),
 				Title:         "Entry 2",
 				Currency:      "EUR",
 				Type:          "income",
@@ -218,7 +323,7 @@ func TestGetAllHandler(t *testing.T) {
 			},
 		}
 
-		mockService.On("GetAllTransactionsEntries", 10, 0, "", "").Return(expectedEntries, nil)
+		mockService.On("GetAllTransactionsEntries", 10, 0, dtos.TransactionsFilterDTO{}, services.DateFormat).Return(dtos.TransactionsPageDTO{Entries: expectedEntries, Total: 12}, nil)
 
 		req, _ := http.NewRequest("GET", "/transactions?limit=10&skip=0", nil)
 		w := httptest.NewRecorder()
@@ -240,13 +345,15 @@ func TestGetAllHandler(t *testing.T) {
 		assert.Equal(t, float64(10), pagination["limit"])
 		assert.Equal(t, float64(0), pagination["skip"])
 		assert.Equal(t, float64(2), pagination["count"])
+		assert.Equal(t, float64(12), pagination["total"])
+		assert.Empty(t, w.Header().Get("Link"))
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("with_filters", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions", func(c *gin.Context) {
@@ -256,7 +363,7 @@ func TestGetAllHandler(t *testing.T) {
 		filteredEntries := []dtos.TransactionsEntryResponseDTO{
 			{
 				ID:            "123456789012345678901234",
-				Amount:        100.0,
+				Amount:        json.Number("100.00"),
 				Title:         "Lunch",
 				Currency:      "USD",
 				Type:          "expense",
@@ -270,7 +377,7 @@ func TestGetAllHandler(t *testing.T) {
 			},
 		}
 
-		mockService.On("GetAllTransactionsEntries", 10, 0, "lunch", "food").Return(filteredEntries, nil)
+		mockService.On("GetAllTransactionsEntries", 10, 0, dtos.TransactionsFilterDTO{Title: "lunch", Category: "food"}, services.DateFormat).Return(dtos.TransactionsPageDTO{Entries: filteredEntries, Total: 1}, nil)
 
 		req, _ := http.NewRequest("GET", "/transactions?limit=10&skip=0&title=lunch&category=food", nil)
 		w := httptest.NewRecorder()
@@ -297,7 +404,7 @@ func TestGetAllHandler(t *testing.T) {
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions", func(c *gin.Context) {
@@ -305,7 +412,7 @@ func TestGetAllHandler(t *testing.T) {
 		})
 
 		expectedError := errors.New("database error")
-		mockService.On("GetAllTransactionsEntries", 10, 0, "", "").Return([]dtos.TransactionsEntryResponseDTO{}, expectedError)
+		mockService.On("GetAllTransactionsEntries", 10, 0, dtos.TransactionsFilterDTO{}, services.DateFormat).Return(dtos.TransactionsPageDTO{}, expectedError)
 
 		req, _ := http.NewRequest("GET", "/transactions?limit=10&skip=0", nil)
 		w := httptest.NewRecorder()
@@ -314,18 +421,261 @@ func TestGetAllHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "Failed to retrieve entries: "+expectedError.Error(), response.Detail)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("rich_filters", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		filter := dtos.TransactionsFilterDTO{
+			Category:  "food,transport",
+			Type:      "expense",
+			Currency:  "BRL",
+			From:      "01/09/2025",
+			MinAmount: json.Number("10.00"),
+		}
+
+		mockService.On("GetAllTransactionsEntries", 10, 0, filter, services.DateFormat).Return(dtos.TransactionsPageDTO{}, nil)
+
+		req, _ := http.NewRequest("GET", "/transactions?category=food,transport&type=expense&currency=BRL&from=01/09/2025&minAmount=10.00", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		filters, ok := response["filters"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "food,transport", filters["category"])
+		assert.Equal(t, "expense", filters["type"])
+		assert.Equal(t, "01/09/2025", filters["from"])
+		assert.Equal(t, 10.0, filters["minAmount"])
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("cursor_links", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		page := dtos.TransactionsPageDTO{
+			Entries: []dtos.TransactionsEntryResponseDTO{{ID: "123456789012345678901234"}},
+			Total:   30,
+			Next:    "next-token",
+			Prev:    "prev-token",
+		}
+
+		mockService.On("GetAllTransactionsEntries", 1, 0, dtos.TransactionsFilterDTO{Type: "expense", Cursor: "current"}, services.DateFormat).Return(page, nil)
+
+		req, _ := http.NewRequest("GET", "/transactions?limit=1&skip=5&type=expense&cursor=current", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t,
+			`</transactions?cursor=next-token&limit=1&type=expense>; rel="next", </transactions?cursor=prev-token&limit=1&type=expense>; rel="prev"`,
+			w.Header().Get("Link"),
+		)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		pagination, ok := response["pagination"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, float64(0), pagination["skip"])
+		assert.Equal(t, float64(30), pagination["total"])
+		assert.Equal(t, "next-token", pagination["next"])
+		assert.Equal(t, "prev-token", pagination["prev"])
+
+		filters, ok := response["filters"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.NotContains(t, filters, "cursor")
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("echoes_dates_in_requested_format", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+		router.Use(DateFormat)
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		filter := dtos.TransactionsFilterDTO{From: "01/09/2025", To: "2025-09-30"}
+		mockService.On("GetAllTransactionsEntries", 10, 0, filter, dates.ISOLayout).Return(dtos.TransactionsPageDTO{}, nil)
+
+		req, _ := http.NewRequest("GET", "/transactions?from=01/09/2025&to=2025-09-30&dateFormat=iso", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Filters dtos.TransactionsFilterDTO `json:"filters"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "2025-09-01", response.Filters.From)
+		assert.Equal(t, "2025-09-30", response.Filters.To)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("cursor_with_sort", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/transactions?cursor=abc&sort=amount", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAllTransactionsEntries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		mockService.On("GetAllTransactionsEntries", 10, 0, dtos.TransactionsFilterDTO{Cursor: "garbage"}, services.DateFormat).Return(dtos.TransactionsPageDTO{}, services.ErrInvalidCursor)
+
+		req, _ := http.NewRequest("GET", "/transactions?cursor=garbage", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_filter_expression", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		expectedError := &filterql.Error{Position: 8, Code: i18n.FilterInvalidAmount, Args: []any{`"ten"`, "amount"}}
+		mockService.On("GetAllTransactionsEntries", 10, 0, dtos.TransactionsFilterDTO{Filter: "amount > ten"}, services.DateFormat).Return(dtos.TransactionsPageDTO{}, expectedError)
+
+		req, _ := http.NewRequest("GET", "/transactions?filter=amount%20%3E%20ten", nil)
+		req.Header.Set("Accept-Language", "pt-BR")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `expressão de filtro inválida: valor \"ten\" inválido para amount na posição 8`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_filter", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/transactions?type=refund", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAllTransactionsEntries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_sort", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/transactions?sort=amount,-password", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to retrieve entries", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
 
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, "sort", response.Errors[0].Parameter)
+		assert.Contains(t, response.Errors[0].Detail, "amount, category, createdAt, date, title")
+
+		mockService.AssertNotCalled(t, "GetAllTransactionsEntries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("inverted_amount_range", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions", func(c *gin.Context) {
+			handler.GetAll(c)
+		})
+
+		filter := dtos.TransactionsFilterDTO{MinAmount: json.Number("100"), MaxAmount: json.Number("10")}
+		mockService.On("GetAllTransactionsEntries", 10, 0, filter, services.DateFormat).Return(dtos.TransactionsPageDTO{}, services.ErrInvalidAmountRange)
+
+		req, _ := http.NewRequest("GET", "/transactions?minAmount=100&maxAmount=10", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("invalid_pagination_params", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions", func(c *gin.Context) {
@@ -346,7 +696,7 @@ func TestGetAllHandler(t *testing.T) {
 func TestDeleteHandler(t *testing.T) {
 	t.Run("successful_deletion", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.DELETE("/transactions/:id", func(c *gin.Context) {
@@ -355,9 +705,10 @@ func TestDeleteHandler(t *testing.T) {
 
 		validID := "123456789012345678901234"
 
-		mockService.On("DeleteTransactionsEntry", validID).Return(nil)
+		mockService.On("DeleteTransactionsEntry", validID, dtos.IfMatchVersion(1)).Return(nil)
 
 		req, _ := http.NewRequest("DELETE", "/transactions/"+validID, nil)
+		req.Header.Set("If-Match", `"1"`)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
@@ -375,7 +726,7 @@ func TestDeleteHandler(t *testing.T) {
 
 	t.Run("missing_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.DELETE("/transactions/:id", func(c *gin.Context) {
@@ -394,7 +745,7 @@ func TestDeleteHandler(t *testing.T) {
 
 	t.Run("empty_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.DELETE("/transactions/", func(c *gin.Context) {
@@ -409,17 +760,17 @@ func TestDeleteHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "ID is required", response["error"])
+		assert.Equal(t, []dtos.ProblemFieldErrorDTO{{Parameter: "id", Detail: "ID is required"}}, response.Errors)
 
 		mockService.AssertNotCalled(t, "DeleteTransactionsEntry")
 	})
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.DELETE("/transactions/:id", func(c *gin.Context) {
@@ -429,27 +780,27 @@ func TestDeleteHandler(t *testing.T) {
 		validID := "123456789012345678901234"
 
 		expectedError := errors.New("database error")
-		mockService.On("DeleteTransactionsEntry", validID).Return(expectedError)
+		mockService.On("DeleteTransactionsEntry", validID, dtos.IfMatchVersion(1)).Return(expectedError)
 
 		req, _ := http.NewRequest("DELETE", "/transactions/"+validID, nil)
+		req.Header.Set("If-Match", `"1"`)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to delete entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to delete entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("invalid_id_format", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.DELETE("/transactions/:id", func(c *gin.Context) {
@@ -458,30 +809,79 @@ func TestDeleteHandler(t *testing.T) {
 
 		invalidID := "invalid-id-format"
 
-		expectedError := errors.New("the provided hex string is not a valid ObjectID")
-		mockService.On("DeleteTransactionsEntry", invalidID).Return(expectedError)
+		expectedError := fmt.Errorf("%w: %q", domain.ErrInvalidID, invalidID)
+		mockService.On("DeleteTransactionsEntry", invalidID, dtos.IfMatchVersion(1)).Return(expectedError)
 
 		req, _ := http.NewRequest("DELETE", "/transactions/"+invalidID, nil)
+		req.Header.Set("If-Match", `"1"`)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to delete entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to delete entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 }
 
+func TestDeleteHandlerIfMatch(t *testing.T) {
+	validID := "123456789012345678901234"
+
+	tests := []struct {
+		name            string
+		ifMatch         string
+		expectedIfMatch dtos.IfMatchDTO
+		serviceErr      error
+		expectedStatus  int
+	}{
+		{name: "missing_header", expectedStatus: http.StatusPreconditionRequired},
+		{name: "unquoted_etag", ifMatch: "1", expectedStatus: http.StatusBadRequest},
+		{name: "weak_etag", ifMatch: `W/"1"`, expectedIfMatch: dtos.IfMatchDTO{}, serviceErr: services.ErrVersionMismatch, expectedStatus: http.StatusPreconditionFailed},
+		{name: "stale_version", ifMatch: `"1"`, expectedIfMatch: dtos.IfMatchVersion(1), serviceErr: services.ErrVersionMismatch, expectedStatus: http.StatusPreconditionFailed},
+		{name: "etag_list", ifMatch: `"1", "2"`, expectedIfMatch: dtos.IfMatchDTO{Versions: []int64{1, 2}}, expectedStatus: http.StatusOK},
+		{name: "wildcard", ifMatch: "*", expectedIfMatch: dtos.IfMatchDTO{Any: true}, expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockTransactionsService)
+			handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+			router := setupRouter()
+
+			router.DELETE("/transactions/:id", func(c *gin.Context) {
+				handler.Delete(c)
+			})
+
+			if tt.expectedStatus != http.StatusPreconditionRequired && tt.expectedStatus != http.StatusBadRequest {
+				mockService.On("DeleteTransactionsEntry", validID, tt.expectedIfMatch).Return(tt.serviceErr)
+			}
+
+			req, _ := http.NewRequest("DELETE", "/transactions/"+validID, nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusPreconditionRequired || tt.expectedStatus == http.StatusBadRequest {
+				mockService.AssertNotCalled(t, "DeleteTransactionsEntry", mock.Anything, mock.Anything)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestUpdateHandler(t *testing.T) {
 	t.Run("successful_update", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/:id", func(c *gin.Context) {
@@ -491,7 +891,7 @@ func TestUpdateHandler(t *testing.T) {
 		validID := "123456789012345678901234"
 
 		validEntry := dtos.UpdateTransactionsEntryDTO{
-			Amount:        200.50,
+			Amount:        json.Number("200.50"),
 			Title:         "Updated Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -503,7 +903,7 @@ func TestUpdateHandler(t *testing.T) {
 
 		expectedResponse := dtos.TransactionsEntryResponseDTO{
 			ID:            validID,
-			Amount:        200.50,
+			Amount:        json.Number("200.50"),
 			Title:         "Updated Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -516,11 +916,12 @@ func TestUpdateHandler(t *testing.T) {
 			UpdatedAt:     "2025-10-15T10:30:00Z",
 		}
 
-		mockService.On("UpdateTransactionsEntry", validID, mock.AnythingOfType("dtos.UpdateTransactionsEntryDTO")).Return(expectedResponse, nil)
+		mockService.On("UpdateTransactionsEntry", validID, dtos.IfMatchVersion(1), mock.AnythingOfType("dtos.UpdateTransactionsEntryDTO"), services.DateFormat).Return(expectedResponse, nil)
 
 		jsonPayload, _ := json.Marshal(validEntry)
 
 		req, _ := http.NewRequest("PUT", "/transactions/"+validID, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("If-Match", `"1"`)
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
@@ -551,7 +952,7 @@ func TestUpdateHandler(t *testing.T) {
 
 	t.Run("invalid_request_body", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/:id", func(c *gin.Context) {
@@ -563,6 +964,7 @@ func TestUpdateHandler(t *testing.T) {
 		invalidJSON := []byte(`{"amount": "invalid", "title": 123}`)
 
 		req, _ := http.NewRequest("PUT", "/transactions/"+validID, bytes.NewBuffer(invalidJSON))
+		req.Header.Set("If-Match", `"1"`)
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
@@ -575,7 +977,7 @@ func TestUpdateHandler(t *testing.T) {
 
 	t.Run("missing_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/:id", func(c *gin.Context) {
@@ -583,7 +985,7 @@ func TestUpdateHandler(t *testing.T) {
 		})
 
 		validEntry := dtos.UpdateTransactionsEntryDTO{
-			Amount:        200.50,
+			Amount:        json.Number("200.50"),
 			Title:         "Updated Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -608,7 +1010,7 @@ func TestUpdateHandler(t *testing.T) {
 
 	t.Run("empty_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/", func(c *gin.Context) {
@@ -617,7 +1019,7 @@ func TestUpdateHandler(t *testing.T) {
 		})
 
 		validEntry := dtos.UpdateTransactionsEntryDTO{
-			Amount:        200.50,
+			Amount:        json.Number("200.50"),
 			Title:         "Updated Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -642,7 +1044,7 @@ func TestUpdateHandler(t *testing.T) {
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/:id", func(c *gin.Context) {
@@ -652,7 +1054,7 @@ func TestUpdateHandler(t *testing.T) {
 		validID := "123456789012345678901234"
 
 		validEntry := dtos.UpdateTransactionsEntryDTO{
-			Amount:        200.50,
+			Amount:        json.Number("200.50"),
 			Title:         "Updated Entry",
 			Currency:      "USD",
 			Type:          "expense",
@@ -663,11 +1065,12 @@ func TestUpdateHandler(t *testing.T) {
 		}
 
 		expectedError := errors.New("database error")
-		mockService.On("UpdateTransactionsEntry", validID, mock.AnythingOfType("dtos.UpdateTransactionsEntryDTO")).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		mockService.On("UpdateTransactionsEntry", validID, dtos.IfMatchVersion(1), mock.AnythingOfType("dtos.UpdateTransactionsEntryDTO"), services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
 
 		jsonPayload, _ := json.Marshal(validEntry)
 
 		req, _ := http.NewRequest("PUT", "/transactions/"+validID, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("If-Match", `"1"`)
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
@@ -675,18 +1078,17 @@ func TestUpdateHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to update entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to update entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("invalid_date_format", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.PUT("/transactions/:id", func(c *gin.Context) {
@@ -703,12 +1105,13 @@ func TestUpdateHandler(t *testing.T) {
 			"category":      "entertainment",
 			"paymentMethod": "debit_card",
 			"description":   "Updated description",
-			"date":          "2025-10-15", // Wrong format, should be DD/MM/YYYY
+			"date":          "15-10-2025", // Wrong format, should be DD/MM/YYYY or YYYY-MM-DD
 		}
 
 		jsonPayload, _ := json.Marshal(invalidEntry)
 
 		req, _ := http.NewRequest("PUT", "/transactions/"+validID, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("If-Match", `"1"`)
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
@@ -716,19 +1119,154 @@ func TestUpdateHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Contains(t, response["error"], "Date must be in format")
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, "/date", response.Errors[0].Pointer)
+		assert.Contains(t, response.Errors[0].Detail, "Date must be in format")
 
 		mockService.AssertNotCalled(t, "UpdateTransactionsEntry")
 	})
 }
 
+func TestPatchHandler(t *testing.T) {
+	validID := "123456789012345678901234"
+
+	t.Run("successful_patch", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.PATCH("/transactions/:id", func(c *gin.Context) {
+			handler.Patch(c)
+		})
+
+		title := "Groceries"
+		expectedPatch := dtos.PatchTransactionsEntryDTO{Title: &title, Remove: []string{"description"}}
+		expectedResponse := dtos.TransactionsEntryResponseDTO{
+			ID:       validID,
+			Amount:   json.Number("200.50"),
+			Title:    "Groceries",
+			Currency: "USD",
+			Type:     "expense",
+			Date:     "15/10/2025",
+			Version:  2,
+		}
+
+		mockService.On("PatchTransactionsEntry", validID, dtos.IfMatchVersion(1), expectedPatch, services.DateFormat).Return(expectedResponse, nil)
+
+		req, _ := http.NewRequest("PATCH", "/transactions/"+validID, bytes.NewBufferString(`{"title":"Groceries","description":null}`))
+		req.Header.Set("If-Match", `"1"`)
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "Entry updated successfully", response["message"])
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Groceries", data["title"])
+		assert.Equal(t, float64(2), data["version"])
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unsupported_content_type", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.PATCH("/transactions/:id", func(c *gin.Context) {
+			handler.Patch(c)
+		})
+
+		req, _ := http.NewRequest("PATCH", "/transactions/"+validID, bytes.NewBufferString(`title=Groceries`))
+		req.Header.Set("If-Match", `"1"`)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		mockService.AssertNotCalled(t, "PatchTransactionsEntry", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("null_on_required_field", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.PATCH("/transactions/:id", func(c *gin.Context) {
+			handler.Patch(c)
+		})
+
+		req, _ := http.NewRequest("PATCH", "/transactions/"+validID, bytes.NewBufferString(`{"amount":null}`))
+		req.Header.Set("If-Match", `"1"`)
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "PatchTransactionsEntry", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("service_errors", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			err            error
+			expectedStatus int
+		}{
+			{name: "amount_required", err: services.ErrAmountRequired, expectedStatus: http.StatusUnprocessableEntity},
+			{name: "transfer_leg", err: services.ErrTransferLeg, expectedStatus: http.StatusUnprocessableEntity},
+			{name: "version_mismatch", err: services.ErrVersionMismatch, expectedStatus: http.StatusPreconditionFailed},
+			{name: "internal", err: errors.New("database connection failed"), expectedStatus: http.StatusInternalServerError},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockService := new(MockTransactionsService)
+				handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+				router := setupRouter()
+
+				router.PATCH("/transactions/:id", func(c *gin.Context) {
+					handler.Patch(c)
+				})
+
+				mockService.On("PatchTransactionsEntry", validID, dtos.IfMatchVersion(1), mock.AnythingOfType("dtos.PatchTransactionsEntryDTO"), services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, tt.err)
+
+				req, _ := http.NewRequest("PATCH", "/transactions/"+validID, bytes.NewBufferString(`{"currency":"JPY"}`))
+				req.Header.Set("If-Match", `"1"`)
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				assert.Equal(t, tt.expectedStatus, w.Code)
+
+				var response dtos.ProblemDTO
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Contains(t, response.Detail, "Failed to update entry")
+
+				mockService.AssertExpectations(t)
+			})
+		}
+	})
+}
+
 func TestGetByIDHandler(t *testing.T) {
 	t.Run("successful_retrieval", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/:id", func(c *gin.Context) {
@@ -739,7 +1277,7 @@ func TestGetByIDHandler(t *testing.T) {
 
 		expectedResponse := dtos.TransactionsEntryResponseDTO{
 			ID:            validID,
-			Amount:        150.75,
+			Amount:        json.Number("150.75"),
 			Title:         "Lunch at restaurant",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -750,9 +1288,10 @@ func TestGetByIDHandler(t *testing.T) {
 			Timestamp:     1234567890,
 			CreatedAt:     "2025-09-06T14:30:00Z",
 			UpdatedAt:     "2025-09-06T14:30:00Z",
+			Version:       4,
 		}
 
-		mockService.On("GetTransactionsEntryByID", validID).Return(expectedResponse, nil)
+		mockService.On("GetTransactionsEntryByID", validID, services.DateFormat).Return(expectedResponse, nil)
 
 		req, _ := http.NewRequest("GET", "/transactions/"+validID, nil)
 		w := httptest.NewRecorder()
@@ -760,6 +1299,7 @@ func TestGetByIDHandler(t *testing.T) {
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
 
 		var response dtos.TransactionsEntryResponseDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -782,7 +1322,7 @@ func TestGetByIDHandler(t *testing.T) {
 
 	t.Run("missing_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/:id", func(c *gin.Context) {
@@ -801,7 +1341,7 @@ func TestGetByIDHandler(t *testing.T) {
 
 	t.Run("empty_id", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/", func(c *gin.Context) {
@@ -816,17 +1356,17 @@ func TestGetByIDHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "ID is required", response["error"])
+		assert.Equal(t, []dtos.ProblemFieldErrorDTO{{Parameter: "id", Detail: "ID is required"}}, response.Errors)
 
 		mockService.AssertNotCalled(t, "GetTransactionsEntryByID")
 	})
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/:id", func(c *gin.Context) {
@@ -836,7 +1376,7 @@ func TestGetByIDHandler(t *testing.T) {
 		validID := "123456789012345678901234"
 
 		expectedError := errors.New("database error")
-		mockService.On("GetTransactionsEntryByID", validID).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		mockService.On("GetTransactionsEntryByID", validID, services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
 
 		req, _ := http.NewRequest("GET", "/transactions/"+validID, nil)
 		w := httptest.NewRecorder()
@@ -845,18 +1385,17 @@ func TestGetByIDHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to retrieve entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to retrieve entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("not_found_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/:id", func(c *gin.Context) {
@@ -865,28 +1404,27 @@ func TestGetByIDHandler(t *testing.T) {
 
 		validID := "123456789012345678901234"
 
-		expectedError := errors.New("entry not found")
-		mockService.On("GetTransactionsEntryByID", validID).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		expectedError := domain.NewError(domain.ErrNotFound, i18n.TransactionNotFound)
+		mockService.On("GetTransactionsEntryByID", validID, services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
 
 		req, _ := http.NewRequest("GET", "/transactions/"+validID, nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, http.StatusNotFound, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to retrieve entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to retrieve entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("invalid_id_format", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/:id", func(c *gin.Context) {
@@ -895,21 +1433,20 @@ func TestGetByIDHandler(t *testing.T) {
 
 		invalidID := "invalid-id-format"
 
-		expectedError := errors.New("the provided hex string is not a valid ObjectID")
-		mockService.On("GetTransactionsEntryByID", invalidID).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		expectedError := fmt.Errorf("%w: %q", domain.ErrInvalidID, invalidID)
+		mockService.On("GetTransactionsEntryByID", invalidID, services.DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
 
 		req, _ := http.NewRequest("GET", "/transactions/"+invalidID, nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to retrieve entry", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to retrieve entry: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
@@ -918,7 +1455,7 @@ func TestGetByIDHandler(t *testing.T) {
 func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/dashboard", func(c *gin.Context) {
@@ -926,14 +1463,19 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 		})
 
 		expectedResponse := dtos.TransactionDashboardResponseDTO{
-			IncomeAmount:  1000.50,
-			ExpenseAmount: 250.75,
-			TotalAmount:   749.75,
+			Currency:      "BRL",
+			IncomeAmount:  json.Number("1000.50"),
+			ExpenseAmount: json.Number("250.75"),
+			TotalAmount:   json.Number("749.75"),
+			Totals: []dtos.DashboardCurrencyTotalDTO{
+				{Currency: "BRL", IncomeAmount: json.Number("1000.50"), ExpenseAmount: json.Number("200.00"), TotalAmount: json.Number("800.50")},
+				{Currency: "USD", IncomeAmount: json.Number("0.00"), ExpenseAmount: json.Number("10.00"), TotalAmount: json.Number("-10.00")},
+			},
 		}
 
-		mockService.On("GetTransactionDashboardData").Return(expectedResponse, nil)
+		mockService.On("GetTransactionDashboardData", dtos.TransactionDashboardQueryDTO{Currency: "BRL"}, services.DateFormat).Return(expectedResponse, nil)
 
-		req, _ := http.NewRequest("GET", "/transactions/dashboard", nil)
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?currency=BRL", nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
@@ -946,13 +1488,14 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 		assert.Equal(t, expectedResponse.IncomeAmount, response.IncomeAmount)
 		assert.Equal(t, expectedResponse.ExpenseAmount, response.ExpenseAmount)
 		assert.Equal(t, expectedResponse.TotalAmount, response.TotalAmount)
+		assert.Equal(t, expectedResponse.Totals, response.Totals)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("service_error", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/dashboard", func(c *gin.Context) {
@@ -960,7 +1503,7 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 		})
 
 		expectedError := errors.New("database connection failed")
-		mockService.On("GetTransactionDashboardData").Return(dtos.TransactionDashboardResponseDTO{}, expectedError)
+		mockService.On("GetTransactionDashboardData", dtos.TransactionDashboardQueryDTO{}, services.DateFormat).Return(dtos.TransactionDashboardResponseDTO{}, expectedError)
 
 		req, _ := http.NewRequest("GET", "/transactions/dashboard", nil)
 		w := httptest.NewRecorder()
@@ -969,18 +1512,17 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-		var response map[string]string
+		var response dtos.ProblemDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "Failed to retrieve dashboard data", response["error"])
-		assert.Equal(t, expectedError.Error(), response["details"])
+		assert.Equal(t, "Failed to retrieve dashboard data: "+expectedError.Error(), response.Detail)
 
 		mockService.AssertExpectations(t)
 	})
 
 	t.Run("empty_dashboard_data", func(t *testing.T) {
 		mockService := new(MockTransactionsService)
-		handler := NewTransactionsHandler(mockService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
 		router := setupRouter()
 
 		router.GET("/transactions/dashboard", func(c *gin.Context) {
@@ -988,12 +1530,11 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 		})
 
 		expectedResponse := dtos.TransactionDashboardResponseDTO{
-			IncomeAmount:  0.0,
-			ExpenseAmount: 0.0,
-			TotalAmount:   0.0,
+			Totals:   []dtos.DashboardCurrencyTotalDTO{},
+			Accounts: []dtos.AccountBalanceResponseDTO{},
 		}
 
-		mockService.On("GetTransactionDashboardData").Return(expectedResponse, nil)
+		mockService.On("GetTransactionDashboardData", dtos.TransactionDashboardQueryDTO{}, services.DateFormat).Return(expectedResponse, nil)
 
 		req, _ := http.NewRequest("GET", "/transactions/dashboard", nil)
 		w := httptest.NewRecorder()
@@ -1002,13 +1543,341 @@ func TestTransactionsHandlerGetTransactionDashboardData(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response dtos.TransactionDashboardResponseDTO
+		assert.JSONEq(t, `{"totals": [], "accounts": []}`, w.Body.String())
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_currency", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions/dashboard", func(c *gin.Context) {
+			handler.GetTransactionDashboardData(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?currency=REAL", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetTransactionDashboardData", mock.Anything, mock.Anything)
+	})
+
+	t.Run("period_filter", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions/dashboard", func(c *gin.Context) {
+			handler.GetTransactionDashboardData(c)
+		})
+
+		expectedResponse := dtos.TransactionDashboardResponseDTO{
+			From:   "01/09/2025",
+			To:     "30/09/2025",
+			Totals: []dtos.DashboardCurrencyTotalDTO{},
+		}
+
+		mockService.On("GetTransactionDashboardData", dtos.TransactionDashboardQueryDTO{Period: "this-month"}, services.DateFormat).Return(expectedResponse, nil)
+
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?period=this-month", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"from": "01/09/2025", "to": "30/09/2025", "totals": [], "accounts": null}`, w.Body.String())
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("period_with_dates", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions/dashboard", func(c *gin.Context) {
+			handler.GetTransactionDashboardData(c)
+		})
+
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?period=ytd&to=30/09/2025", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetTransactionDashboardData", mock.Anything, mock.Anything)
+	})
+
+	t.Run("inverted_date_range", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions/dashboard", func(c *gin.Context) {
+			handler.GetTransactionDashboardData(c)
+		})
+
+		query := dtos.TransactionDashboardQueryDTO{From: "30/09/2025", To: "01/09/2025"}
+		mockService.On("GetTransactionDashboardData", query, services.DateFormat).Return(dtos.TransactionDashboardResponseDTO{}, services.ErrInvalidDateRange)
+
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?from=30/09/2025&to=01/09/2025", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing_exchange_rate", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.GET("/transactions/dashboard", func(c *gin.Context) {
+			handler.GetTransactionDashboardData(c)
+		})
+
+		expectedError := fmt.Errorf("%w: EUR to BRL", services.ErrExchangeRateNotFound)
+		mockService.On("GetTransactionDashboardData", dtos.TransactionDashboardQueryDTO{Currency: "BRL"}, services.DateFormat).Return(dtos.TransactionDashboardResponseDTO{}, expectedError)
+
+		req, _ := http.NewRequest("GET", "/transactions/dashboard?currency=BRL", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestBatchHandler(t *testing.T) {
+	deleteBody := `{"operations":[{"op":"delete","id":"507f1f77bcf86cd799439011","version":1},{"op":"delete","id":"507f1f77bcf86cd799439012","version":3}]}`
+
+	t.Run("all_operations_succeed", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.AnythingOfType("dtos.BatchTransactionsDTO"), services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{
+			Succeeded: 2,
+			Results: []dtos.BatchTransactionResultDTO{
+				{Index: 0, Op: "create", ID: "507f1f77bcf86cd799439013", Data: &dtos.TransactionsEntryResponseDTO{ID: "507f1f77bcf86cd799439013", Title: "Coffee"}},
+				{Index: 1, Op: "delete", ID: "507f1f77bcf86cd799439011"},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("POST", "/transactions/batch", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.BatchTransactionsResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Succeeded)
+		assert.Equal(t, http.StatusCreated, response.Results[0].Status)
+		assert.Equal(t, "Coffee", response.Results[0].Data.Title)
+		assert.Equal(t, http.StatusOK, response.Results[1].Status)
+		assert.Empty(t, response.Results[1].Error)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("partial_failure_returns_multi_status", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.MatchedBy(func(batch dtos.BatchTransactionsDTO) bool {
+			return !batch.Atomic && len(batch.Operations) == 2
+		}), services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{
+			Succeeded: 1,
+			Failed:    1,
+			Results: []dtos.BatchTransactionResultDTO{
+				{Index: 0, Op: "delete", ID: "507f1f77bcf86cd799439011"},
+				{Index: 1, Op: "delete", ID: "507f1f77bcf86cd799439012", Err: errors.New("database connection failed")},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("POST", "/transactions/batch", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMultiStatus, w.Code)
+
+		var response dtos.BatchTransactionsResponseDTO
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, 0.0, response.IncomeAmount)
-		assert.Equal(t, 0.0, response.ExpenseAmount)
-		assert.Equal(t, 0.0, response.TotalAmount)
+		assert.Equal(t, http.StatusInternalServerError, response.Results[1].Status)
+		assert.Equal(t, http.StatusInternalServerError, response.Results[1].Error.Status)
+		assert.Equal(t, "Failed to delete entry: database connection failed", response.Results[1].Error.Detail)
+
+		mockService.AssertExpectations(t)
+	})
 
+	t.Run("stale_version_returns_precondition_failed", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.MatchedBy(func(batch dtos.BatchTransactionsDTO) bool {
+			return batch.Operations[0].Version == 1 && batch.Operations[1].Version == 3
+		}), services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{
+			Succeeded: 1,
+			Failed:    1,
+			Results: []dtos.BatchTransactionResultDTO{
+				{Index: 0, Op: "delete", ID: "507f1f77bcf86cd799439011"},
+				{Index: 1, Op: "delete", ID: "507f1f77bcf86cd799439012", Err: services.ErrVersionMismatch},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("POST", "/transactions/batch", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMultiStatus, w.Code)
+
+		var response dtos.BatchTransactionsResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, response.Results[1].Status)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("atomic_failure_uses_failing_operation_status", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.MatchedBy(func(batch dtos.BatchTransactionsDTO) bool {
+			return batch.Atomic
+		}), services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{
+			Atomic: true,
+			Failed: 2,
+			Results: []dtos.BatchTransactionResultDTO{
+				{Index: 0, Op: "delete", ID: "507f1f77bcf86cd799439011", Err: services.ErrBatchAborted},
+				{Index: 1, Op: "update", ID: "507f1f77bcf86cd799439012", Err: services.ErrInvalidOperation, ValidationErrors: []dtos.ProblemFieldErrorDTO{{Pointer: "/operations/1/data/title", Detail: "Title is required"}}},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("POST", "/transactions/batch?atomic=true", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		results := response["results"].([]interface{})
+		aborted := results[0].(map[string]interface{})
+		invalid := results[1].(map[string]interface{})
+		assert.Equal(t, float64(http.StatusFailedDependency), aborted["status"])
+		assert.Equal(t, float64(http.StatusBadRequest), invalid["status"])
+		problem := invalid["error"].(map[string]interface{})
+		assert.Equal(t, float64(http.StatusBadRequest), problem["status"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"pointer": "/operations/1/data/title", "detail": "Title is required"},
+		}, problem["errors"])
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid_envelope", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		req, _ := http.NewRequest("POST", "/transactions/batch", bytes.NewBufferString(`{"operations":[]}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "BatchTransactionsEntries", mock.Anything, mock.Anything)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.Anything, services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{}, errors.New("connection reset"))
+
+		req, _ := http.NewRequest("POST", "/transactions/batch?atomic=true", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("transactions_unsupported", func(t *testing.T) {
+		mockService := new(MockTransactionsService)
+		handler := NewTransactionsHandler(mockService, new(MockIdempotencyService))
+		router := setupRouter()
+
+		router.POST("/transactions/batch", func(c *gin.Context) {
+			handler.Batch(c)
+		})
+
+		mockService.On("BatchTransactionsEntries", mock.Anything, services.DateFormat).Return(dtos.BatchTransactionsResponseDTO{}, repository.ErrTransactionsUnsupported)
+
+		req, _ := http.NewRequest("POST", "/transactions/batch?atomic=true", bytes.NewBufferString(deleteBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotImplemented, w.Code)
+
+		var response dtos.ProblemDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "Failed to apply batch: "+repository.ErrTransactionsUnsupported.Error(), response.Detail)
 		mockService.AssertExpectations(t)
 	})
 }
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"myfin-api/internal/dtos/validators"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransfersHandler interface {
+	Save(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type transfersHandler struct {
+	transfersService services.TransfersService
+}
+
+func NewTransfersHandler(transfersService services.TransfersService) TransfersHandler {
+	return &transfersHandler{
+		transfersService: transfersService,
+	}
+}
+
+func (h *transfersHandler) Save(ctx *gin.Context) {
+	transfer, isValid := validators.ValidateCreateTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.transfersService.CreateTransfer(*transfer, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToCreateTransfer, err)
+		return
+	}
+
+	ctx.Header("ETag", entityTag(response.Outgoing.Version))
+	displayTransferAmounts(ctx, &response)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *transfersHandler) GetByID(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	transfer, err := h.transfersService.GetTransferByID(id, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, errorStatus(err), i18n.FailedToRetrieveTransfer, err)
+		return
+	}
+
+	ctx.Header("ETag", entityTag(transfer.Outgoing.Version))
+	displayTransferAmounts(ctx, &transfer)
+	ctx.JSON(http.StatusOK, transfer)
+}
+
+func (h *transfersHandler) Update(ctx *gin.Context) {
+	transfer, id, isValid := validators.ValidateUpdateTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	ifMatch, isValid := validators.ValidateIfMatch(ctx)
+	if !isValid {
+		return
+	}
+
+	response, err := h.transfersService.UpdateTransfer(id, ifMatch, *transfer, dateLayout(ctx))
+	if err != nil {
+		writeError(ctx, transactionWriteErrorStatus(err), i18n.FailedToUpdateTransfer, err)
+		return
+	}
+
+	ctx.Header("ETag", entityTag(response.Outgoing.Version))
+	displayTransferAmounts(ctx, &response)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.TransferUpdated),
+		"data":    response,
+	})
+}
+
+func (h *transfersHandler) Delete(ctx *gin.Context) {
+	id, isValid := validators.ValidateID(ctx)
+	if !isValid {
+		return
+	}
+
+	ifMatch, isValid := validators.ValidateIfMatch(ctx)
+	if !isValid {
+		return
+	}
+
+	err := h.transfersService.DeleteTransfer(id, ifMatch)
+	if err != nil {
+		writeError(ctx, transactionWriteErrorStatus(err), i18n.FailedToDeleteTransfer, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": i18n.Message(validators.Locale(ctx), i18n.TransferDeleted),
+		"id":      id,
+	})
+}
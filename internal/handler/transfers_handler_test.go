@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransfersService struct {
+	mock.Mock
+}
+
+func (m *MockTransfersService) CreateTransfer(transfer dtos.CreateTransferDTO, layout string) (dtos.TransferResponseDTO, error) {
+	args := m.Called(transfer, layout)
+	return args.Get(0).(dtos.TransferResponseDTO), args.Error(1)
+}
+
+func (m *MockTransfersService) GetTransferByID(id string, layout string) (dtos.TransferResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.TransferResponseDTO), args.Error(1)
+}
+
+func (m *MockTransfersService) UpdateTransfer(id string, ifMatch dtos.IfMatchDTO, transfer dtos.UpdateTransferDTO, layout string) (dtos.TransferResponseDTO, error) {
+	args := m.Called(id, ifMatch, transfer, layout)
+	return args.Get(0).(dtos.TransferResponseDTO), args.Error(1)
+}
+
+func (m *MockTransfersService) DeleteTransfer(id string, ifMatch dtos.IfMatchDTO) error {
+	args := m.Called(id, ifMatch)
+	return args.Error(0)
+}
+
+func TestTransfersHandlerSave(t *testing.T) {
+	validTransfer := dtos.CreateTransferDTO{
+		FromAccountID: "650000000000000000000001",
+		ToAccountID:   "650000000000000000000002",
+		Amount:        json.Number("250.00"),
+		Date:          "10/09/2025",
+	}
+
+	t.Run("successful_creation", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/transfers", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		expectedResponse := dtos.TransferResponseDTO{
+			ID:       "650000000000000000000010",
+			Outgoing: dtos.TransactionsEntryResponseDTO{ID: "650000000000000000000010", Amount: json.Number("250.00"), Currency: "BRL", TransferDirection: "out"},
+			Incoming: dtos.TransactionsEntryResponseDTO{ID: "650000000000000000000011", Amount: json.Number("250.00"), Currency: "BRL", TransferDirection: "in"},
+		}
+
+		mockService.On("CreateTransfer", validTransfer, services.DateFormat).Return(expectedResponse, nil)
+
+		jsonPayload, _ := json.Marshal(validTransfer)
+		req, _ := http.NewRequest("POST", "/transfers", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response dtos.TransferResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		expectedResponse.Outgoing.AmountDisplay = "R$ 250.00"
+		expectedResponse.Incoming.AmountDisplay = "R$ 250.00"
+		assert.Equal(t, expectedResponse, response)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("same_account", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/transfers", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		invalidTransfer := validTransfer
+		invalidTransfer.ToAccountID = invalidTransfer.FromAccountID
+
+		jsonPayload, _ := json.Marshal(invalidTransfer)
+		req, _ := http.NewRequest("POST", "/transfers", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("currency_mismatch", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.POST("/transfers", func(c *gin.Context) {
+			handler.Save(c)
+		})
+
+		mockService.On("CreateTransfer", validTransfer, services.DateFormat).Return(dtos.TransferResponseDTO{}, services.ErrTransferCurrencyMismatch)
+
+		jsonPayload, _ := json.Marshal(validTransfer)
+		req, _ := http.NewRequest("POST", "/transfers", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestTransfersHandlerDelete(t *testing.T) {
+	t.Run("successful_deletion", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/transfers/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "650000000000000000000010"
+		mockService.On("DeleteTransfer", id, dtos.IfMatchVersion(1)).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/transfers/"+id, nil)
+		req.Header.Set("If-Match", `"1"`)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/transfers/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "650000000000000000000010"
+		mockService.On("DeleteTransfer", id, dtos.IfMatchVersion(1)).Return(errors.New("database error"))
+
+		req, _ := http.NewRequest("DELETE", "/transfers/"+id, nil)
+		req.Header.Set("If-Match", `"1"`)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing_if_match", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/transfers/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		req, _ := http.NewRequest("DELETE", "/transfers/650000000000000000000010", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+		mockService.AssertNotCalled(t, "DeleteTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale_version", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.DELETE("/transfers/:id", func(c *gin.Context) {
+			handler.Delete(c)
+		})
+
+		id := "650000000000000000000010"
+		mockService.On("DeleteTransfer", id, dtos.IfMatchVersion(1)).Return(services.ErrVersionMismatch)
+
+		req, _ := http.NewRequest("DELETE", "/transfers/"+id, nil)
+		req.Header.Set("If-Match", `"1"`)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestTransfersHandlerUpdate(t *testing.T) {
+	validTransfer := dtos.UpdateTransferDTO{
+		FromAccountID: "650000000000000000000001",
+		ToAccountID:   "650000000000000000000002",
+		Amount:        json.Number("250.00"),
+		Date:          "10/09/2025",
+	}
+
+	t.Run("successful_update", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.PUT("/transfers/:id", func(c *gin.Context) {
+			handler.Update(c)
+		})
+
+		id := "650000000000000000000010"
+		response := dtos.TransferResponseDTO{ID: id, Outgoing: dtos.TransactionsEntryResponseDTO{Version: 3}}
+		mockService.On("UpdateTransfer", id, dtos.IfMatchVersion(2), validTransfer, services.DateFormat).Return(response, nil)
+
+		jsonPayload, _ := json.Marshal(validTransfer)
+		req, _ := http.NewRequest("PUT", "/transfers/"+id, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2"`)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing_if_match", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.PUT("/transfers/:id", func(c *gin.Context) {
+			handler.Update(c)
+		})
+
+		jsonPayload, _ := json.Marshal(validTransfer)
+		req, _ := http.NewRequest("PUT", "/transfers/650000000000000000000010", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+		mockService.AssertNotCalled(t, "UpdateTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale_version", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.PUT("/transfers/:id", func(c *gin.Context) {
+			handler.Update(c)
+		})
+
+		id := "650000000000000000000010"
+		mockService.On("UpdateTransfer", id, dtos.IfMatchVersion(1), validTransfer, services.DateFormat).Return(dtos.TransferResponseDTO{}, services.ErrVersionMismatch)
+
+		jsonPayload, _ := json.Marshal(validTransfer)
+		req, _ := http.NewRequest("PUT", "/transfers/"+id, bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"1"`)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestTransfersHandlerGetByID(t *testing.T) {
+	t.Run("not_a_transfer", func(t *testing.T) {
+		mockService := new(MockTransfersService)
+		handler := NewTransfersHandler(mockService)
+		router := setupRouter()
+
+		router.GET("/transfers/:id", func(c *gin.Context) {
+			handler.GetByID(c)
+		})
+
+		id := "650000000000000000000010"
+		mockService.On("GetTransferByID", id, services.DateFormat).Return(dtos.TransferResponseDTO{}, services.ErrNotATransfer)
+
+		req, _ := http.NewRequest("GET", "/transfers/"+id, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,216 @@
+package i18n
+
+// Problem details.
+const (
+	InvalidFields               Code = "problem.invalid_fields"
+	InvalidJSONBody             Code = "problem.invalid_json_body"
+	UnreadableBody              Code = "problem.unreadable_body"
+	InvalidQueryParameters      Code = "problem.invalid_query_parameters"
+	RouteNotFound               Code = "problem.route_not_found"
+	MethodNotAllowed            Code = "problem.method_not_allowed"
+	UnexpectedError             Code = "problem.unexpected_error"
+	IfMatchRequired             Code = "problem.if_match_required"
+	UnsupportedPatchContentType Code = "problem.unsupported_patch_content_type"
+	PatchNotObject              Code = "problem.patch_not_object"
+	PatchEmpty                  Code = "problem.patch_empty"
+)
+
+// Field validation.
+const (
+	FieldRequired             Code = "field.required"
+	FieldInvalid              Code = "field.invalid"
+	FieldTooShort             Code = "field.too_short"
+	FieldNotEmpty             Code = "field.not_empty"
+	FieldNotNegative          Code = "field.not_negative"
+	FieldAtLeastOne           Code = "field.at_least_one"
+	FieldMaxCharacters        Code = "field.max_characters"
+	FieldThreeCharacters      Code = "field.three_characters"
+	FieldValidID              Code = "field.valid_id"
+	FieldBoolean              Code = "field.boolean"
+	FieldString               Code = "field.string"
+	FieldNumber               Code = "field.number"
+	FieldArray                Code = "field.array"
+	FieldObject               Code = "field.object"
+	FieldPositiveAmount       Code = "field.positive_amount"
+	FieldAmount               Code = "field.amount"
+	FieldFilterAmount         Code = "field.filter_amount"
+	FieldAmountLocale         Code = "field.amount_locale"
+	FieldLocalizedAmount      Code = "field.localized_amount"
+	FieldAmbiguousAmount      Code = "field.ambiguous_amount"
+	FieldAmbiguousDecimal     Code = "field.ambiguous_decimal"
+	FieldAmountCurrency       Code = "field.amount_currency"
+	FieldPositiveRate         Code = "field.positive_rate"
+	FieldIncomeOrExpense      Code = "field.income_or_expense"
+	FieldAccountType          Code = "field.account_type"
+	FieldFrequency            Code = "field.frequency"
+	FieldEndDateOrOccurrences Code = "field.end_date_or_occurrences"
+	FieldDate                 Code = "field.date"
+	FieldDateExample          Code = "field.date_example"
+	FieldDateFormat           Code = "field.date_format"
+	FieldMonth                Code = "field.month"
+	FieldCurrencyCode         Code = "field.currency_code"
+	FieldQuoteCurrency        Code = "field.quote_currency"
+	FieldDestinationAccount   Code = "field.destination_account"
+	FieldNameLength           Code = "field.name_length"
+	FieldPageLimit            Code = "field.page_limit"
+	FieldLimitInteger         Code = "field.limit_integer"
+	FieldSkipInteger          Code = "field.skip_integer"
+	FieldFilterTypes          Code = "field.filter_types"
+	FieldReportTypes          Code = "field.report_types"
+	FieldTopPositive          Code = "field.top_positive"
+	FieldCashflowInterval     Code = "field.cashflow_interval"
+	FieldDashboardPeriod      Code = "field.dashboard_period"
+	FieldExcludedWithPeriod   Code = "field.excluded_with_period"
+	FieldCursorExclusive      Code = "field.cursor_exclusive"
+	FieldSort                 Code = "field.sort"
+	FieldBatchOperations      Code = "field.batch_operations"
+	FieldBatchOp              Code = "field.batch_op"
+	FieldRequiredForUpdate    Code = "field.required_for_update"
+	FieldRequiredForCreate    Code = "field.required_for_create"
+	FieldIDRequired           Code = "field.id_required"
+	FieldIfMatch              Code = "field.if_match"
+	FieldNotPatchable         Code = "field.not_patchable"
+	FieldNotRemovable         Code = "field.not_removable"
+	FieldUpdateAmount         Code = "field.update_amount"
+	FieldUpdateTitle          Code = "field.update_title"
+	FieldUpdateType           Code = "field.update_type"
+	FieldUpdateCategory       Code = "field.update_category"
+	FieldUpdatePaymentMethod  Code = "field.update_payment_method"
+	FieldUpdateDescription    Code = "field.update_description"
+	FieldUpdateDate           Code = "field.update_date"
+	FieldUpdateAccountID      Code = "field.update_account_id"
+	FieldMonthParameter       Code = "field.month_parameter"
+	FieldFilterSize           Code = "field.filter_size"
+)
+
+// Failed actions, used to prefix the error that caused them.
+const (
+	FailedToApplyBatch             Code = "action.apply_batch"
+	FailedToCreateAccount          Code = "action.create_account"
+	FailedToCreateBudget           Code = "action.create_budget"
+	FailedToCreateEntry            Code = "action.create_entry"
+	FailedToCreateExchangeRate     Code = "action.create_exchange_rate"
+	FailedToCreateRecurringRule    Code = "action.create_recurring_rule"
+	FailedToCreateSavedView        Code = "action.create_saved_view"
+	FailedToCreateTransfer         Code = "action.create_transfer"
+	FailedToDeleteAccount          Code = "action.delete_account"
+	FailedToDeleteBudget           Code = "action.delete_budget"
+	FailedToDeleteEntry            Code = "action.delete_entry"
+	FailedToDeleteExchangeRate     Code = "action.delete_exchange_rate"
+	FailedToDeleteRecurringRule    Code = "action.delete_recurring_rule"
+	FailedToDeleteSavedView        Code = "action.delete_saved_view"
+	FailedToDeleteTransfer         Code = "action.delete_transfer"
+	FailedToRetrieveAccount        Code = "action.retrieve_account"
+	FailedToRetrieveAccountBalance Code = "action.retrieve_account_balance"
+	FailedToRetrieveAccounts       Code = "action.retrieve_accounts"
+	FailedToRetrieveBudgetStatus   Code = "action.retrieve_budget_status"
+	FailedToRetrieveBudgets        Code = "action.retrieve_budgets"
+	FailedToRetrieveCashflowReport Code = "action.retrieve_cashflow_report"
+	FailedToRetrieveCategoryReport Code = "action.retrieve_category_report"
+	FailedToRetrieveDashboardData  Code = "action.retrieve_dashboard_data"
+	FailedToRetrieveEntries        Code = "action.retrieve_entries"
+	FailedToRetrieveEntry          Code = "action.retrieve_entry"
+	FailedToRetrieveExchangeRates  Code = "action.retrieve_exchange_rates"
+	FailedToRetrieveRecurringRule  Code = "action.retrieve_recurring_rule"
+	FailedToRetrieveRecurringRules Code = "action.retrieve_recurring_rules"
+	FailedToRetrieveSavedView      Code = "action.retrieve_saved_view"
+	FailedToRetrieveSavedViews     Code = "action.retrieve_saved_views"
+	FailedToRetrieveTransfer       Code = "action.retrieve_transfer"
+	FailedToUpdateAccount          Code = "action.update_account"
+	FailedToUpdateBudget           Code = "action.update_budget"
+	FailedToUpdateEntry            Code = "action.update_entry"
+	FailedToUpdateExchangeRate     Code = "action.update_exchange_rate"
+	FailedToUpdateRecurringRule    Code = "action.update_recurring_rule"
+	FailedToUpdateSavedView        Code = "action.update_saved_view"
+	FailedToUpdateTransfer         Code = "action.update_transfer"
+	OperationNotApplied            Code = "action.operation_not_applied"
+	OperationValidationFailed      Code = "action.operation_validation_failed"
+)
+
+// Filter expression errors, reported with the position they occur at.
+const (
+	FilterAtPosition            Code = "filter.at_position"
+	FilterEndOfInput            Code = "filter.end_of_input"
+	FilterStringToken           Code = "filter.string_token"
+	FilterUnsupportedExpression Code = "filter.unsupported_expression"
+	FilterUnknownField          Code = "filter.unknown_field"
+	FilterInvalidValue          Code = "filter.invalid_value"
+	FilterInvalidDate           Code = "filter.invalid_date"
+	FilterInvalidAmount         Code = "filter.invalid_amount"
+	FilterUnsupportedOperator   Code = "filter.unsupported_operator"
+	FilterUnterminatedString    Code = "filter.unterminated_string"
+	FilterBangWithoutEquals     Code = "filter.bang_without_equals"
+	FilterExpectedLogical       Code = "filter.expected_logical"
+	FilterExpectedCloseParen    Code = "filter.expected_close_paren"
+	FilterExpectedField         Code = "filter.expected_field"
+	FilterExpectedOperator      Code = "filter.expected_operator"
+	FilterExpectedOpenParen     Code = "filter.expected_open_paren"
+	FilterExpectedListSeparator Code = "filter.expected_list_separator"
+	FilterExpectedValue         Code = "filter.expected_value"
+)
+
+// Success messages.
+const (
+	AccountUpdated       Code = "success.account_updated"
+	AccountDeleted       Code = "success.account_deleted"
+	BudgetUpdated        Code = "success.budget_updated"
+	BudgetDeleted        Code = "success.budget_deleted"
+	ExchangeRateUpdated  Code = "success.exchange_rate_updated"
+	ExchangeRateDeleted  Code = "success.exchange_rate_deleted"
+	RecurringRuleUpdated Code = "success.recurring_rule_updated"
+	RecurringRuleDeleted Code = "success.recurring_rule_deleted"
+	SavedViewUpdated     Code = "success.saved_view_updated"
+	SavedViewDeleted     Code = "success.saved_view_deleted"
+	EntryUpdated         Code = "success.entry_updated"
+	EntryDeleted         Code = "success.entry_deleted"
+	TransferUpdated      Code = "success.transfer_updated"
+	TransferDeleted      Code = "success.transfer_deleted"
+)
+
+// Domain and service errors.
+const (
+	NotFound                  Code = "error.not_found"
+	InvalidID                 Code = "error.invalid_id"
+	Conflict                  Code = "error.conflict"
+	ValidationFailed          Code = "error.validation_failed"
+	Unsupported               Code = "error.unsupported"
+	AccountNotFound           Code = "error.account_not_found"
+	BudgetNotFound            Code = "error.budget_not_found"
+	ExchangeRateNotFound      Code = "error.exchange_rate_not_found"
+	RecurringRuleNotFound     Code = "error.recurring_rule_not_found"
+	SavedViewNotFound         Code = "error.saved_view_not_found"
+	TransactionNotFound       Code = "error.transaction_not_found"
+	VersionMismatch           Code = "error.version_mismatch"
+	UnknownAccount            Code = "error.unknown_account"
+	AccountCurrencyMismatch   Code = "error.account_currency_mismatch"
+	AccountCurrencyLocked     Code = "error.account_currency_locked"
+	AccountInUse              Code = "error.account_in_use"
+	BudgetAlreadyExists       Code = "error.budget_already_exists"
+	ExchangeRateAlreadyExists Code = "error.exchange_rate_already_exists"
+	MissingExchangeRate       Code = "error.missing_exchange_rate"
+	RecurringEndBeforeStart   Code = "error.recurring_end_before_start"
+	NotATransfer              Code = "error.not_a_transfer"
+	TransferLeg               Code = "error.transfer_leg"
+	TransferCurrencyMismatch  Code = "error.transfer_currency_mismatch"
+	AmountRequired            Code = "error.amount_required"
+	InvalidDateRange          Code = "error.invalid_date_range"
+	InvalidAmountRange        Code = "error.invalid_amount_range"
+	InvalidSort               Code = "error.invalid_sort"
+	InvalidCursor             Code = "error.invalid_cursor"
+	InvalidBatchOperation     Code = "error.invalid_batch_operation"
+	BatchAborted              Code = "error.batch_aborted"
+	TooManyBuckets            Code = "error.too_many_buckets"
+	InvalidFilterExpression   Code = "error.invalid_filter_expression"
+	IdempotencyKeyReused      Code = "error.idempotency_key_reused"
+	IdempotencyKeyInProgress  Code = "error.idempotency_key_in_progress"
+	IdempotencyKeyNotFound    Code = "error.idempotency_key_not_found"
+	TransactionsUnsupported   Code = "error.transactions_unsupported"
+	InvalidAmount             Code = "error.invalid_amount"
+	TooManyDecimals           Code = "error.too_many_decimals"
+	AmountOutOfRange          Code = "error.amount_out_of_range"
+	InvalidRate               Code = "error.invalid_rate"
+	UnsupportedAmountLocale   Code = "error.unsupported_amount_locale"
+	AmbiguousAmount           Code = "error.ambiguous_amount"
+	AmountCurrencyMismatch    Code = "error.amount_currency_mismatch"
+	InvalidDate               Code = "error.invalid_date"
+)
@@ -0,0 +1,120 @@
+// Package i18n holds the catalogue of user-facing messages. Every message is
+// keyed by a Code and shipped in each supported locale, and the locale of a
+// request is negotiated from its Accept-Language header.
+package i18n
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Locale string
+
+const (
+	English             Locale = "en"
+	BrazilianPortuguese Locale = "pt-BR"
+
+	// DefaultLocale is used when the client does not ask for a supported
+	// locale, which keeps the messages clients already rely on.
+	DefaultLocale = English
+)
+
+// Code identifies a message independently of the language it is shown in.
+type Code string
+
+var catalogues = map[Locale]map[Code]string{
+	English:             english,
+	BrazilianPortuguese: brazilianPortuguese,
+}
+
+// Message renders code in locale, formatting args into it. Messages missing
+// from locale fall back to English, and unknown codes are returned as is.
+func Message(locale Locale, code Code, args ...any) string {
+	message, ok := lookup(locale, code)
+	if !ok {
+		message = string(code)
+	}
+
+	if len(args) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf(message, args...)
+}
+
+// StatusText is the localised reason phrase for an HTTP status, used as the
+// title of problem documents.
+func StatusText(locale Locale, status int) string {
+	if message, ok := lookup(locale, Code("status."+strconv.Itoa(status))); ok {
+		return message
+	}
+
+	return http.StatusText(status)
+}
+
+func lookup(locale Locale, code Code) (string, bool) {
+	if message, ok := catalogues[locale][code]; ok {
+		return message, true
+	}
+
+	message, ok := catalogues[English][code]
+	return message, ok
+}
+
+// Negotiate picks the supported locale the client prefers the most in an
+// Accept-Language header such as "pt-BR,pt;q=0.9,en;q=0.8".
+func Negotiate(acceptLanguage string) Locale {
+	type preference struct {
+		locale Locale
+		weight float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		weight := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+
+		locale, ok := supportedLocale(tag)
+		if !ok || weight <= 0 {
+			continue
+		}
+
+		preferences = append(preferences, preference{locale: locale, weight: weight})
+	}
+
+	if len(preferences) == 0 {
+		return DefaultLocale
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].weight > preferences[j].weight
+	})
+
+	return preferences[0].locale
+}
+
+func supportedLocale(tag string) (Locale, bool) {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+
+	switch primary {
+	case "pt":
+		return BrazilianPortuguese, true
+	case "en":
+		return English, true
+	case "*":
+		return DefaultLocale, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,63 @@
+package i18n
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       Locale
+	}{
+		{name: "Missing header", acceptLanguage: "", expected: DefaultLocale},
+		{name: "Brazilian Portuguese", acceptLanguage: "pt-BR", expected: BrazilianPortuguese},
+		{name: "Any Portuguese", acceptLanguage: "pt", expected: BrazilianPortuguese},
+		{name: "Case insensitive", acceptLanguage: "PT-br", expected: BrazilianPortuguese},
+		{name: "English", acceptLanguage: "en-US", expected: English},
+		{name: "Browser list", acceptLanguage: "pt-BR,pt;q=0.9,en-US;q=0.8,en;q=0.7", expected: BrazilianPortuguese},
+		{name: "Highest weight wins", acceptLanguage: "en;q=0.5, pt;q=0.8", expected: BrazilianPortuguese},
+		{name: "Unsupported languages are skipped", acceptLanguage: "fr-FR, es;q=0.9, pt;q=0.1", expected: BrazilianPortuguese},
+		{name: "Excluded language", acceptLanguage: "pt;q=0, en;q=0.1", expected: English},
+		{name: "Wildcard", acceptLanguage: "*", expected: DefaultLocale},
+		{name: "Only unsupported", acceptLanguage: "de, fr;q=0.8", expected: DefaultLocale},
+		{name: "Malformed weight", acceptLanguage: "pt;q=abc", expected: DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Negotiate(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	assert.Equal(t, "This field is required", Message(English, FieldRequired))
+	assert.Equal(t, "Este campo é obrigatório", Message(BrazilianPortuguese, FieldRequired))
+	assert.Equal(t, "Deve conter entre 1 e 100 operações", Message(BrazilianPortuguese, FieldBatchOperations, 100))
+	assert.Equal(t, "This field is required", Message(Locale("fr"), FieldRequired))
+	assert.Equal(t, "unknown.code", Message(BrazilianPortuguese, Code("unknown.code")))
+}
+
+func TestStatusText(t *testing.T) {
+	assert.Equal(t, "Not Found", StatusText(English, http.StatusNotFound))
+	assert.Equal(t, "Não encontrado", StatusText(BrazilianPortuguese, http.StatusNotFound))
+	assert.Equal(t, "Too Many Requests", StatusText(BrazilianPortuguese, http.StatusTooManyRequests))
+}
+
+func TestCataloguesAreComplete(t *testing.T) {
+	verbs := regexp.MustCompile(`%[sdvq]`)
+
+	for code, message := range english {
+		translation, ok := brazilianPortuguese[code]
+		if !assert.True(t, ok, "missing pt-BR message for %s", code) {
+			continue
+		}
+
+		assert.Equal(t, verbs.FindAllString(message, -1), verbs.FindAllString(translation, -1), "format verbs differ for %s", code)
+	}
+}
@@ -0,0 +1,200 @@
+package i18n
+
+var english = map[Code]string{
+	InvalidFields:               "The request has invalid fields",
+	InvalidJSONBody:             "Request body must be valid JSON: %s",
+	UnreadableBody:              "Request body could not be read: %s",
+	InvalidQueryParameters:      "Invalid query parameters: %s",
+	RouteNotFound:               "No route matches %s",
+	MethodNotAllowed:            "Method %s is not allowed on %s",
+	UnexpectedError:             "An unexpected error occurred",
+	IfMatchRequired:             "If-Match header with the transaction ETag is required",
+	UnsupportedPatchContentType: "Content-Type must be %s or %s",
+	PatchNotObject:              "Patch must be a JSON object",
+	PatchEmpty:                  "Patch must contain at least one field",
+
+	FieldRequired:             "This field is required",
+	FieldInvalid:              "Invalid value",
+	FieldTooShort:             "Value is too short",
+	FieldNotEmpty:             "Must not be empty",
+	FieldNotNegative:          "Must not be negative",
+	FieldAtLeastOne:           "Must be at least 1",
+	FieldMaxCharacters:        "Must be at most %s characters",
+	FieldThreeCharacters:      "Must be exactly 3 characters",
+	FieldValidID:              "Must be a valid ID",
+	FieldBoolean:              "Must be true or false",
+	FieldString:               "Must be a string",
+	FieldNumber:               "Must be a number",
+	FieldArray:                "Must be an array",
+	FieldObject:               "Must be an object",
+	FieldPositiveAmount:       "Must be a positive amount with no more decimal places than the currency allows",
+	FieldAmount:               "Must be an amount with no more decimal places than the currency allows",
+	FieldFilterAmount:         "Must be a valid amount with at most the currency's decimal places",
+	FieldAmountLocale:         "Amount locale must be one of: pt-BR, en",
+	FieldLocalizedAmount:      "Must be an amount written for %s, e.g. %s",
+	FieldAmbiguousAmount:      "Amount %s is ambiguous; write it with decimal places (%s) or without the thousands separator (%s)",
+	FieldAmbiguousDecimal:     "Amount %s is ambiguous; write it with another decimal place (%s) or, for a whole number, without the separator (%s)",
+	FieldAmountCurrency:       "Amount %s is written in another currency than %s",
+	FieldPositiveRate:         "Must be a positive decimal number",
+	FieldIncomeOrExpense:      "Must be either 'income' or 'expense'",
+	FieldAccountType:          "Must be one of 'checking', 'savings', 'credit_card', 'cash' or 'investment'",
+	FieldFrequency:            "Must be one of 'daily', 'weekly', 'monthly' or 'yearly'",
+	FieldEndDateOrOccurrences: "Use either an end date or a number of occurrences, not both",
+	FieldDate:                 "Date must be in DD/MM/YYYY or ISO 8601 (YYYY-MM-DD) format",
+	FieldDateExample:          "Date must be in DD/MM/YYYY or ISO 8601 (YYYY-MM-DD) format (e.g., 31/12/2025 or 2025-12-31)",
+	FieldDateFormat:           "Date format must be one of: br, iso",
+	FieldMonth:                "Must be in the format YYYY-MM",
+	FieldCurrencyCode:         "Currency must be a 3-letter code",
+	FieldQuoteCurrency:        "Quote currency must differ from base currency",
+	FieldDestinationAccount:   "Destination account must be different from the source account",
+	FieldNameLength:           "Must be between 1 and 100 characters",
+	FieldPageLimit:            "Must be between 1 and 100",
+	FieldLimitInteger:         "Limit must be a valid integer",
+	FieldSkipInteger:          "Skip must be a valid integer",
+	FieldFilterTypes:          "Type must be one of: income, expense, transfer",
+	FieldReportTypes:          "Type must be one of: income, expense",
+	FieldTopPositive:          "Top must be a positive integer",
+	FieldCashflowInterval:     "Interval must be one of: month, week, day",
+	FieldDashboardPeriod:      "Period must be one of: this-month, last-month, ytd",
+	FieldExcludedWithPeriod:   "Cannot be combined with period",
+	FieldCursorExclusive:      "Cursor pagination only supports the default date ordering and cannot be combined with sort or q",
+	FieldSort:                 "Sort must be a comma-separated list of %s, each optionally prefixed with - for descending order and used at most once",
+	FieldBatchOperations:      "Must contain between 1 and %d operations",
+	FieldBatchOp:              "Must be one of 'create', 'update' or 'delete'",
+	FieldRequiredForUpdate:    "This field is required for update and delete",
+	FieldRequiredForCreate:    "This field is required for create and update",
+	FieldIDRequired:           "ID is required",
+	FieldIfMatch:              "Must be the ETag returned for the transaction",
+	FieldNotPatchable:         "This field cannot be patched",
+	FieldNotRemovable:         "This field is required and cannot be removed",
+	FieldUpdateAmount:         "Amount must be a positive value with no more decimal places than the currency allows",
+	FieldUpdateTitle:          "Title is required",
+	FieldUpdateType:           "Type must be either 'income' or 'expense'",
+	FieldUpdateCategory:       "Category is required",
+	FieldUpdatePaymentMethod:  "Payment method is required",
+	FieldUpdateDescription:    "Description must not be empty",
+	FieldUpdateDate:           "Date must be in format DD/MM/YYYY or ISO 8601 (YYYY-MM-DD)",
+	FieldUpdateAccountID:      "Account ID must be a valid ID",
+	FieldMonthParameter:       "Month must be in the format YYYY-MM",
+	FieldFilterSize:           "Filter must be at most 1000 characters",
+
+	FailedToApplyBatch:             "Failed to apply batch",
+	FailedToCreateAccount:          "Failed to create account",
+	FailedToCreateBudget:           "Failed to create budget",
+	FailedToCreateEntry:            "Failed to create entry",
+	FailedToCreateExchangeRate:     "Failed to create exchange rate",
+	FailedToCreateRecurringRule:    "Failed to create recurring rule",
+	FailedToCreateSavedView:        "Failed to create saved view",
+	FailedToCreateTransfer:         "Failed to create transfer",
+	FailedToDeleteAccount:          "Failed to delete account",
+	FailedToDeleteBudget:           "Failed to delete budget",
+	FailedToDeleteEntry:            "Failed to delete entry",
+	FailedToDeleteExchangeRate:     "Failed to delete exchange rate",
+	FailedToDeleteRecurringRule:    "Failed to delete recurring rule",
+	FailedToDeleteSavedView:        "Failed to delete saved view",
+	FailedToDeleteTransfer:         "Failed to delete transfer",
+	FailedToRetrieveAccount:        "Failed to retrieve account",
+	FailedToRetrieveAccountBalance: "Failed to retrieve account balance",
+	FailedToRetrieveAccounts:       "Failed to retrieve accounts",
+	FailedToRetrieveBudgetStatus:   "Failed to retrieve budget status",
+	FailedToRetrieveBudgets:        "Failed to retrieve budgets",
+	FailedToRetrieveCashflowReport: "Failed to retrieve cash flow report",
+	FailedToRetrieveCategoryReport: "Failed to retrieve category report",
+	FailedToRetrieveDashboardData:  "Failed to retrieve dashboard data",
+	FailedToRetrieveEntries:        "Failed to retrieve entries",
+	FailedToRetrieveEntry:          "Failed to retrieve entry",
+	FailedToRetrieveExchangeRates:  "Failed to retrieve exchange rates",
+	FailedToRetrieveRecurringRule:  "Failed to retrieve recurring rule",
+	FailedToRetrieveRecurringRules: "Failed to retrieve recurring rules",
+	FailedToRetrieveSavedView:      "Failed to retrieve saved view",
+	FailedToRetrieveSavedViews:     "Failed to retrieve saved views",
+	FailedToRetrieveTransfer:       "Failed to retrieve transfer",
+	FailedToUpdateAccount:          "Failed to update account",
+	FailedToUpdateBudget:           "Failed to update budget",
+	FailedToUpdateEntry:            "Failed to update entry",
+	FailedToUpdateExchangeRate:     "Failed to update exchange rate",
+	FailedToUpdateRecurringRule:    "Failed to update recurring rule",
+	FailedToUpdateSavedView:        "Failed to update saved view",
+	FailedToUpdateTransfer:         "Failed to update transfer",
+	OperationNotApplied:            "Operation not applied",
+	OperationValidationFailed:      "Validation failed",
+
+	FilterAtPosition:            "%s at position %d",
+	FilterEndOfInput:            "end of input",
+	FilterStringToken:           "string %s",
+	FilterUnsupportedExpression: "unsupported expression",
+	FilterUnknownField:          "unknown field %s, expected one of: %s",
+	FilterInvalidValue:          "invalid value %s for %s, expected one of: %s",
+	FilterInvalidDate:           "invalid date %s for %s, expected YYYY-MM-DD",
+	FilterInvalidAmount:         "invalid amount %s for %s",
+	FilterUnsupportedOperator:   "operator %s is not supported for %s",
+	FilterUnterminatedString:    "unterminated string",
+	FilterBangWithoutEquals:     "unexpected \"!\", did you mean \"!=\"?",
+	FilterExpectedLogical:       "unexpected %s, expected \"and\", \"or\" or end of input",
+	FilterExpectedCloseParen:    "unexpected %s, expected \")\"",
+	FilterExpectedField:         "unexpected %s, expected field name",
+	FilterExpectedOperator:      "unexpected %s, expected operator after %s",
+	FilterExpectedOpenParen:     "unexpected %s, expected \"(\"",
+	FilterExpectedListSeparator: "unexpected %s, expected \",\" or \")\"",
+	FilterExpectedValue:         "unexpected %s, expected value",
+
+	AccountUpdated:       "Account updated successfully",
+	AccountDeleted:       "Account deleted successfully",
+	BudgetUpdated:        "Budget updated successfully",
+	BudgetDeleted:        "Budget deleted successfully",
+	ExchangeRateUpdated:  "Exchange rate updated successfully",
+	ExchangeRateDeleted:  "Exchange rate deleted successfully",
+	RecurringRuleUpdated: "Recurring rule updated successfully",
+	RecurringRuleDeleted: "Recurring rule deleted successfully",
+	SavedViewUpdated:     "Saved view updated successfully",
+	SavedViewDeleted:     "Saved view deleted successfully",
+	EntryUpdated:         "Entry updated successfully",
+	EntryDeleted:         "Entry deleted successfully",
+	TransferUpdated:      "Transfer updated successfully",
+	TransferDeleted:      "Transfer deleted successfully",
+
+	NotFound:                  "not found",
+	InvalidID:                 "invalid ID",
+	Conflict:                  "conflict",
+	ValidationFailed:          "validation failed",
+	Unsupported:               "operation not supported",
+	AccountNotFound:           "account not found",
+	BudgetNotFound:            "budget not found",
+	ExchangeRateNotFound:      "exchange rate not found",
+	RecurringRuleNotFound:     "recurring rule not found",
+	SavedViewNotFound:         "saved view not found",
+	TransactionNotFound:       "transaction not found",
+	VersionMismatch:           "transaction was modified by another request",
+	UnknownAccount:            "account does not exist",
+	AccountCurrencyMismatch:   "transaction currency must match the account currency",
+	AccountCurrencyLocked:     "account currency cannot change while transactions or recurring rules reference it",
+	AccountInUse:              "account cannot be deleted while transactions or recurring rules reference it",
+	BudgetAlreadyExists:       "a budget for this category, month and currency already exists",
+	ExchangeRateAlreadyExists: "an exchange rate for this currency pair already exists",
+	MissingExchangeRate:       "no exchange rate found for currency pair",
+	RecurringEndBeforeStart:   "recurring rule end date must not be before its start date",
+	NotATransfer:              "transaction is not a transfer",
+	TransferLeg:               "transaction is a transfer leg and must be changed through /transfers",
+	TransferCurrencyMismatch:  "transfer accounts must use the same currency",
+	AmountRequired:            "amount is required when changing to a currency with different decimal places",
+	InvalidDateRange:          "from date must not be after to date",
+	InvalidAmountRange:        "minAmount must not be greater than maxAmount",
+	InvalidSort:               "sort must list amount, category, createdAt, date or title, each at most once",
+	InvalidCursor:             "invalid pagination cursor",
+	InvalidBatchOperation:     "batch operation is invalid",
+	BatchAborted:              "operation not applied because another operation in the atomic batch failed",
+	TooManyBuckets:            "date range produces too many buckets for the interval",
+	InvalidFilterExpression:   "invalid filter expression",
+	IdempotencyKeyReused:      "idempotency key was already used with a different request body",
+	IdempotencyKeyInProgress:  "a request with this idempotency key is still being processed",
+	IdempotencyKeyNotFound:    "idempotency key not found",
+	TransactionsUnsupported:   "the MongoDB server does not support transactions; run it as a replica set",
+	InvalidAmount:             "amount must be a decimal number",
+	TooManyDecimals:           "amount has more decimal places than the currency allows",
+	AmountOutOfRange:          "amount is out of range",
+	InvalidRate:               "exchange rate must be a positive decimal number",
+	UnsupportedAmountLocale:   "amount locale is not supported",
+	AmbiguousAmount:           "amount is ambiguous: its only separator could mark thousands or decimals",
+	AmountCurrencyMismatch:    "amount is written in another currency than the transaction's",
+	InvalidDate:               "date must be in DD/MM/YYYY or ISO 8601 format",
+}
@@ -0,0 +1,212 @@
+package i18n
+
+var brazilianPortuguese = map[Code]string{
+	"status.400": "Requisição inválida",
+	"status.404": "Não encontrado",
+	"status.405": "Método não permitido",
+	"status.409": "Conflito",
+	"status.412": "Pré-condição falhou",
+	"status.415": "Tipo de mídia não suportado",
+	"status.422": "Entidade não processável",
+	"status.424": "Dependência falhou",
+	"status.428": "Pré-condição obrigatória",
+	"status.500": "Erro interno do servidor",
+	"status.501": "Não implementado",
+
+	InvalidFields:               "A requisição tem campos inválidos",
+	InvalidJSONBody:             "O corpo da requisição deve ser um JSON válido: %s",
+	UnreadableBody:              "Não foi possível ler o corpo da requisição: %s",
+	InvalidQueryParameters:      "Parâmetros de consulta inválidos: %s",
+	RouteNotFound:               "Nenhuma rota corresponde a %s",
+	MethodNotAllowed:            "O método %s não é permitido em %s",
+	UnexpectedError:             "Ocorreu um erro inesperado",
+	IfMatchRequired:             "O cabeçalho If-Match com o ETag da transação é obrigatório",
+	UnsupportedPatchContentType: "O Content-Type deve ser %s ou %s",
+	PatchNotObject:              "O patch deve ser um objeto JSON",
+	PatchEmpty:                  "O patch deve conter pelo menos um campo",
+
+	FieldRequired:             "Este campo é obrigatório",
+	FieldInvalid:              "Valor inválido",
+	FieldTooShort:             "Valor muito curto",
+	FieldNotEmpty:             "Não pode ser vazio",
+	FieldNotNegative:          "Não pode ser negativo",
+	FieldAtLeastOne:           "Deve ser no mínimo 1",
+	FieldMaxCharacters:        "Deve ter no máximo %s caracteres",
+	FieldThreeCharacters:      "Deve ter exatamente 3 caracteres",
+	FieldValidID:              "Deve ser um ID válido",
+	FieldBoolean:              "Deve ser true ou false",
+	FieldString:               "Deve ser um texto",
+	FieldNumber:               "Deve ser um número",
+	FieldArray:                "Deve ser uma lista",
+	FieldObject:               "Deve ser um objeto",
+	FieldPositiveAmount:       "Deve ser um valor positivo sem mais casas decimais do que a moeda permite",
+	FieldAmount:               "Deve ser um valor sem mais casas decimais do que a moeda permite",
+	FieldFilterAmount:         "Deve ser um valor válido com no máximo as casas decimais da moeda",
+	FieldAmountLocale:         "O locale do valor deve ser um de: pt-BR, en",
+	FieldLocalizedAmount:      "Deve ser um valor escrito no formato %s, ex.: %s",
+	FieldAmbiguousAmount:      "O valor %s é ambíguo; escreva-o com casas decimais (%s) ou sem o separador de milhar (%s)",
+	FieldAmbiguousDecimal:     "O valor %s é ambíguo; escreva-o com mais uma casa decimal (%s) ou, se for um número inteiro, sem o separador (%s)",
+	FieldAmountCurrency:       "O valor %s está escrito em outra moeda que não %s",
+	FieldPositiveRate:         "Deve ser um número decimal positivo",
+	FieldIncomeOrExpense:      "Deve ser 'income' ou 'expense'",
+	FieldAccountType:          "Deve ser 'checking', 'savings', 'credit_card', 'cash' ou 'investment'",
+	FieldFrequency:            "Deve ser 'daily', 'weekly', 'monthly' ou 'yearly'",
+	FieldEndDateOrOccurrences: "Use uma data final ou um número de ocorrências, não ambos",
+	FieldDate:                 "A data deve estar no formato DD/MM/AAAA ou ISO 8601 (AAAA-MM-DD)",
+	FieldDateExample:          "A data deve estar no formato DD/MM/AAAA ou ISO 8601 (AAAA-MM-DD) (ex.: 31/12/2025 ou 2025-12-31)",
+	FieldDateFormat:           "O formato de data deve ser um de: br, iso",
+	FieldMonth:                "Deve estar no formato AAAA-MM",
+	FieldCurrencyCode:         "A moeda deve ser um código de 3 letras",
+	FieldQuoteCurrency:        "A moeda cotada deve ser diferente da moeda base",
+	FieldDestinationAccount:   "A conta de destino deve ser diferente da conta de origem",
+	FieldNameLength:           "Deve ter entre 1 e 100 caracteres",
+	FieldPageLimit:            "Deve estar entre 1 e 100",
+	FieldLimitInteger:         "O limite deve ser um número inteiro válido",
+	FieldSkipInteger:          "O deslocamento deve ser um número inteiro válido",
+	FieldFilterTypes:          "O tipo deve ser um de: income, expense, transfer",
+	FieldReportTypes:          "O tipo deve ser um de: income, expense",
+	FieldTopPositive:          "O top deve ser um número inteiro positivo",
+	FieldCashflowInterval:     "O intervalo deve ser um de: month, week, day",
+	FieldDashboardPeriod:      "O período deve ser um de: this-month, last-month, ytd",
+	FieldExcludedWithPeriod:   "Não pode ser combinado com o período",
+	FieldCursorExclusive:      "A paginação por cursor só suporta a ordenação padrão por data e não pode ser combinada com sort ou q",
+	FieldSort:                 "A ordenação deve ser uma lista separada por vírgulas de %s, cada um opcionalmente prefixado com - para ordem decrescente e usado no máximo uma vez",
+	FieldBatchOperations:      "Deve conter entre 1 e %d operações",
+	FieldBatchOp:              "Deve ser 'create', 'update' ou 'delete'",
+	FieldRequiredForUpdate:    "Este campo é obrigatório para update e delete",
+	FieldRequiredForCreate:    "Este campo é obrigatório para create e update",
+	FieldIDRequired:           "O ID é obrigatório",
+	FieldIfMatch:              "Deve ser o ETag retornado para a transação",
+	FieldNotPatchable:         "Este campo não pode ser alterado via patch",
+	FieldNotRemovable:         "Este campo é obrigatório e não pode ser removido",
+	FieldUpdateAmount:         "O valor deve ser positivo e sem mais casas decimais do que a moeda permite",
+	FieldUpdateTitle:          "O título é obrigatório",
+	FieldUpdateType:           "O tipo deve ser 'income' ou 'expense'",
+	FieldUpdateCategory:       "A categoria é obrigatória",
+	FieldUpdatePaymentMethod:  "A forma de pagamento é obrigatória",
+	FieldUpdateDescription:    "A descrição não pode ser vazia",
+	FieldUpdateDate:           "A data deve estar no formato DD/MM/AAAA ou ISO 8601 (AAAA-MM-DD)",
+	FieldUpdateAccountID:      "O ID da conta deve ser um ID válido",
+	FieldMonthParameter:       "O mês deve estar no formato AAAA-MM",
+	FieldFilterSize:           "O filtro deve ter no máximo 1000 caracteres",
+
+	FailedToApplyBatch:             "Falha ao aplicar o lote",
+	FailedToCreateAccount:          "Falha ao criar a conta",
+	FailedToCreateBudget:           "Falha ao criar o orçamento",
+	FailedToCreateEntry:            "Falha ao criar a transação",
+	FailedToCreateExchangeRate:     "Falha ao criar a cotação",
+	FailedToCreateRecurringRule:    "Falha ao criar a regra recorrente",
+	FailedToCreateSavedView:        "Falha ao criar a visão salva",
+	FailedToCreateTransfer:         "Falha ao criar a transferência",
+	FailedToDeleteAccount:          "Falha ao excluir a conta",
+	FailedToDeleteBudget:           "Falha ao excluir o orçamento",
+	FailedToDeleteEntry:            "Falha ao excluir a transação",
+	FailedToDeleteExchangeRate:     "Falha ao excluir a cotação",
+	FailedToDeleteRecurringRule:    "Falha ao excluir a regra recorrente",
+	FailedToDeleteSavedView:        "Falha ao excluir a visão salva",
+	FailedToDeleteTransfer:         "Falha ao excluir a transferência",
+	FailedToRetrieveAccount:        "Falha ao buscar a conta",
+	FailedToRetrieveAccountBalance: "Falha ao buscar o saldo da conta",
+	FailedToRetrieveAccounts:       "Falha ao buscar as contas",
+	FailedToRetrieveBudgetStatus:   "Falha ao buscar a situação dos orçamentos",
+	FailedToRetrieveBudgets:        "Falha ao buscar os orçamentos",
+	FailedToRetrieveCashflowReport: "Falha ao buscar o relatório de fluxo de caixa",
+	FailedToRetrieveCategoryReport: "Falha ao buscar o relatório por categoria",
+	FailedToRetrieveDashboardData:  "Falha ao buscar os dados do painel",
+	FailedToRetrieveEntries:        "Falha ao buscar as transações",
+	FailedToRetrieveEntry:          "Falha ao buscar a transação",
+	FailedToRetrieveExchangeRates:  "Falha ao buscar as cotações",
+	FailedToRetrieveRecurringRule:  "Falha ao buscar a regra recorrente",
+	FailedToRetrieveRecurringRules: "Falha ao buscar as regras recorrentes",
+	FailedToRetrieveSavedView:      "Falha ao buscar a visão salva",
+	FailedToRetrieveSavedViews:     "Falha ao buscar as visões salvas",
+	FailedToRetrieveTransfer:       "Falha ao buscar a transferência",
+	FailedToUpdateAccount:          "Falha ao atualizar a conta",
+	FailedToUpdateBudget:           "Falha ao atualizar o orçamento",
+	FailedToUpdateEntry:            "Falha ao atualizar a transação",
+	FailedToUpdateExchangeRate:     "Falha ao atualizar a cotação",
+	FailedToUpdateRecurringRule:    "Falha ao atualizar a regra recorrente",
+	FailedToUpdateSavedView:        "Falha ao atualizar a visão salva",
+	FailedToUpdateTransfer:         "Falha ao atualizar a transferência",
+	OperationNotApplied:            "Operação não aplicada",
+	OperationValidationFailed:      "Falha na validação",
+
+	FilterAtPosition:            "%s na posição %d",
+	FilterEndOfInput:            "fim da expressão",
+	FilterStringToken:           "texto %s",
+	FilterUnsupportedExpression: "expressão não suportada",
+	FilterUnknownField:          "campo %s desconhecido, esperado um de: %s",
+	FilterInvalidValue:          "valor %s inválido para %s, esperado um de: %s",
+	FilterInvalidDate:           "data %s inválida para %s, esperado AAAA-MM-DD",
+	FilterInvalidAmount:         "valor %s inválido para %s",
+	FilterUnsupportedOperator:   "o operador %s não é suportado para %s",
+	FilterUnterminatedString:    "texto sem aspas de fechamento",
+	FilterBangWithoutEquals:     "\"!\" inesperado, você quis dizer \"!=\"?",
+	FilterExpectedLogical:       "%s inesperado, esperado \"and\", \"or\" ou fim da expressão",
+	FilterExpectedCloseParen:    "%s inesperado, esperado \")\"",
+	FilterExpectedField:         "%s inesperado, esperado nome de campo",
+	FilterExpectedOperator:      "%s inesperado, esperado operador após %s",
+	FilterExpectedOpenParen:     "%s inesperado, esperado \"(\"",
+	FilterExpectedListSeparator: "%s inesperado, esperado \",\" ou \")\"",
+	FilterExpectedValue:         "%s inesperado, esperado um valor",
+
+	AccountUpdated:       "Conta atualizada com sucesso",
+	AccountDeleted:       "Conta excluída com sucesso",
+	BudgetUpdated:        "Orçamento atualizado com sucesso",
+	BudgetDeleted:        "Orçamento excluído com sucesso",
+	ExchangeRateUpdated:  "Cotação atualizada com sucesso",
+	ExchangeRateDeleted:  "Cotação excluída com sucesso",
+	RecurringRuleUpdated: "Regra recorrente atualizada com sucesso",
+	RecurringRuleDeleted: "Regra recorrente excluída com sucesso",
+	SavedViewUpdated:     "Visão salva atualizada com sucesso",
+	SavedViewDeleted:     "Visão salva excluída com sucesso",
+	EntryUpdated:         "Transação atualizada com sucesso",
+	EntryDeleted:         "Transação excluída com sucesso",
+	TransferUpdated:      "Transferência atualizada com sucesso",
+	TransferDeleted:      "Transferência excluída com sucesso",
+
+	NotFound:                  "não encontrado",
+	InvalidID:                 "ID inválido",
+	Conflict:                  "conflito",
+	ValidationFailed:          "falha na validação",
+	Unsupported:               "operação não suportada",
+	AccountNotFound:           "conta não encontrada",
+	BudgetNotFound:            "orçamento não encontrado",
+	ExchangeRateNotFound:      "cotação não encontrada",
+	RecurringRuleNotFound:     "regra recorrente não encontrada",
+	SavedViewNotFound:         "visão salva não encontrada",
+	TransactionNotFound:       "transação não encontrada",
+	VersionMismatch:           "a transação foi modificada por outra requisição",
+	UnknownAccount:            "a conta não existe",
+	AccountCurrencyMismatch:   "a moeda da transação deve ser a mesma da conta",
+	AccountCurrencyLocked:     "a moeda da conta não pode mudar enquanto houver transações ou regras recorrentes vinculadas a ela",
+	AccountInUse:              "a conta não pode ser excluída enquanto houver transações ou regras recorrentes vinculadas a ela",
+	BudgetAlreadyExists:       "já existe um orçamento para esta categoria, mês e moeda",
+	ExchangeRateAlreadyExists: "já existe uma cotação para este par de moedas",
+	MissingExchangeRate:       "nenhuma cotação encontrada para o par de moedas",
+	RecurringEndBeforeStart:   "a data final da regra recorrente não pode ser anterior à data inicial",
+	NotATransfer:              "a transação não é uma transferência",
+	TransferLeg:               "a transação faz parte de uma transferência e deve ser alterada por /transfers",
+	TransferCurrencyMismatch:  "as contas da transferência devem usar a mesma moeda",
+	AmountRequired:            "o valor é obrigatório ao mudar para uma moeda com casas decimais diferentes",
+	InvalidDateRange:          "a data inicial não pode ser posterior à data final",
+	InvalidAmountRange:        "minAmount não pode ser maior que maxAmount",
+	InvalidSort:               "sort deve listar amount, category, createdAt, date ou title, cada um no máximo uma vez",
+	InvalidCursor:             "cursor de paginação inválido",
+	InvalidBatchOperation:     "a operação do lote é inválida",
+	BatchAborted:              "operação não aplicada porque outra operação do lote atômico falhou",
+	TooManyBuckets:            "o período gera intervalos demais para a granularidade escolhida",
+	InvalidFilterExpression:   "expressão de filtro inválida",
+	IdempotencyKeyReused:      "a chave de idempotência já foi usada com outro corpo de requisição",
+	IdempotencyKeyInProgress:  "uma requisição com esta chave de idempotência ainda está sendo processada",
+	IdempotencyKeyNotFound:    "chave de idempotência não encontrada",
+	TransactionsUnsupported:   "o servidor MongoDB não suporta transações; execute-o como replica set",
+	InvalidAmount:             "o valor deve ser um número decimal",
+	TooManyDecimals:           "o valor tem mais casas decimais do que a moeda permite",
+	AmountOutOfRange:          "o valor está fora do intervalo permitido",
+	InvalidRate:               "a cotação deve ser um número decimal positivo",
+	UnsupportedAmountLocale:   "o idioma do valor não é suportado",
+	AmbiguousAmount:           "o valor é ambíguo: seu único separador pode indicar milhares ou decimais",
+	AmountCurrencyMismatch:    "o valor está escrito em outra moeda que não a da transação",
+	InvalidDate:               "a data deve estar no formato DD/MM/AAAA ou ISO 8601",
+}
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"context"
+	"math"
+
+	"myfin-api/internal/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type amountField struct {
+	collection string
+	field      string
+}
+
+var amountFields = []amountField{
+	{collection: "transactions_entries", field: "amount"},
+	{collection: "accounts", field: "opening_balance"},
+	{collection: "budgets", field: "limit"},
+	{collection: "recurring_rules", field: "amount"},
+}
+
+func amountsToMinorUnits(ctx context.Context, database *mongo.Database) error {
+	zeroDecimalCurrencies := money.CurrenciesWithDecimals(0)
+	threeDecimalCurrencies := money.CurrenciesWithDecimals(3)
+	upperCurrency := bson.M{"$toUpper": "$currency"}
+
+	currencyGroups := []struct {
+		decimals int
+		match    bson.M
+	}{
+		{decimals: 0, match: bson.M{"$in": bson.A{upperCurrency, zeroDecimalCurrencies}}},
+		{decimals: 3, match: bson.M{"$in": bson.A{upperCurrency, threeDecimalCurrencies}}},
+		{decimals: money.DefaultDecimals, match: bson.M{"$not": bson.A{
+			bson.M{"$in": bson.A{upperCurrency, append(append([]string{}, zeroDecimalCurrencies...), threeDecimalCurrencies...)}},
+		}}},
+	}
+
+	for _, target := range amountFields {
+		collection := database.Collection(target.collection)
+
+		for _, group := range currencyGroups {
+			filter := bson.M{
+				target.field: bson.M{"$type": "double"},
+				"$expr":      group.match,
+			}
+			update := mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{
+					target.field: bson.M{"$toLong": bson.M{"$round": bson.A{
+						bson.M{"$multiply": bson.A{"$" + target.field, math.Pow10(group.decimals)}},
+						0,
+					}}},
+				}}},
+			}
+
+			if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// budgetCategories reduces budgets sharing a month, category and currency,
+// categories compared regardless of case, to the most recently updated one.
+// The earlier read-then-insert check let such duplicates through, and the
+// unique index the budgets repository ensures at startup cannot be built
+// over them.
+func budgetCategories(ctx context.Context, database *mongo.Database) error {
+	key := bson.M{"month": "$month", "category": "$category", "currency": bson.M{"$toUpper": "$currency"}}
+	return removeDuplicates(ctx, database.Collection("budgets"), key, &options.Collation{Locale: "en", Strength: 2})
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type duplicateGroup struct {
+	Key bson.M               `bson:"_id"`
+	IDs []primitive.ObjectID `bson:"ids"`
+}
+
+// removeDuplicates keeps, among the documents of collection that share key
+// under collation, only the most recently updated one, so a unique index on
+// key can be built. Each removal is logged.
+func removeDuplicates(ctx context.Context, collection *mongo.Collection, key bson.M, collation *options.Collation) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   key,
+			"ids":   bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline, options.Aggregate().SetCollation(collation))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []duplicateGroup
+	if err := cursor.All(ctx, &groups); err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		kept, removed := group.IDs[0], group.IDs[1:]
+		if _, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": removed}}); err != nil {
+			return err
+		}
+
+		log.Printf("⚠️  %s: %d documento(s) duplicado(s) de %v removido(s), mantido %s", collection.Name(), len(removed), group.Key, kept.Hex())
+	}
+
+	return nil
+}
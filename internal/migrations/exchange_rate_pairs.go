@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// exchangeRatePairs stores the currencies of every existing rate in
+// alphabetical order, as the exchange rates repository does, so a rate and
+// its inverse share a pair. Rates stored for both directions of a pair,
+// which used to be allowed, are then reduced to the most recently updated
+// one, since the unique index the repository ensures at startup cannot be
+// built over them.
+func exchangeRatePairs(ctx context.Context, database *mongo.Database) error {
+	collection := database.Collection("exchange_rates")
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"pair": bson.M{"$cond": bson.A{
+				bson.M{"$lte": bson.A{"$base_currency", "$quote_currency"}},
+				bson.M{"$concat": bson.A{"$base_currency", "/", "$quote_currency"}},
+				bson.M{"$concat": bson.A{"$quote_currency", "/", "$base_currency"}},
+			}},
+		}}},
+	}
+	if _, err := collection.UpdateMany(ctx, bson.M{"pair": bson.M{"$exists": false}}, update); err != nil {
+		return err
+	}
+
+	return removeDuplicates(ctx, collection, bson.M{"pair": "$pair"}, nil)
+}
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const migrationTimeout = 5 * time.Minute
+
+type Migration struct {
+	ID string
+	Up func(ctx context.Context, database *mongo.Database) error
+}
+
+type appliedMigration struct {
+	ID        string    `bson:"_id"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+var All = []Migration{
+	{ID: "0001_amounts_to_minor_units", Up: amountsToMinorUnits},
+	{ID: "0002_transaction_versions", Up: transactionVersions},
+	{ID: "0004_budget_categories", Up: budgetCategories},
+	{ID: "0005_exchange_rate_pairs", Up: exchangeRatePairs},
+	{ID: "0006_saved_view_names_not_unique", Up: savedViewNamesNotUnique},
+}
+
+func Run(database *mongo.Database, migrations []Migration) error {
+	collection := database.Collection("migrations")
+
+	for _, migration := range migrations {
+		if err := runMigration(database, collection, migration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runMigration(database *mongo.Database, collection *mongo.Collection, migration Migration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), migrationTimeout)
+	defer cancel()
+
+	err := collection.FindOne(ctx, bson.M{"_id": migration.ID}).Err()
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	log.Println("Aplicando migração", migration.ID)
+
+	if err := migration.Up(ctx, database); err != nil {
+		return err
+	}
+
+	_, err = collection.InsertOne(ctx, appliedMigration{
+		ID:        migration.ID,
+		AppliedAt: time.Now().UTC(),
+	})
+	return err
+}
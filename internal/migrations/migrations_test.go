@@ -0,0 +1,293 @@
+package migrations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myfin-api/internal/migrations"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// migrationByID returns the registered migration with id, ready to Run.
+func migrationByID(t *testing.T, id string) []migrations.Migration {
+	t.Helper()
+
+	for _, migration := range migrations.All {
+		if migration.ID == id {
+			return []migrations.Migration{migration}
+		}
+	}
+
+	t.Fatalf("migration %s is not registered", id)
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("applies_pending_migration", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(),
+		)
+
+		var applied int
+		err := migrations.Run(mt.DB, []migrations.Migration{
+			{ID: "0001_test", Up: func(ctx context.Context, database *mongo.Database) error {
+				applied++
+				return nil
+			}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+
+		started := mt.GetStartedEvent()
+		for started != nil && started.CommandName != "insert" {
+			started = mt.GetStartedEvent()
+		}
+		if assert.NotNil(t, started) {
+			document := started.Command.Lookup("documents").Array().Index(0).Value().Document()
+			assert.Equal(t, "0001_test", document.Lookup("_id").StringValue())
+		}
+	})
+
+	mt.Run("skips_applied_migration", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "myfin.migrations", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "0001_test"},
+		}))
+
+		var applied int
+		err := migrations.Run(mt.DB, []migrations.Migration{
+			{ID: "0001_test", Up: func(ctx context.Context, database *mongo.Database) error {
+				applied++
+				return nil
+			}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+	})
+
+	mt.Run("migration_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch))
+
+		expectedError := errors.New("migration failed")
+		err := migrations.Run(mt.DB, []migrations.Migration{
+			{ID: "0001_test", Up: func(ctx context.Context, database *mongo.Database) error {
+				return expectedError
+			}},
+		})
+
+		assert.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestAmountsToMinorUnitsMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("converts_every_amount_field", func(mt *mtest.T) {
+		responses := []bson.D{mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch)}
+		for i := 0; i < 12; i++ {
+			responses = append(responses, mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		}
+		responses = append(responses, mtest.CreateSuccessResponse())
+		mt.AddMockResponses(responses...)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0001_amounts_to_minor_units"))
+
+		assert.NoError(t, err)
+
+		updatedFields := map[string]int{}
+		for started := mt.GetStartedEvent(); started != nil; started = mt.GetStartedEvent() {
+			if started.CommandName != "update" {
+				continue
+			}
+
+			collection := started.Command.Lookup("update").StringValue()
+			update := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+			pipeline := update.Lookup("u").Array().Index(0).Value().Document()
+			set := pipeline.Lookup("$set").Document()
+
+			elements, _ := set.Elements()
+			if assert.Len(t, elements, 1) {
+				updatedFields[collection+"."+elements[0].Key()]++
+			}
+		}
+
+		assert.Equal(t, map[string]int{
+			"transactions_entries.amount": 3,
+			"accounts.opening_balance":    3,
+			"budgets.limit":               3,
+			"recurring_rules.amount":      3,
+		}, updatedFields)
+	})
+}
+
+func TestTransactionVersionsMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("sets_missing_versions", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0002_transaction_versions"))
+
+		assert.NoError(t, err)
+
+		mt.GetStartedEvent()
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "transactions_entries", started.Command.Lookup("update").StringValue())
+
+		update := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.False(t, update.Lookup("q", "version", "$exists").Boolean())
+		assert.Equal(t, int64(1), update.Lookup("u", "$set", "version").Int64())
+	})
+}
+
+func TestExchangeRatePairsMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("keeps_newest_direction_before_indexing", func(mt *mtest.T) {
+		newest := primitive.NewObjectID()
+		inverse := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}),
+			mtest.CreateCursorResponse(0, "myfin.exchange_rates", mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: bson.D{{Key: "pair", Value: "BRL/USD"}}},
+				{Key: "ids", Value: bson.A{newest, inverse}},
+				{Key: "count", Value: int32(2)},
+			}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0005_exchange_rate_pairs"))
+
+		assert.NoError(t, err)
+
+		mt.GetStartedEvent()
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "exchange_rates", started.Command.Lookup("update").StringValue())
+
+		update := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.False(t, update.Lookup("q", "pair", "$exists").Boolean())
+
+		aggregate := mt.GetStartedEvent()
+		assert.Equal(t, "aggregate", aggregate.CommandName)
+		sortStage := aggregate.Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$sort").Document()
+		assert.Equal(t, int32(-1), sortStage.Lookup("updated_at").Int32())
+
+		remove := mt.GetStartedEvent()
+		assert.Equal(t, "delete", remove.CommandName)
+		removed, _ := remove.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q", "_id", "$in").Array().Values()
+		if assert.Len(t, removed, 1) {
+			assert.Equal(t, inverse, removed[0].ObjectID())
+		}
+	})
+}
+
+func TestSavedViewNamesNotUniqueMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("drops_name_index", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0006_saved_view_names_not_unique"))
+
+		assert.NoError(t, err)
+
+		mt.GetStartedEvent()
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "dropIndexes", started.CommandName)
+		assert.Equal(t, "saved_views", started.Command.Lookup("dropIndexes").StringValue())
+		assert.Equal(t, "name_unique", started.Command.Lookup("index").StringValue())
+	})
+
+	mt.Run("index_never_created", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 27, Name: "IndexNotFound", Message: "index not found with name [name_unique]"}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0006_saved_view_names_not_unique"))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestBudgetCategoriesMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("keeps_newest_duplicate", func(mt *mtest.T) {
+		newest := primitive.NewObjectID()
+		older := primitive.NewObjectID()
+		oldest := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "myfin.budgets", mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: bson.D{{Key: "month", Value: "2025-09"}, {Key: "category", Value: "Alimentação"}, {Key: "currency", Value: "BRL"}}},
+				{Key: "ids", Value: bson.A{newest, older, oldest}},
+				{Key: "count", Value: int32(3)},
+			}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0004_budget_categories"))
+
+		assert.NoError(t, err)
+
+		mt.GetStartedEvent()
+		aggregate := mt.GetStartedEvent()
+		assert.Equal(t, "aggregate", aggregate.CommandName)
+		assert.Equal(t, int32(2), aggregate.Command.Lookup("collation", "strength").Int32())
+
+		remove := mt.GetStartedEvent()
+		assert.Equal(t, "delete", remove.CommandName)
+		removed, _ := remove.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q", "_id", "$in").Array().Values()
+		if assert.Len(t, removed, 2) {
+			assert.Equal(t, older, removed[0].ObjectID())
+			assert.Equal(t, oldest, removed[1].ObjectID())
+		}
+	})
+
+	mt.Run("no_duplicates", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "myfin.migrations", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "myfin.budgets", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := migrations.Run(mt.DB, migrationByID(t, "0004_budget_categories"))
+
+		assert.NoError(t, err)
+
+		mt.GetStartedEvent()
+		mt.GetStartedEvent()
+		assert.Equal(t, "insert", mt.GetStartedEvent().CommandName)
+	})
+}
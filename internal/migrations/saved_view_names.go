@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Server codes for dropping an index that, or whose collection, does not exist.
+const (
+	namespaceNotFound = 26
+	indexNotFound     = 27
+)
+
+// savedViewNamesNotUnique drops the unique index on saved view names that an
+// earlier version created. Views have no owner yet, so the index made a name
+// unique across everyone rather than per user.
+func savedViewNamesNotUnique(ctx context.Context, database *mongo.Database) error {
+	_, err := database.Collection("saved_views").Indexes().DropOne(ctx, "name_unique")
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && (serverErr.HasErrorCode(namespaceNotFound) || serverErr.HasErrorCode(indexNotFound)) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// transactionVersions starts every existing transaction at version 1 so that
+// conditional writes can filter on the field.
+func transactionVersions(ctx context.Context, database *mongo.Database) error {
+	_, err := database.Collection("transactions_entries").UpdateMany(ctx,
+		bson.M{"version": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"version": int64(1)}},
+	)
+	return err
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AccountModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name           string             `bson:"name" json:"name"`
+	Type           string             `bson:"type" json:"type"`
+	Currency       string             `bson:"currency" json:"currency"`
+	OpeningBalance int64              `bson:"opening_balance" json:"opening_balance"`
+	Description    string             `bson:"description,omitempty" json:"description,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BudgetModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Category  string             `bson:"category" json:"category"`
+	Month     string             `bson:"month" json:"month"`
+	Limit     int64              `bson:"limit" json:"limit"`
+	Currency  string             `bson:"currency" json:"currency"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ExchangeRateModel struct {
+	ID            primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	BaseCurrency  string               `bson:"base_currency" json:"base_currency"`
+	QuoteCurrency string               `bson:"quote_currency" json:"quote_currency"`
+	Pair          string               `bson:"pair" json:"pair"`
+	Rate          primitive.Decimal128 `bson:"rate" json:"rate"`
+	CreatedAt     time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time            `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type IdempotencyKeyModel struct {
+	Key         string    `bson:"_id" json:"key"`
+	RequestHash string    `bson:"request_hash" json:"request_hash"`
+	Response    []byte    `bson:"response,omitempty" json:"response,omitempty"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+}
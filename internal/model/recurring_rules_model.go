@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RecurringRuleModel struct {
+	ID                   primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Amount               int64              `bson:"amount" json:"amount"`
+	Title                string             `bson:"title" json:"title"`
+	Currency             string             `bson:"currency" json:"currency"`
+	Type                 string             `bson:"type" json:"type"`
+	Category             string             `bson:"category" json:"category"`
+	PaymentMethod        string             `bson:"payment_method" json:"payment_method"`
+	Description          string             `bson:"description,omitempty" json:"description,omitempty"`
+	AccountID            primitive.ObjectID `bson:"account_id,omitempty" json:"account_id,omitempty"`
+	Frequency            string             `bson:"frequency" json:"frequency"`
+	Interval             int                `bson:"interval" json:"interval"`
+	StartDate            time.Time          `bson:"start_date" json:"start_date"`
+	EndDate              *time.Time         `bson:"end_date,omitempty" json:"end_date,omitempty"`
+	MaxOccurrences       int                `bson:"max_occurrences,omitempty" json:"max_occurrences,omitempty"`
+	OccurrencesGenerated int                `bson:"occurrences_generated" json:"occurrences_generated"`
+	NextRunAt            time.Time          `bson:"next_run_at" json:"next_run_at"`
+	Active               bool               `bson:"active" json:"active"`
+	CreatedAt            time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt            time.Time          `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SavedViewModel struct {
+	ID        primitive.ObjectID    `bson:"_id,omitempty" json:"id"`
+	Name      string                `bson:"name" json:"name"`
+	Filters   SavedViewFiltersModel `bson:"filters" json:"filters"`
+	Limit     int                   `bson:"limit" json:"limit"`
+	Skip      int                   `bson:"skip" json:"skip"`
+	CreatedAt time.Time             `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time             `bson:"updated_at" json:"updated_at"`
+}
+
+type SavedViewFiltersModel struct {
+	Q             string `bson:"q,omitempty" json:"q,omitempty"`
+	Filter        string `bson:"filter,omitempty" json:"filter,omitempty"`
+	Title         string `bson:"title,omitempty" json:"title,omitempty"`
+	Category      string `bson:"category,omitempty" json:"category,omitempty"`
+	Type          string `bson:"type,omitempty" json:"type,omitempty"`
+	Currency      string `bson:"currency,omitempty" json:"currency,omitempty"`
+	PaymentMethod string `bson:"payment_method,omitempty" json:"payment_method,omitempty"`
+	From          string `bson:"from,omitempty" json:"from,omitempty"`
+	To            string `bson:"to,omitempty" json:"to,omitempty"`
+	MinAmount     string `bson:"min_amount,omitempty" json:"min_amount,omitempty"`
+	MaxAmount     string `bson:"max_amount,omitempty" json:"max_amount,omitempty"`
+	Sort          string `bson:"sort,omitempty" json:"sort,omitempty"`
+}
@@ -7,16 +7,22 @@ import (
 )
 
 type TransactionsEntryModel struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Amount        float64            `bson:"amount" json:"amount"`
-	Title         string             `bson:"title" json:"title"`
-	Currency      string             `bson:"currency" json:"currency"`
-	Type          string             `bson:"type" json:"type"`
-	Category      string             `bson:"category" json:"category"`
-	PaymentMethod string             `bson:"payment_method" json:"payment_method"`
-	Description   string             `bson:"description,omitempty" json:"description,omitempty"`
-	Date          time.Time          `bson:"date" json:"date"`
-	Timestamp     int64              `bson:"timestamp" json:"timestamp"`
-	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+	ID                  primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Amount              int64              `bson:"amount" json:"amount"`
+	Title               string             `bson:"title" json:"title"`
+	Currency            string             `bson:"currency" json:"currency"`
+	Type                string             `bson:"type" json:"type"`
+	Category            string             `bson:"category" json:"category"`
+	PaymentMethod       string             `bson:"payment_method" json:"payment_method"`
+	Description         string             `bson:"description,omitempty" json:"description,omitempty"`
+	AccountID           primitive.ObjectID `bson:"account_id,omitempty" json:"account_id,omitempty"`
+	TransferDirection   string             `bson:"transfer_direction,omitempty" json:"transfer_direction,omitempty"`
+	LinkedTransactionID primitive.ObjectID `bson:"linked_transaction_id,omitempty" json:"linked_transaction_id,omitempty"`
+	RecurringRuleID     primitive.ObjectID `bson:"recurring_rule_id,omitempty" json:"recurring_rule_id,omitempty"`
+	RecurringOccurrence int                `bson:"recurring_occurrence,omitempty" json:"recurring_occurrence,omitempty"`
+	Date                time.Time          `bson:"date" json:"date"`
+	Timestamp           int64              `bson:"timestamp" json:"timestamp"`
+	CreatedAt           time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt           time.Time          `bson:"updated_at" json:"updated_at"`
+	Version             int64              `bson:"version" json:"version"`
 }
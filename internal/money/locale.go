@@ -0,0 +1,199 @@
+package money
+
+import (
+	"strings"
+	"unicode"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/i18n"
+)
+
+var (
+	ErrUnsupportedLocale = domain.NewError(domain.ErrValidation, i18n.UnsupportedAmountLocale)
+	ErrAmbiguousAmount   = domain.NewError(domain.ErrValidation, i18n.AmbiguousAmount)
+	ErrCurrencyMismatch  = domain.NewError(domain.ErrValidation, i18n.AmountCurrencyMismatch)
+)
+
+type separators struct {
+	decimal string
+	group   string
+}
+
+// localeSeparators lists the locales amounts may be written in.
+var localeSeparators = map[string]separators{
+	"en":    {decimal: ".", group: ","},
+	"pt-BR": {decimal: ",", group: "."},
+}
+
+var currencySymbols = map[string]string{
+	"BRL": "R$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"USD": "US$",
+}
+
+// symbolPrefixes are stripped from localized input, longest first so "US$"
+// is not read as "$".
+var symbolPrefixes = []string{"US$", "R$", "$", "€", "£", "¥"}
+
+// symbolCurrencies tells which currency a symbol in localized input stands
+// for. A bare "$" is read as US dollars.
+var symbolCurrencies = map[string]string{
+	"US$": "USD",
+	"R$":  "BRL",
+	"$":   "USD",
+	"€":   "EUR",
+	"£":   "GBP",
+	"¥":   "JPY",
+}
+
+func SupportsLocale(locale string) bool {
+	_, ok := localeSeparators[locale]
+	return ok
+}
+
+// Normalize turns an amount written for locale, such as "R$ 1.234,56" for
+// pt-BR, into the plain decimal form Parse expects ("1234.56"). A currency
+// symbol or code may precede the number; when currency is given it must be
+// that currency's, otherwise ErrCurrencyMismatch is returned. Thousands
+// separators must group exactly three digits.
+//
+// A value whose only separator is followed by exactly three digits, such as
+// "1.234" or "1,234" in pt-BR, means something else in the other locale, so
+// it is rejected with ErrAmbiguousAmount. The reading in locale ("1234" or
+// "1.234") is still returned so callers can suggest an unambiguous spelling.
+func Normalize(value, locale, currency string) (string, error) {
+	separators, ok := localeSeparators[locale]
+	if !ok {
+		return "", ErrUnsupportedLocale
+	}
+
+	number, written, negative := stripCurrency(value)
+	if written != "" && currency != "" && !strings.EqualFold(written, currency) {
+		return "", ErrCurrencyMismatch
+	}
+
+	integer, fraction, hasFraction := strings.Cut(number, separators.decimal)
+	if hasFraction && (fraction == "" || !isDigits(fraction)) {
+		return "", ErrInvalidAmount
+	}
+
+	groups := strings.Split(integer, separators.group)
+	for i, group := range groups {
+		valid := group != "" && isDigits(group) && (len(groups) == 1 || len(group) == 3 || (i == 0 && len(group) <= 3))
+		if !valid {
+			return "", ErrInvalidAmount
+		}
+	}
+
+	normalized := strings.Join(groups, "")
+	if negative {
+		normalized = "-" + normalized
+	}
+
+	if hasFraction {
+		normalized += "." + fraction
+	}
+
+	// The other locale would read the decimal separator as grouping a
+	// thousand, or the thousands separator as marking three decimals.
+	decimalReadAsGroup := hasFraction && len(groups) == 1 && len(fraction) == 3 && len(integer) <= 3 && !strings.HasPrefix(integer, "0")
+	groupReadAsDecimal := !hasFraction && len(groups) == 2
+	if decimalReadAsGroup || groupReadAsDecimal {
+		return normalized, ErrAmbiguousAmount
+	}
+
+	return normalized, nil
+}
+
+// Localize writes a plain decimal amount, as produced by Format, with the
+// separators of locale. Unknown locales get the plain form back.
+func Localize(value, locale string) string {
+	separators, ok := localeSeparators[locale]
+	if !ok {
+		return value
+	}
+
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(value, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(separators.group)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	if hasFraction {
+		return sign + grouped.String() + separators.decimal + fraction
+	}
+
+	return sign + grouped.String()
+}
+
+// Display formats a plain decimal amount for people, e.g. "R$ 1.234,56" for
+// BRL in pt-BR. Currencies without a known symbol are shown with their code.
+func Display(value, currency, locale string) string {
+	symbol, ok := currencySymbols[strings.ToUpper(currency)]
+	if !ok {
+		symbol = strings.ToUpper(currency)
+	}
+
+	localized := Localize(value, locale)
+	if symbol == "" {
+		return localized
+	}
+
+	if strings.HasPrefix(localized, "-") {
+		return "-" + symbol + " " + localized[1:]
+	}
+
+	return symbol + " " + localized
+}
+
+// stripCurrency removes surrounding spaces, a leading sign and a currency
+// symbol or ISO code from a localized amount, in either order. It also
+// returns the currency the symbol or code stands for, if there was one.
+func stripCurrency(value string) (string, string, bool) {
+	value = strings.TrimFunc(value, unicode.IsSpace)
+
+	negative := false
+	if strings.HasPrefix(value, "-") {
+		negative, value = true, strings.TrimLeftFunc(value[1:], unicode.IsSpace)
+	}
+
+	currency := ""
+	for _, symbol := range symbolPrefixes {
+		if strings.HasPrefix(value, symbol) {
+			value, currency = value[len(symbol):], symbolCurrencies[symbol]
+			break
+		}
+	}
+
+	if currency == "" && len(value) > 3 && isUpperLetters(value[:3]) {
+		value, currency = value[3:], value[:3]
+	}
+
+	value = strings.TrimLeftFunc(value, unicode.IsSpace)
+	if !negative && strings.HasPrefix(value, "-") {
+		negative, value = true, value[1:]
+	}
+
+	return value, currency, negative
+}
+
+func isUpperLetters(value string) bool {
+	for _, char := range value {
+		if char < 'A' || char > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,86 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		locale        string
+		currency      string
+		expected      string
+		expectedError error
+	}{
+		{name: "pt_br_grouped", value: "1.234,56", locale: "pt-BR", expected: "1234.56"},
+		{name: "pt_br_symbol", value: "R$ 50,00", locale: "pt-BR", expected: "50.00"},
+		{name: "pt_br_symbol_without_space", value: "R$1.234.567,8", locale: "pt-BR", expected: "1234567.8"},
+		{name: "pt_br_non_breaking_space", value: "R$\u00a01.234,56", locale: "pt-BR", expected: "1234.56"},
+		{name: "pt_br_plain_integer", value: "1234", locale: "pt-BR", expected: "1234"},
+		{name: "pt_br_negative_before_symbol", value: "-R$ 10,50", locale: "pt-BR", expected: "-10.50"},
+		{name: "pt_br_negative_after_symbol", value: "R$ -10,50", locale: "pt-BR", expected: "-10.50"},
+		{name: "pt_br_currency_code", value: "BRL 10,50", locale: "pt-BR", expected: "10.50"},
+		{name: "pt_br_several_groups", value: "1.234.567", locale: "pt-BR", expected: "1234567"},
+		{name: "en_grouped", value: "1,234.56", locale: "en", expected: "1234.56"},
+		{name: "en_symbol", value: "US$ 50.00", locale: "en", expected: "50.00"},
+		{name: "en_dollar", value: "$0.99", locale: "en", expected: "0.99"},
+		{name: "en_decimal_with_three_places", value: "1.2345", locale: "en", expected: "1.2345"},
+		{name: "en_zero_with_three_places", value: "0.125", locale: "en", expected: "0.125"},
+		{name: "pt_br_grouped_with_three_places", value: "1.234,567", locale: "pt-BR", expected: "1234.567"},
+		{name: "pt_br_matching_symbol", value: "R$ 50,00", locale: "pt-BR", currency: "BRL", expected: "50.00"},
+		{name: "pt_br_matching_code", value: "BRL 50,00", locale: "pt-BR", currency: "brl", expected: "50.00"},
+		{name: "en_dollar_for_usd", value: "$5.00", locale: "en", currency: "usd", expected: "5.00"},
+		{name: "pt_br_without_symbol_for_currency", value: "50,00", locale: "pt-BR", currency: "USD", expected: "50.00"},
+		{name: "pt_br_single_thousands_separator", value: "1.234", locale: "pt-BR", expected: "1234", expectedError: ErrAmbiguousAmount},
+		{name: "en_single_thousands_separator", value: "12,345", locale: "en", expected: "12345", expectedError: ErrAmbiguousAmount},
+		{name: "pt_br_three_decimal_places", value: "1,234", locale: "pt-BR", expected: "1.234", expectedError: ErrAmbiguousAmount},
+		{name: "en_three_decimal_places", value: "-1.234", locale: "en", expected: "-1.234", expectedError: ErrAmbiguousAmount},
+		{name: "pt_br_other_currency_symbol", value: "US$ 50,00", locale: "pt-BR", currency: "BRL", expectedError: ErrCurrencyMismatch},
+		{name: "pt_br_other_currency_code", value: "EUR 50,00", locale: "pt-BR", currency: "BRL", expectedError: ErrCurrencyMismatch},
+		{name: "en_real_symbol_for_dollars", value: "R$ 50.00", locale: "en", currency: "USD", expectedError: ErrCurrencyMismatch},
+		{name: "pt_br_english_decimal", value: "10.50", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "pt_br_english_grouping", value: "1,234.56", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "en_brazilian_decimal", value: "10,5", locale: "en", expectedError: ErrInvalidAmount},
+		{name: "misplaced_group", value: "12.34,00", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "leading_group_too_long", value: "1234.567,00", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "empty_fraction", value: "10,", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "missing_integer_part", value: ",50", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "two_decimal_separators", value: "1,2,3", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "letters", value: "dez reais", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "empty", value: "", locale: "pt-BR", expectedError: ErrInvalidAmount},
+		{name: "unsupported_locale", value: "10", locale: "fr", expectedError: ErrUnsupportedLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := Normalize(tt.value, tt.locale, tt.currency)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, normalized)
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	assert.Equal(t, "1.234,56", Localize("1234.56", "pt-BR"))
+	assert.Equal(t, "1,234.56", Localize("1234.56", "en"))
+	assert.Equal(t, "-1.234.567", Localize("-1234567", "pt-BR"))
+	assert.Equal(t, "999,000", Localize("999.000", "pt-BR"))
+	assert.Equal(t, "0,5", Localize("0.5", "pt-BR"))
+	assert.Equal(t, "1234.56", Localize("1234.56", "fr"))
+}
+
+func TestDisplay(t *testing.T) {
+	assert.Equal(t, "R$ 1.234,56", Display("1234.56", "BRL", "pt-BR"))
+	assert.Equal(t, "R$ 1,234.56", Display("1234.56", "BRL", "en"))
+	assert.Equal(t, "-US$ 10.00", Display("-10.00", "USD", "en"))
+	assert.Equal(t, "¥ 1.200", Display("1200", "JPY", "pt-BR"))
+	assert.Equal(t, "KWD 1,234.567", Display("1234.567", "kwd", "en"))
+	assert.Equal(t, "1,234.56", Display("1234.56", "", "en"))
+}
@@ -0,0 +1,188 @@
+package money
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/i18n"
+)
+
+const (
+	DefaultDecimals = 2
+	MaxDecimals     = 3
+)
+
+var (
+	ErrInvalidAmount    = domain.NewError(domain.ErrValidation, i18n.InvalidAmount)
+	ErrTooManyDecimals  = domain.NewError(domain.ErrValidation, i18n.TooManyDecimals)
+	ErrAmountOutOfRange = domain.NewError(domain.ErrValidation, i18n.AmountOutOfRange)
+	ErrInvalidRate      = domain.NewError(domain.ErrValidation, i18n.InvalidRate)
+)
+
+var currencyDecimals = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+func Decimals(currency string) int {
+	if decimals, ok := currencyDecimals[strings.ToUpper(currency)]; ok {
+		return decimals
+	}
+
+	return DefaultDecimals
+}
+
+func CurrenciesWithDecimals(decimals int) []string {
+	currencies := make([]string, 0)
+	for currency, currencyDecimals := range currencyDecimals {
+		if currencyDecimals == decimals {
+			currencies = append(currencies, currency)
+		}
+	}
+
+	sort.Strings(currencies)
+
+	return currencies
+}
+
+func Parse(value, currency string) (int64, error) {
+	return ParseWithDecimals(value, Decimals(currency))
+}
+
+func ParseWithDecimals(value string, decimals int) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	negative := false
+	switch {
+	case strings.HasPrefix(value, "-"):
+		negative = true
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+
+	integerPart, fractionalPart, hasPoint := strings.Cut(value, ".")
+	if integerPart == "" || (hasPoint && fractionalPart == "") || !isDigits(integerPart) || !isDigits(fractionalPart) {
+		return 0, ErrInvalidAmount
+	}
+
+	fractionalPart = strings.TrimRight(fractionalPart, "0")
+	if len(fractionalPart) > decimals {
+		return 0, ErrTooManyDecimals
+	}
+
+	digits := integerPart + fractionalPart + strings.Repeat("0", decimals-len(fractionalPart))
+
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, ErrAmountOutOfRange
+	}
+
+	if negative {
+		minor = -minor
+	}
+
+	return minor, nil
+}
+
+func Format(minor int64, currency string) string {
+	return FormatWithDecimals(minor, Decimals(currency))
+}
+
+func FormatWithDecimals(minor int64, decimals int) string {
+	sign := ""
+	digits := strconv.FormatUint(absUint(minor), 10)
+	if minor < 0 {
+		sign = "-"
+	}
+
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	point := len(digits) - decimals
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+func ToNumber(minor int64, currency string) json.Number {
+	return json.Number(Format(minor, currency))
+}
+
+func ParseRate(value string) (*big.Rat, error) {
+	value = strings.TrimSpace(value)
+
+	integerPart, fractionalPart, hasPoint := strings.Cut(value, ".")
+	if integerPart == "" || (hasPoint && fractionalPart == "") || !isDigits(integerPart) || !isDigits(fractionalPart) {
+		return nil, ErrInvalidRate
+	}
+
+	rate, ok := new(big.Rat).SetString(value)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	return rate, nil
+}
+
+func Convert(minor int64, fromCurrency, toCurrency string, rate *big.Rat) *big.Rat {
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(minor), rate)
+
+	shift := Decimals(toCurrency) - Decimals(fromCurrency)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+
+	if shift < 0 {
+		return converted.Quo(converted, scale)
+	}
+
+	return converted.Mul(converted, scale)
+}
+
+func Round(value *big.Rat) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+
+	doubled := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+	if doubled.Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	if !quotient.IsInt64() {
+		return 0, ErrAmountOutOfRange
+	}
+
+	return quotient.Int64(), nil
+}
+
+func isDigits(value string) bool {
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+func absUint(value int64) uint64 {
+	if value < 0 {
+		return uint64(-(value + 1)) + 1
+	}
+
+	return uint64(value)
+}
@@ -0,0 +1,126 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimals(t *testing.T) {
+	assert.Equal(t, 2, Decimals("BRL"))
+	assert.Equal(t, 2, Decimals("usd"))
+	assert.Equal(t, 0, Decimals("JPY"))
+	assert.Equal(t, 3, Decimals("KWD"))
+	assert.Equal(t, DefaultDecimals, Decimals("XYZ"))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		currency      string
+		expected      int64
+		expectedError error
+	}{
+		{name: "integer", value: "1500", currency: "BRL", expected: 150000},
+		{name: "two_decimals", value: "10.99", currency: "BRL", expected: 1099},
+		{name: "one_decimal", value: "0.1", currency: "BRL", expected: 10},
+		{name: "trailing_zeros_beyond_precision", value: "12.3400", currency: "BRL", expected: 1234},
+		{name: "negative", value: "-300.5", currency: "BRL", expected: -30050},
+		{name: "explicit_plus_sign", value: "+7", currency: "BRL", expected: 700},
+		{name: "zero_decimal_currency", value: "1200", currency: "JPY", expected: 1200},
+		{name: "three_decimal_currency", value: "1.234", currency: "KWD", expected: 1234},
+		{name: "too_many_decimals", value: "10.005", currency: "BRL", expectedError: ErrTooManyDecimals},
+		{name: "decimals_on_zero_decimal_currency", value: "100.5", currency: "JPY", expectedError: ErrTooManyDecimals},
+		{name: "empty", value: "", currency: "BRL", expectedError: ErrInvalidAmount},
+		{name: "exponent", value: "1e3", currency: "BRL", expectedError: ErrInvalidAmount},
+		{name: "missing_integer_part", value: ".5", currency: "BRL", expectedError: ErrInvalidAmount},
+		{name: "dangling_point", value: "5.", currency: "BRL", expectedError: ErrInvalidAmount},
+		{name: "letters", value: "ten", currency: "BRL", expectedError: ErrInvalidAmount},
+		{name: "overflow", value: "92233720368547758.08", currency: "BRL", expectedError: ErrAmountOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(tt.value, tt.currency)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "1500.00", Format(150000, "BRL"))
+	assert.Equal(t, "0.05", Format(5, "BRL"))
+	assert.Equal(t, "-0.05", Format(-5, "BRL"))
+	assert.Equal(t, "-300.50", Format(-30050, "BRL"))
+	assert.Equal(t, "1200", Format(1200, "JPY"))
+	assert.Equal(t, "1.234", Format(1234, "KWD"))
+	assert.Equal(t, "0.000", Format(0, "KWD"))
+	assert.Equal(t, "-92233720368547758.08", Format(math.MinInt64, "BRL"))
+}
+
+func TestToNumber(t *testing.T) {
+	payload, err := json.Marshal(map[string]json.Number{"amount": ToNumber(1099, "BRL")})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 10.99}`, string(payload))
+}
+
+func TestParseRate(t *testing.T) {
+	rate, err := ParseRate("5.4321")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "54321/10000", rate.String())
+
+	for _, value := range []string{"", "0", "0.000", "-1.5", "1e3", "abc", "1."} {
+		_, err := ParseRate(value)
+		assert.ErrorIs(t, err, ErrInvalidRate, value)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	rate, _ := ParseRate("5.25")
+	assert.Equal(t, "52500", Convert(10000, "USD", "BRL", rate).RatString())
+
+	rate, _ = ParseRate("0.0065")
+	assert.Equal(t, "650", Convert(1000, "JPY", "USD", rate).RatString())
+
+	rate, _ = ParseRate("150")
+	assert.Equal(t, "1500", Convert(1000, "USD", "JPY", rate).RatString())
+
+	rate, _ = ParseRate("3.25")
+	assert.Equal(t, "65/2", Convert(100, "KWD", "USD", rate).RatString())
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value    *big.Rat
+		expected int64
+	}{
+		{big.NewRat(13, 2), 7},
+		{big.NewRat(-13, 2), -7},
+		{big.NewRat(649, 100), 6},
+		{big.NewRat(-651, 100), -7},
+		{big.NewRat(42, 1), 42},
+	}
+
+	for _, tt := range tests {
+		result, err := Round(tt.value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, result, tt.value.String())
+	}
+
+	_, err := Round(new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 70), big.NewInt(1)))
+	assert.ErrorIs(t, err, ErrAmountOutOfRange)
+}
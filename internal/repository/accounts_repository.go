@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AccountsRepository interface {
+	Create(account *model.AccountModel) (*model.AccountModel, error)
+	GetAll() ([]*model.AccountModel, error)
+	GetByID(id string) (*model.AccountModel, error)
+	Update(id string, account *model.AccountModel) (*model.AccountModel, error)
+	Delete(id string) error
+}
+
+// accountReferences are the collections whose documents point at an account
+// and hold amounts in its currency.
+var accountReferences = []string{"transactions_entries", "recurring_rules"}
+
+type accountsRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewAccountsRepository(database *mongo.Database) AccountsRepository {
+	collection := database.Collection("accounts")
+	return &accountsRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func (r *accountsRepository) Create(account *model.AccountModel) (*model.AccountModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	account.CreatedAt = time.Now().UTC().Local()
+	account.UpdatedAt = time.Now().UTC().Local()
+
+	result, err := r.collection.InsertOne(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		account.ID = oid
+	}
+
+	return account, nil
+}
+
+func (r *accountsRepository) GetAll() ([]*model.AccountModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, options)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	accounts := make([]*model.AccountModel, 0)
+
+	for cursor.Next(ctx) {
+		var account model.AccountModel
+		if err := cursor.Decode(&account); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, &account)
+	}
+
+	return accounts, cursor.Err()
+}
+
+func (r *accountsRepository) GetByID(id string) (*model.AccountModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	var account model.AccountModel
+	err = r.collection.FindOne(ctx, filter).Decode(&account)
+	if err != nil {
+		return nil, findError(err, ErrAccountNotFound)
+	}
+
+	return &account, nil
+}
+
+// Update refuses with ErrAccountCurrencyLocked to change the currency of an
+// account that is referenced. The check and the update run in one transaction.
+func (r *accountsRepository) Update(id string, account *model.AccountModel) (*model.AccountModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	account.ID = objectID
+	account.UpdatedAt = time.Now().UTC().Local()
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"name":            account.Name,
+			"type":            account.Type,
+			"currency":        account.Currency,
+			"opening_balance": account.OpeningBalance,
+			"description":     account.Description,
+			"updated_at":      account.UpdatedAt,
+		},
+	}
+
+	err = withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		var existing model.AccountModel
+		if err := r.collection.FindOne(sc, filter).Decode(&existing); err != nil {
+			return findError(err, ErrAccountNotFound)
+		}
+
+		if existing.Currency != account.Currency {
+			if err := r.checkUnreferenced(sc, objectID, ErrAccountCurrencyLocked); err != nil {
+				return err
+			}
+		}
+
+		_, err := r.collection.UpdateOne(sc, filter, update)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(id)
+}
+
+// Delete refuses with ErrAccountInUse to remove an account that is referenced.
+// The check and the delete run in one transaction.
+func (r *accountsRepository) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	return withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		if err := r.checkUnreferenced(sc, objectID, ErrAccountInUse); err != nil {
+			return err
+		}
+
+		result, err := r.collection.DeleteOne(sc, bson.M{"_id": objectID})
+		if err != nil {
+			return err
+		}
+
+		if result.DeletedCount == 0 {
+			return ErrAccountNotFound
+		}
+
+		return nil
+	})
+}
+
+// checkUnreferenced returns referenced when a document in accountReferences
+// points at the account.
+func (r *accountsRepository) checkUnreferenced(sc mongo.SessionContext, objectID primitive.ObjectID, referenced error) error {
+	for _, name := range accountReferences {
+		count, err := r.database.Collection(name).CountDocuments(sc, bson.M{"account_id": objectID}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return referenced
+		}
+	}
+
+	return nil
+}
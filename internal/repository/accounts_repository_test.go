@@ -0,0 +1,336 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestAccountsRepositoryCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "ok", Value: 1},
+			bson.E{Key: "n", Value: 1},
+		))
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		account := &model.AccountModel{
+			Name:           "Nubank",
+			Type:           "checking",
+			Currency:       "BRL",
+			OpeningBalance: 10000,
+		}
+
+		result, err := repo.Create(account)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotZero(t, result.ID)
+		assert.NotZero(t, result.CreatedAt)
+		assert.NotZero(t, result.UpdatedAt)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.Create(&model.AccountModel{Name: "Nubank"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAccountsRepositoryGetAll(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_retrieval", func(mt *mtest.T) {
+		objectID1 := primitive.NewObjectID()
+		objectID2 := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "accounts.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID1},
+			{Key: "name", Value: "Nubank"},
+			{Key: "type", Value: "checking"},
+			{Key: "currency", Value: "BRL"},
+			{Key: "opening_balance", Value: int64(25000)},
+		})
+
+		second := mtest.CreateCursorResponse(1, "accounts.entries", mtest.NextBatch, bson.D{
+			{Key: "_id", Value: objectID2},
+			{Key: "name", Value: "Wallet"},
+			{Key: "type", Value: "cash"},
+			{Key: "currency", Value: "BRL"},
+			{Key: "opening_balance", Value: int64(0)},
+		})
+
+		killCursors := mtest.CreateCursorResponse(0, "accounts.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, second, killCursors)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, objectID1, result[0].ID)
+		assert.Equal(t, "Nubank", result[0].Name)
+		assert.Equal(t, int64(25000), result[0].OpeningBalance)
+		assert.Equal(t, objectID2, result[1].ID)
+		assert.Equal(t, "cash", result[1].Type)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAccountsRepositoryGetByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_retrieval", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+		createdTime := time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "accounts.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "name", Value: "Nubank"},
+			{Key: "type", Value: "checking"},
+			{Key: "currency", Value: "BRL"},
+			{Key: "opening_balance", Value: int64(1050)},
+			{Key: "created_at", Value: createdTime},
+			{Key: "updated_at", Value: createdTime},
+		}))
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.GetByID(objectID.Hex())
+
+		assert.NoError(t, err)
+		assert.Equal(t, objectID, result.ID)
+		assert.Equal(t, "Nubank", result.Name)
+		assert.Equal(t, int64(1050), result.OpeningBalance)
+		assert.Equal(t, createdTime, result.CreatedAt)
+	})
+
+	mt.Run("invalid_object_id", func(mt *mtest.T) {
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.GetByID("invalid-id")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidID)
+	})
+}
+
+func TestAccountsRepositoryUpdate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	existing := func(objectID primitive.ObjectID) bson.D {
+		return bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "name", Value: "Savings"},
+			{Key: "type", Value: "savings"},
+			{Key: "currency", Value: "BRL"},
+		}
+	}
+
+	mt.Run("successful_update", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch, existing(objectID)),
+			mtest.CreateSuccessResponse(
+				bson.E{Key: "ok", Value: 1},
+				bson.E{Key: "n", Value: 1},
+				bson.E{Key: "nModified", Value: 1},
+			),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch, existing(objectID)),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.Update(objectID.Hex(), &model.AccountModel{Name: "Savings", Type: "savings", Currency: "BRL"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, objectID, result.ID)
+		assert.Equal(t, "Savings", result.Name)
+	})
+
+	mt.Run("currency_change_on_unreferenced_account", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch, existing(objectID)),
+			mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "recurring_rules.entries", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch, existing(objectID)),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		_, err := repo.Update(objectID.Hex(), &model.AccountModel{Name: "Savings", Type: "savings", Currency: "USD"})
+
+		assert.NoError(t, err)
+
+		var commands []string
+		for _, event := range mt.GetAllStartedEvents() {
+			commands = append(commands, event.CommandName)
+		}
+		assert.Equal(t, []string{"find", "aggregate", "aggregate", "update", "commitTransaction", "find"}, commands)
+	})
+
+	mt.Run("currency_change_on_referenced_account", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch, existing(objectID)),
+			mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(1)}}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.Update(objectID.Hex(), &model.AccountModel{Name: "Savings", Type: "savings", Currency: "USD"})
+
+		assert.ErrorIs(t, err, repository.ErrAccountCurrencyLocked)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, result)
+
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "abortTransaction", events[len(events)-1].CommandName)
+	})
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "accounts.entries", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.Update(primitive.NewObjectID().Hex(), &model.AccountModel{Name: "Savings"})
+
+		assert.ErrorIs(t, err, repository.ErrAccountNotFound)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("invalid_object_id", func(mt *mtest.T) {
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		result, err := repo.Update("invalid-id", &model.AccountModel{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAccountsRepositoryDelete(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_deletion", func(mt *mtest.T) {
+		accountID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "recurring_rules.entries", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(
+				bson.E{Key: "ok", Value: 1},
+				bson.E{Key: "n", Value: 1},
+				bson.E{Key: "deletedCount", Value: 1},
+			),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		err := repo.Delete(accountID.Hex())
+
+		assert.NoError(t, err)
+
+		events := mt.GetAllStartedEvents()
+		match := events[0].Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, accountID, match.Lookup("account_id").ObjectID())
+		assert.Equal(t, "commitTransaction", events[len(events)-1].CommandName)
+	})
+
+	mt.Run("referenced_by_recurring_rule", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "recurring_rules.entries", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(1)}}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrAccountInUse)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "abortTransaction", events[len(events)-1].CommandName)
+	})
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "recurring_rules.entries", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(
+				bson.E{Key: "ok", Value: 1},
+				bson.E{Key: "n", Value: 0},
+			),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrAccountNotFound)
+	})
+
+	mt.Run("invalid_object_id", func(mt *mtest.T) {
+		repo := repository.NewAccountsRepository(mt.DB)
+
+		err := repo.Delete("invalid-id")
+
+		assert.Error(t, err)
+	})
+}
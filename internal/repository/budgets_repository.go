@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type BudgetsRepository interface {
+	Create(budget *model.BudgetModel) (*model.BudgetModel, error)
+	GetAll(month string) ([]*model.BudgetModel, error)
+	GetByID(id string) (*model.BudgetModel, error)
+	Update(id string, budget *model.BudgetModel) (*model.BudgetModel, error)
+	Delete(id string) error
+	EnsureIndexes() error
+}
+
+type budgetsRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewBudgetsRepository(database *mongo.Database) BudgetsRepository {
+	collection := database.Collection("budgets")
+	return &budgetsRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func (r *budgetsRepository) Create(budget *model.BudgetModel) (*model.BudgetModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	budget.CreatedAt = time.Now().UTC().Local()
+	budget.UpdatedAt = time.Now().UTC().Local()
+
+	result, err := r.collection.InsertOne(ctx, budget)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrBudgetAlreadyExists
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		budget.ID = oid
+	}
+
+	return budget, nil
+}
+
+func (r *budgetsRepository) GetAll(month string) ([]*model.BudgetModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	query := bson.M{}
+
+	if month != "" {
+		query["month"] = month
+	}
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "month", Value: -1}, {Key: "category", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, query, options)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	budgets := make([]*model.BudgetModel, 0)
+
+	for cursor.Next(ctx) {
+		var budget model.BudgetModel
+		if err := cursor.Decode(&budget); err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, &budget)
+	}
+
+	return budgets, cursor.Err()
+}
+
+func (r *budgetsRepository) GetByID(id string) (*model.BudgetModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	var budget model.BudgetModel
+	err = r.collection.FindOne(ctx, filter).Decode(&budget)
+	if err != nil {
+		return nil, findError(err, ErrBudgetNotFound)
+	}
+
+	return &budget, nil
+}
+
+func (r *budgetsRepository) Update(id string, budget *model.BudgetModel) (*model.BudgetModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	budget.ID = objectID
+	budget.UpdatedAt = time.Now().UTC().Local()
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"category":   budget.Category,
+			"month":      budget.Month,
+			"limit":      budget.Limit,
+			"currency":   budget.Currency,
+			"updated_at": budget.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrBudgetAlreadyExists
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrBudgetNotFound
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *budgetsRepository) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objectID}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+// EnsureIndexes allows a single budget per month, category and currency,
+// comparing categories with categoryCollation as the budget status does.
+func (r *budgetsRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "month", Value: 1}, {Key: "category", Value: 1}, {Key: "currency", Value: 1}},
+		Options: options.Index().
+			SetName("month_category_currency_unique").
+			SetUnique(true).
+			SetCollation(categoryCollation),
+	})
+	return err
+}
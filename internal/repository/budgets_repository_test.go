@@ -0,0 +1,193 @@
+package repository_test
+
+import (
+	"testing"
+
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestBudgetsRepositoryCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "ok", Value: 1},
+			bson.E{Key: "n", Value: 1},
+		))
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		budget := &model.BudgetModel{
+			Category: "Food",
+			Month:    "2025-09",
+			Limit:    80000,
+			Currency: "BRL",
+		}
+
+		result, err := repo.Create(budget)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotZero(t, result.ID)
+		assert.NotZero(t, result.CreatedAt)
+	})
+
+	mt.Run("duplicate_budget", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.Create(&model.BudgetModel{Category: "Food"})
+
+		assert.ErrorIs(t, err, repository.ErrBudgetAlreadyExists)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.Create(&model.BudgetModel{Category: "Food"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, repository.ErrBudgetAlreadyExists)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBudgetsRepositoryGetAll(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("filtered_by_month", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "budgets.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "category", Value: "Food"},
+			{Key: "month", Value: "2025-09"},
+			{Key: "limit", Value: int64(80000)},
+			{Key: "currency", Value: "BRL"},
+		})
+
+		killCursors := mtest.CreateCursorResponse(0, "budgets.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.GetAll("2025-09")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, objectID, result[0].ID)
+		assert.Equal(t, int64(80000), result[0].Limit)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		assert.Equal(t, "2025-09", started.Command.Lookup("filter", "month").StringValue())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.GetAll("")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBudgetsRepositoryGetByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "budgets.entries", mtest.FirstBatch))
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.GetByID(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrBudgetNotFound)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("invalid_id", func(mt *mtest.T) {
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		result, err := repo.GetByID("invalid")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBudgetsRepositoryDelete(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_deletion", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "acknowledged", Value: true},
+			{Key: "n", Value: 1},
+		})
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.NoError(t, err)
+	})
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "acknowledged", Value: true},
+			{Key: "n", Value: 0},
+		})
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrBudgetNotFound)
+	})
+}
+
+func TestBudgetsRepositoryEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("creates_unique_category_index", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewBudgetsRepository(mt.DB)
+
+		err := repo.EnsureIndexes()
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		index := started.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		assert.Equal(t, "month_category_currency_unique", index.Lookup("name").StringValue())
+		assert.True(t, index.Lookup("unique").Boolean())
+		assert.Equal(t, int32(2), index.Lookup("collation", "strength").Int32())
+	})
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrAccountNotFound       = domain.NewError(domain.ErrNotFound, i18n.AccountNotFound)
+	ErrBudgetNotFound        = domain.NewError(domain.ErrNotFound, i18n.BudgetNotFound)
+	ErrExchangeRateNotFound  = domain.NewError(domain.ErrNotFound, i18n.ExchangeRateNotFound)
+	ErrRecurringRuleNotFound = domain.NewError(domain.ErrNotFound, i18n.RecurringRuleNotFound)
+	ErrSavedViewNotFound     = domain.NewError(domain.ErrNotFound, i18n.SavedViewNotFound)
+	ErrTransactionNotFound   = domain.NewError(domain.ErrNotFound, i18n.TransactionNotFound)
+
+	ErrIdempotencyKeyNotFound = domain.NewError(domain.ErrNotFound, i18n.IdempotencyKeyNotFound)
+
+	ErrBudgetAlreadyExists       = domain.NewError(domain.ErrConflict, i18n.BudgetAlreadyExists)
+	ErrExchangeRateAlreadyExists = domain.NewError(domain.ErrConflict, i18n.ExchangeRateAlreadyExists)
+
+	ErrAccountCurrencyLocked = domain.NewError(domain.ErrConflict, i18n.AccountCurrencyLocked)
+	ErrAccountInUse          = domain.NewError(domain.ErrConflict, i18n.AccountInUse)
+
+	ErrTransactionsUnsupported = domain.NewError(domain.ErrUnsupported, i18n.TransactionsUnsupported)
+)
+
+// illegalOperation is the code a standalone server answers with when a
+// command carries a transaction number.
+const illegalOperation = 20
+
+func objectIDFromHex(id string) (primitive.ObjectID, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return objectID, nil
+}
+
+// findError keeps the driver's ErrNoDocuments from leaking out of the
+// repository by reporting it as the resource's not found error.
+func findError(err, notFound error) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return notFound
+	}
+
+	return err
+}
+
+// transactionError reports a server that cannot run multi-document
+// transactions as ErrTransactionsUnsupported, whatever write hit it first.
+func transactionError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(illegalOperation) && serverErr.HasErrorMessage("Transaction numbers") {
+		return ErrTransactionsUnsupported
+	}
+
+	return err
+}
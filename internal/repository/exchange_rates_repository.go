@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ExchangeRatesRepository interface {
+	Create(exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error)
+	GetAll() ([]*model.ExchangeRateModel, error)
+	GetByID(id string) (*model.ExchangeRateModel, error)
+	Update(id string, exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error)
+	Delete(id string) error
+	EnsureIndexes() error
+}
+
+type exchangeRatesRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewExchangeRatesRepository(database *mongo.Database) ExchangeRatesRepository {
+	collection := database.Collection("exchange_rates")
+	return &exchangeRatesRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func (r *exchangeRatesRepository) Create(exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	exchangeRate.Pair = exchangeRatePair(exchangeRate.BaseCurrency, exchangeRate.QuoteCurrency)
+	exchangeRate.CreatedAt = time.Now().UTC().Local()
+	exchangeRate.UpdatedAt = time.Now().UTC().Local()
+
+	result, err := r.collection.InsertOne(ctx, exchangeRate)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrExchangeRateAlreadyExists
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		exchangeRate.ID = oid
+	}
+
+	return exchangeRate, nil
+}
+
+func (r *exchangeRatesRepository) GetAll() ([]*model.ExchangeRateModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "base_currency", Value: 1}, {Key: "quote_currency", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, options)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	exchangeRates := make([]*model.ExchangeRateModel, 0)
+
+	for cursor.Next(ctx) {
+		var exchangeRate model.ExchangeRateModel
+		if err := cursor.Decode(&exchangeRate); err != nil {
+			return nil, err
+		}
+		exchangeRates = append(exchangeRates, &exchangeRate)
+	}
+
+	return exchangeRates, cursor.Err()
+}
+
+func (r *exchangeRatesRepository) GetByID(id string) (*model.ExchangeRateModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	var exchangeRate model.ExchangeRateModel
+	err = r.collection.FindOne(ctx, filter).Decode(&exchangeRate)
+	if err != nil {
+		return nil, findError(err, ErrExchangeRateNotFound)
+	}
+
+	return &exchangeRate, nil
+}
+
+func (r *exchangeRatesRepository) Update(id string, exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate.ID = objectID
+	exchangeRate.Pair = exchangeRatePair(exchangeRate.BaseCurrency, exchangeRate.QuoteCurrency)
+	exchangeRate.UpdatedAt = time.Now().UTC().Local()
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"base_currency":  exchangeRate.BaseCurrency,
+			"quote_currency": exchangeRate.QuoteCurrency,
+			"pair":           exchangeRate.Pair,
+			"rate":           exchangeRate.Rate,
+			"updated_at":     exchangeRate.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrExchangeRateAlreadyExists
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrExchangeRateNotFound
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *exchangeRatesRepository) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objectID}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrExchangeRateNotFound
+	}
+
+	return nil
+}
+
+// EnsureIndexes keeps a single rate per pair, whichever its direction.
+func (r *exchangeRatesRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "pair", Value: 1}},
+		Options: options.Index().SetName("pair_unique").SetUnique(true),
+	})
+	return err
+}
+
+// exchangeRatePair names the two currencies of a rate in alphabetical order,
+// so a rate and its inverse share the same pair and the unique index on it
+// keeps only one of them.
+func exchangeRatePair(base, quote string) string {
+	if quote < base {
+		base, quote = quote, base
+	}
+
+	return base + "/" + quote
+}
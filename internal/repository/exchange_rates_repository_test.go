@@ -0,0 +1,178 @@
+package repository_test
+
+import (
+	"testing"
+
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestExchangeRatesRepositoryCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "ok", Value: 1},
+			bson.E{Key: "n", Value: 1},
+		))
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		rate, _ := primitive.ParseDecimal128("5.25")
+		result, err := repo.Create(&model.ExchangeRateModel{
+			BaseCurrency:  "USD",
+			QuoteCurrency: "BRL",
+			Rate:          rate,
+		})
+
+		assert.NoError(t, err)
+		assert.NotZero(t, result.ID)
+		assert.NotZero(t, result.CreatedAt)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		document := started.Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "5.25", document.Lookup("rate").Decimal128().String())
+		assert.Equal(t, "BRL/USD", document.Lookup("pair").StringValue())
+	})
+
+	mt.Run("duplicate_pair", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.Create(&model.ExchangeRateModel{BaseCurrency: "BRL", QuoteCurrency: "USD"})
+
+		assert.ErrorIs(t, err, repository.ErrExchangeRateAlreadyExists)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.Create(&model.ExchangeRateModel{BaseCurrency: "USD"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestExchangeRatesRepositoryGetAll(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_retrieval", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+		rate, _ := primitive.ParseDecimal128("0.0065")
+
+		first := mtest.CreateCursorResponse(1, "exchange_rates.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "base_currency", Value: "JPY"},
+			{Key: "quote_currency", Value: "USD"},
+			{Key: "rate", Value: rate},
+		})
+
+		killCursors := mtest.CreateCursorResponse(0, "exchange_rates.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, objectID, result[0].ID)
+		assert.Equal(t, "JPY", result[0].BaseCurrency)
+		assert.Equal(t, "0.0065", result[0].Rate.String())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestExchangeRatesRepositoryGetByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "exchange_rates.entries", mtest.FirstBatch))
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.GetByID(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrExchangeRateNotFound)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("invalid_id", func(mt *mtest.T) {
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		result, err := repo.GetByID("invalid")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestExchangeRatesRepositoryDelete(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_deletion", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "acknowledged", Value: true},
+			{Key: "n", Value: 1},
+		})
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestExchangeRatesRepositoryEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("creates_unique_pair_index", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewExchangeRatesRepository(mt.DB)
+
+		err := repo.EnsureIndexes()
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		index := started.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		assert.Equal(t, "pair_unique", index.Lookup("name").StringValue())
+		assert.True(t, index.Lookup("unique").Boolean())
+	})
+}
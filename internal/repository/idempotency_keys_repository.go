@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	IdempotencyKeyTTL = 24 * time.Hour
+
+	// IdempotencyLeaseTTL bounds how long a reservation without a stored
+	// response blocks the key. A request that crashed or failed to store its
+	// response would otherwise answer 409 to every retry until the key expires.
+	IdempotencyLeaseTTL = time.Minute
+)
+
+type IdempotencyKeysRepository interface {
+	Reserve(record *model.IdempotencyKeyModel) (bool, error)
+	GetByKey(key string) (*model.IdempotencyKeyModel, error)
+	Complete(key string, response []byte) error
+	Delete(key string) error
+	EnsureIndexes() error
+}
+
+type idempotencyKeysRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewIdempotencyKeysRepository(database *mongo.Database) IdempotencyKeysRepository {
+	collection := database.Collection("idempotency_keys")
+	return &idempotencyKeysRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+// Reserve stores the key before the request is processed. It returns false when
+// the key is already taken, so concurrent retries cannot both go through. A
+// reservation that never got a response is taken over once its lease expires.
+func (r *idempotencyKeysRepository) Reserve(record *model.IdempotencyKeyModel) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	record.CreatedAt = time.Now().UTC().Local()
+
+	_, err := r.collection.InsertOne(ctx, record)
+	if mongo.IsDuplicateKeyError(err) {
+		return r.takeOverExpiredLease(ctx, record)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *idempotencyKeysRepository) takeOverExpiredLease(ctx context.Context, record *model.IdempotencyKeyModel) (bool, error) {
+	filter := bson.M{
+		"_id":        record.Key,
+		"response":   bson.M{"$exists": false},
+		"created_at": bson.M{"$lt": record.CreatedAt.Add(-IdempotencyLeaseTTL)},
+	}
+	update := bson.M{"$set": bson.M{
+		"request_hash": record.RequestHash,
+		"created_at":   record.CreatedAt,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+func (r *idempotencyKeysRepository) GetByKey(key string) (*model.IdempotencyKeyModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var record model.IdempotencyKeyModel
+	err := r.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&record)
+	if err != nil {
+		return nil, findError(err, ErrIdempotencyKeyNotFound)
+	}
+
+	return &record, nil
+}
+
+func (r *idempotencyKeysRepository) Complete(key string, response []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{"response": response}})
+	return err
+}
+
+func (r *idempotencyKeysRepository) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+func (r *idempotencyKeysRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().
+			SetName("created_at_ttl").
+			SetExpireAfterSeconds(int32(IdempotencyKeyTTL.Seconds())),
+	})
+	return err
+}
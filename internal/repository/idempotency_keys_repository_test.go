@@ -0,0 +1,130 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestIdempotencyKeysRepositoryReserve(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("new_key", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		record := &model.IdempotencyKeyModel{Key: "retry-123", RequestHash: "abc"}
+		reserved, err := repo.Reserve(record)
+
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.NotZero(t, record.CreatedAt)
+
+		started := mt.GetStartedEvent()
+		document := started.Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "retry-123", document.Lookup("_id").StringValue())
+		assert.Equal(t, "abc", document.Lookup("request_hash").StringValue())
+	})
+
+	mt.Run("existing_key", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+		)
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		reserved, err := repo.Reserve(&model.IdempotencyKeyModel{Key: "retry-123", RequestHash: "abc"})
+
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+	})
+
+	mt.Run("expired_lease_is_taken_over", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		record := &model.IdempotencyKeyModel{Key: "retry-123", RequestHash: "def"}
+		reserved, err := repo.Reserve(record)
+
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+
+		_ = mt.GetStartedEvent() // the rejected insert
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		filter := update.Lookup("q").Document()
+		assert.Equal(t, "retry-123", filter.Lookup("_id").StringValue())
+		assert.False(t, filter.Lookup("response", "$exists").Boolean())
+		leaseStart := filter.Lookup("created_at", "$lt").Time()
+		assert.WithinDuration(t, record.CreatedAt.Add(-repository.IdempotencyLeaseTTL), leaseStart, time.Millisecond)
+		assert.Equal(t, "def", update.Lookup("u", "$set", "request_hash").StringValue())
+	})
+}
+
+func TestIdempotencyKeysRepositoryGetByKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("found", func(mt *mtest.T) {
+		createdAt := time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "idempotency_keys.keys", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "retry-123"},
+			{Key: "request_hash", Value: "abc"},
+			{Key: "response", Value: []byte(`{"id":"1"}`)},
+			{Key: "created_at", Value: createdAt},
+		}))
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		record, err := repo.GetByKey("retry-123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", record.RequestHash)
+		assert.Equal(t, []byte(`{"id":"1"}`), record.Response)
+		assert.Equal(t, createdAt, record.CreatedAt.UTC())
+	})
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "idempotency_keys.keys", mtest.FirstBatch))
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		record, err := repo.GetByKey("missing")
+
+		assert.ErrorIs(t, err, repository.ErrIdempotencyKeyNotFound)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Nil(t, record)
+	})
+}
+
+func TestIdempotencyKeysRepositoryEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("creates_ttl_index", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewIdempotencyKeysRepository(mt.DB)
+
+		err := repo.EnsureIndexes()
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		index := started.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		assert.Equal(t, int32(86400), index.Lookup("expireAfterSeconds").Int32())
+	})
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RecurringRulesRepository interface {
+	Create(rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error)
+	GetAll() ([]*model.RecurringRuleModel, error)
+	GetByID(id string) (*model.RecurringRuleModel, error)
+	Update(id string, rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error)
+	Delete(id string) error
+	GetDue(now time.Time) ([]*model.RecurringRuleModel, error)
+	Advance(rule *model.RecurringRuleModel, previousOccurrences int) (bool, error)
+}
+
+type recurringRulesRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewRecurringRulesRepository(database *mongo.Database) RecurringRulesRepository {
+	collection := database.Collection("recurring_rules")
+	return &recurringRulesRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func (r *recurringRulesRepository) Create(rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rule.CreatedAt = time.Now().UTC().Local()
+	rule.UpdatedAt = time.Now().UTC().Local()
+
+	result, err := r.collection.InsertOne(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		rule.ID = oid
+	}
+
+	return rule, nil
+}
+
+func (r *recurringRulesRepository) GetAll() ([]*model.RecurringRuleModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "next_run_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, bson.M{}, options)
+}
+
+func (r *recurringRulesRepository) GetByID(id string) (*model.RecurringRuleModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	var rule model.RecurringRuleModel
+	err = r.collection.FindOne(ctx, filter).Decode(&rule)
+	if err != nil {
+		return nil, findError(err, ErrRecurringRuleNotFound)
+	}
+
+	return &rule, nil
+}
+
+func (r *recurringRulesRepository) Update(id string, rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.ID = objectID
+	rule.UpdatedAt = time.Now().UTC().Local()
+
+	set := bson.M{
+		"amount":         rule.Amount,
+		"title":          rule.Title,
+		"currency":       rule.Currency,
+		"type":           rule.Type,
+		"category":       rule.Category,
+		"payment_method": rule.PaymentMethod,
+		"description":    rule.Description,
+		"frequency":      rule.Frequency,
+		"interval":       rule.Interval,
+		"start_date":     rule.StartDate,
+		"next_run_at":    rule.NextRunAt,
+		"active":         rule.Active,
+		"updated_at":     rule.UpdatedAt,
+	}
+	unset := bson.M{}
+
+	if rule.AccountID.IsZero() {
+		unset["account_id"] = ""
+	} else {
+		set["account_id"] = rule.AccountID
+	}
+
+	if rule.EndDate == nil {
+		unset["end_date"] = ""
+	} else {
+		set["end_date"] = rule.EndDate
+	}
+
+	if rule.MaxOccurrences == 0 {
+		unset["max_occurrences"] = ""
+	} else {
+		set["max_occurrences"] = rule.MaxOccurrences
+	}
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{"$set": set, "$unset": unset}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrRecurringRuleNotFound
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *recurringRulesRepository) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objectID}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrRecurringRuleNotFound
+	}
+
+	return nil
+}
+
+func (r *recurringRulesRepository) GetDue(now time.Time) ([]*model.RecurringRuleModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"active":      true,
+		"next_run_at": bson.M{"$lte": now},
+	}
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "next_run_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, filter, options)
+}
+
+func (r *recurringRulesRepository) Advance(rule *model.RecurringRuleModel, previousOccurrences int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rule.UpdatedAt = time.Now().UTC().Local()
+
+	filter := bson.M{
+		"_id":                   rule.ID,
+		"occurrences_generated": previousOccurrences,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"occurrences_generated": rule.OccurrencesGenerated,
+			"next_run_at":           rule.NextRunAt,
+			"active":                rule.Active,
+			"updated_at":            rule.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+func (r *recurringRulesRepository) find(ctx context.Context, filter bson.M, findOptions *options.FindOptions) ([]*model.RecurringRuleModel, error) {
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	rules := make([]*model.RecurringRuleModel, 0)
+
+	for cursor.Next(ctx) {
+		var rule model.RecurringRuleModel
+		if err := cursor.Decode(&rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, cursor.Err()
+}
@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestRecurringRulesRepositoryCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "ok", Value: 1},
+			bson.E{Key: "n", Value: 1},
+		))
+
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		startDate := time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)
+		rule := &model.RecurringRuleModel{
+			Amount:    150000,
+			Title:     "Rent",
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: startDate,
+			NextRunAt: startDate,
+			Active:    true,
+		}
+
+		result, err := repo.Create(rule)
+
+		assert.NoError(t, err)
+		assert.NotZero(t, result.ID)
+		assert.NotZero(t, result.CreatedAt)
+	})
+}
+
+func TestRecurringRulesRepositoryGetDue(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("returns_active_due_rules", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+		nextRunAt := time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+		first := mtest.CreateCursorResponse(1, "recurring_rules.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "title", Value: "Rent"},
+			{Key: "frequency", Value: "monthly"},
+			{Key: "interval", Value: 1},
+			{Key: "occurrences_generated", Value: 2},
+			{Key: "next_run_at", Value: nextRunAt},
+			{Key: "active", Value: true},
+		})
+
+		killCursors := mtest.CreateCursorResponse(0, "recurring_rules.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		result, err := repo.GetDue(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC))
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, objectID, result[0].ID)
+		assert.Equal(t, 2, result[0].OccurrencesGenerated)
+		assert.True(t, result[0].NextRunAt.Equal(nextRunAt))
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		assert.True(t, started.Command.Lookup("filter", "active").Boolean())
+		_, hasLte := started.Command.Lookup("filter", "next_run_at").Document().Lookup("$lte").TimeOK()
+		assert.True(t, hasLte)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		result, err := repo.GetDue(time.Now())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRecurringRulesRepositoryAdvance(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("advances_when_progress_matches", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "n", Value: 1},
+			{Key: "nModified", Value: 1},
+		})
+
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		rule := &model.RecurringRuleModel{
+			ID:                   primitive.NewObjectID(),
+			OccurrencesGenerated: 3,
+			NextRunAt:            time.Date(2025, time.April, 5, 0, 0, 0, 0, time.UTC),
+			Active:               true,
+		}
+
+		advanced, err := repo.Advance(rule, 2)
+
+		assert.NoError(t, err)
+		assert.True(t, advanced)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		update := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, int32(2), update.Lookup("q", "occurrences_generated").Int32())
+		assert.Equal(t, int32(3), update.Lookup("u", "$set", "occurrences_generated").Int32())
+	})
+
+	mt.Run("skips_when_rule_already_advanced", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "n", Value: 0},
+			{Key: "nModified", Value: 0},
+		})
+
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		advanced, err := repo.Advance(&model.RecurringRuleModel{ID: primitive.NewObjectID(), OccurrencesGenerated: 3}, 2)
+
+		assert.NoError(t, err)
+		assert.False(t, advanced)
+	})
+}
+
+func TestRecurringRulesRepositoryDelete(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("invalid_id", func(mt *mtest.T) {
+		repo := repository.NewRecurringRulesRepository(mt.DB)
+
+		err := repo.Delete("invalid")
+
+		assert.Error(t, err)
+	})
+}
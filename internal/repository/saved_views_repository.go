@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myfin-api/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SavedViewsRepository interface {
+	Create(view *model.SavedViewModel) (*model.SavedViewModel, error)
+	GetAll() ([]*model.SavedViewModel, error)
+	GetByID(id string) (*model.SavedViewModel, error)
+	Update(id string, view *model.SavedViewModel) (*model.SavedViewModel, error)
+	Delete(id string) error
+}
+
+type savedViewsRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewSavedViewsRepository(database *mongo.Database) SavedViewsRepository {
+	collection := database.Collection("saved_views")
+	return &savedViewsRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func (r *savedViewsRepository) Create(view *model.SavedViewModel) (*model.SavedViewModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	view.CreatedAt = time.Now().UTC().Local()
+	view.UpdatedAt = time.Now().UTC().Local()
+
+	result, err := r.collection.InsertOne(ctx, view)
+	if err != nil {
+		return nil, err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		view.ID = oid
+	}
+
+	return view, nil
+}
+
+func (r *savedViewsRepository) GetAll() ([]*model.SavedViewModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := options.Find()
+	options.SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, options)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	views := make([]*model.SavedViewModel, 0)
+
+	for cursor.Next(ctx) {
+		var view model.SavedViewModel
+		if err := cursor.Decode(&view); err != nil {
+			return nil, err
+		}
+		views = append(views, &view)
+	}
+
+	return views, cursor.Err()
+}
+
+func (r *savedViewsRepository) GetByID(id string) (*model.SavedViewModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	var view model.SavedViewModel
+	err = r.collection.FindOne(ctx, filter).Decode(&view)
+	if err != nil {
+		return nil, findError(err, ErrSavedViewNotFound)
+	}
+
+	return &view, nil
+}
+
+func (r *savedViewsRepository) Update(id string, view *model.SavedViewModel) (*model.SavedViewModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	view.ID = objectID
+	view.UpdatedAt = time.Now().UTC().Local()
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"name":       view.Name,
+			"filters":    view.Filters,
+			"limit":      view.Limit,
+			"skip":       view.Skip,
+			"updated_at": view.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, ErrSavedViewNotFound
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *savedViewsRepository) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objectID}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrSavedViewNotFound
+	}
+
+	return nil
+}
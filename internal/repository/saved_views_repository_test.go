@@ -0,0 +1,177 @@
+package repository_test
+
+import (
+	"testing"
+
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestSavedViewsRepositoryCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "ok", Value: 1},
+			bson.E{Key: "n", Value: 1},
+		))
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		view := &model.SavedViewModel{
+			Name:    "Food",
+			Filters: model.SavedViewFiltersModel{Category: "food", Sort: "-amount"},
+			Limit:   20,
+		}
+
+		result, err := repo.Create(view)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotZero(t, result.ID)
+		assert.NotZero(t, result.CreatedAt)
+
+		started := mt.GetStartedEvent()
+		document := started.Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "food", document.Lookup("filters", "category").StringValue())
+		_, err = document.LookupErr("filters", "title")
+		assert.Error(t, err)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.Create(&model.SavedViewModel{Name: "Food"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSavedViewsRepositoryGetAll(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("sorted_by_name", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "saved_views.entries", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "name", Value: "Food"},
+			{Key: "filters", Value: bson.D{{Key: "category", Value: "food"}}},
+			{Key: "limit", Value: 20},
+		})
+
+		killCursors := mtest.CreateCursorResponse(0, "saved_views.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, objectID, result[0].ID)
+		assert.Equal(t, "food", result[0].Filters.Category)
+		assert.Equal(t, 20, result[0].Limit)
+
+		started := mt.GetStartedEvent()
+		assert.Equal(t, int32(1), started.Command.Lookup("sort", "name").Int32())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.GetAll()
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSavedViewsRepositoryGetByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("not_found", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "saved_views.entries", mtest.FirstBatch))
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.GetByID(primitive.NewObjectID().Hex())
+
+		assert.ErrorIs(t, err, repository.ErrSavedViewNotFound)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("invalid_id", func(mt *mtest.T) {
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.GetByID("invalid")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSavedViewsRepositoryUpdate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_update", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}},
+			mtest.CreateCursorResponse(1, "saved_views.entries", mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: objectID},
+				{Key: "name", Value: "Bars"},
+				{Key: "limit", Value: 50},
+			}),
+		)
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		result, err := repo.Update(objectID.Hex(), &model.SavedViewModel{Name: "Bars", Limit: 50})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bars", result.Name)
+		assert.Equal(t, 50, result.Limit)
+	})
+}
+
+func TestSavedViewsRepositoryDelete(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_deletion", func(mt *mtest.T) {
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "acknowledged", Value: true},
+			{Key: "n", Value: 1},
+		})
+
+		repo := repository.NewSavedViewsRepository(mt.DB)
+
+		err := repo.Delete(primitive.NewObjectID().Hex())
+
+		assert.NoError(t, err)
+	})
+}
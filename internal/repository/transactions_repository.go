@@ -2,8 +2,14 @@ package repository
 
 import (
 	"context"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
+	"myfin-api/internal/domain"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
 	"myfin-api/internal/model"
 	"myfin-api/internal/repository/types"
 
@@ -17,12 +23,42 @@ type TransactionsEntryRepository interface {
 	Create(entry *model.TransactionsEntryModel) (*model.TransactionsEntryModel, error)
 	GetAll(limit, skip int) ([]*model.TransactionsEntryModel, error)
 	GetAllWithFilter(limit, skip int, filter types.FilterOptions) ([]*model.TransactionsEntryModel, error)
-	Delete(id string) error
+	Count(filter types.FilterOptions) (int64, error)
+	Delete(id string, version int64) error
 	Update(id string, entry *model.TransactionsEntryModel) (*model.TransactionsEntryModel, error)
+	Patch(id string, patch types.TransactionPatch) (*model.TransactionsEntryModel, error)
 	GetByID(id string) (*model.TransactionsEntryModel, error)
-	GetTransactions() ([]*model.TransactionsEntryModel, error)
+	CreateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error)
+	UpdateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error)
+	DeleteTransfer(entry, linked *model.TransactionsEntryModel) error
+	ApplyWrites(writes []types.TransactionWrite) error
+	GetExpensesByCategory(from, to time.Time) ([]*types.CategoryTotal, error)
+	GetCategoryTotals(transactionType string, dateRange types.DateRange, expression bson.M) ([]*types.CategoryTotal, error)
+	GetTotalsByCurrency(dateRange types.DateRange, expression bson.M) ([]*types.CurrencyTotal, error)
+	GetCashflowTotals(interval string, dateRange types.DateRange, expression bson.M) ([]*types.CashflowTotal, error)
+	GetAccountTotals(until time.Time) ([]*types.AccountTotal, error)
+	GetAccountTotalsByID(accountID string) ([]*types.AccountTotal, error)
+	CreateRecurringOccurrence(entry *model.TransactionsEntryModel) (bool, error)
+	EnsureIndexes() error
 }
 
+var ErrVersionMismatch = domain.NewError(domain.ErrConflict, i18n.VersionMismatch)
+
+var TransactionFilterFields = map[string]filterql.Field{
+	"amount":        {Path: "amount", Kind: filterql.KindMoney, CurrencyPath: "currency"},
+	"title":         {Path: "title"},
+	"description":   {Path: "description"},
+	"category":      {Path: "category"},
+	"currency":      {Path: "currency"},
+	"type":          {Path: "type", Values: []string{"income", "expense", "transfer"}},
+	"paymentMethod": {Path: "payment_method"},
+	"date":          {Path: "date", Kind: filterql.KindDate},
+}
+
+// categoryCollation compares categories ignoring case but not accents,
+// so budgets and category totals agree on which categories are the same.
+var categoryCollation = &options.Collation{Locale: "en", Strength: 2}
+
 type transactionsEntryRepository struct {
 	database   *mongo.Database
 	collection *mongo.Collection
@@ -46,6 +82,7 @@ func (r *transactionsEntryRepository) Create(entry *model.TransactionsEntryModel
 
 	entry.CreatedAt = time.Now().UTC().Local()
 	entry.UpdatedAt = time.Now().UTC().Local()
+	entry.Version = 1
 
 	result, err := r.collection.InsertOne(ctx, entry)
 	if err != nil {
@@ -73,7 +110,7 @@ func (r *transactionsEntryRepository) GetAll(limit, skip int) ([]*model.Transact
 		options.SetSkip(int64(skip))
 	}
 
-	options.SetSort(bson.D{{Key: "date", Value: -1}})
+	options.SetSort(buildTransactionsSort(nil))
 
 	cursor, err := r.collection.Find(ctx, bson.M{}, options)
 	if err != nil {
@@ -98,14 +135,20 @@ func (r *transactionsEntryRepository) GetAllWithFilter(limit, skip int, filter t
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	query := bson.M{}
+	query := buildTransactionsFilter(filter)
+	sort := buildTransactionsSort(filter.Sort)
+	backward := filter.Cursor != nil && filter.Cursor.Backward
 
-	if filter.Title != "" {
-		query["title"] = bson.M{"$regex": filter.Title, "$options": "i"}
+	if filter.Search != "" && len(filter.Sort) == 0 {
+		sort = append(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}}, sort...)
 	}
 
-	if filter.Category != "" {
-		query["category"] = bson.M{"$regex": "^" + filter.Category + "$", "$options": "i"}
+	if filter.Cursor != nil {
+		query["$or"] = cursorFilter(filter.Cursor)
+	}
+
+	if backward {
+		sort = reverseSort(sort)
 	}
 
 	options := options.Find()
@@ -118,7 +161,7 @@ func (r *transactionsEntryRepository) GetAllWithFilter(limit, skip int, filter t
 		options.SetSkip(int64(skip))
 	}
 
-	options.SetSort(bson.D{{Key: "date", Value: -1}})
+	options.SetSort(sort)
 
 	cursor, err := r.collection.Find(ctx, query, options)
 	if err != nil {
@@ -136,28 +179,47 @@ func (r *transactionsEntryRepository) GetAllWithFilter(limit, skip int, filter t
 		entries = append(entries, &entry)
 	}
 
+	if backward {
+		slices.Reverse(entries)
+	}
+
 	return entries, cursor.Err()
 }
 
-func (r *transactionsEntryRepository) Delete(id string) error {
+func (r *transactionsEntryRepository) Count(filter types.FilterOptions) (int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	objectID, err := primitive.ObjectIDFromHex(id)
+	return r.collection.CountDocuments(ctx, buildTransactionsFilter(filter))
+}
+
+func (r *transactionsEntryRepository) Delete(id string, version int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
 	if err != nil {
 		return err
 	}
 
-	filter := bson.M{"_id": objectID}
-	_, err = r.collection.DeleteOne(ctx, filter)
-	return err
+	filter := bson.M{"_id": objectID, "version": version}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return r.versionMismatch(ctx, objectID)
+	}
+
+	return nil
 }
 
 func (r *transactionsEntryRepository) Update(id string, entry *model.TransactionsEntryModel) (*model.TransactionsEntryModel, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	objectID, err := primitive.ObjectIDFromHex(id)
+	objectID, err := objectIDFromHex(id)
 	if err != nil {
 		return nil, err
 	}
@@ -165,34 +227,125 @@ func (r *transactionsEntryRepository) Update(id string, entry *model.Transaction
 	entry.ID = objectID
 	entry.UpdatedAt = time.Now().UTC().Local()
 
-	filter := bson.M{"_id": objectID}
-	update := bson.M{
-		"$set": bson.M{
-			"amount":         entry.Amount,
-			"title":          entry.Title,
-			"currency":       entry.Currency,
-			"type":           entry.Type,
-			"category":       entry.Category,
-			"payment_method": entry.PaymentMethod,
-			"description":    entry.Description,
-			"date":           entry.Date,
-			"updated_at":     entry.UpdatedAt,
-		},
+	filter := bson.M{"_id": objectID, "version": entry.Version}
+	result, err := r.collection.UpdateOne(ctx, filter, entryUpdate(entry))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, r.versionMismatch(ctx, objectID)
+	}
+
+	return r.GetByID(id)
+}
+
+func entryUpdate(entry *model.TransactionsEntryModel) bson.M {
+	set := bson.M{
+		"amount":         entry.Amount,
+		"title":          entry.Title,
+		"currency":       entry.Currency,
+		"type":           entry.Type,
+		"category":       entry.Category,
+		"payment_method": entry.PaymentMethod,
+		"description":    entry.Description,
+		"date":           entry.Date,
+		"updated_at":     entry.UpdatedAt,
+	}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+
+	if entry.AccountID.IsZero() {
+		update["$unset"] = bson.M{"account_id": ""}
+	} else {
+		set["account_id"] = entry.AccountID
+	}
+
+	return update
+}
+
+func (r *transactionsEntryRepository) Patch(id string, patch types.TransactionPatch) (*model.TransactionsEntryModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := objectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{"updated_at": time.Now().UTC().Local()}
+	unset := bson.M{}
+
+	if patch.Amount != nil {
+		set["amount"] = *patch.Amount
+	}
+	if patch.Title != nil {
+		set["title"] = *patch.Title
+	}
+	if patch.Currency != nil {
+		set["currency"] = *patch.Currency
+	}
+	if patch.Type != nil {
+		set["type"] = *patch.Type
+	}
+	if patch.Category != nil {
+		set["category"] = *patch.Category
+	}
+	if patch.PaymentMethod != nil {
+		set["payment_method"] = *patch.PaymentMethod
+	}
+	if patch.Date != nil {
+		set["date"] = *patch.Date
+	}
+
+	if patch.Description != nil {
+		set["description"] = *patch.Description
+	} else if patch.RemoveDescription {
+		unset["description"] = ""
+	}
+
+	if patch.AccountID != nil {
+		set["account_id"] = *patch.AccountID
+	} else if patch.RemoveAccountID {
+		unset["account_id"] = ""
+	}
+
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	if len(unset) > 0 {
+		update["$unset"] = unset
 	}
 
-	_, err = r.collection.UpdateOne(ctx, filter, update)
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID, "version": patch.Version}, update)
 	if err != nil {
 		return nil, err
 	}
 
+	if result.MatchedCount == 0 {
+		return nil, r.versionMismatch(ctx, objectID)
+	}
+
 	return r.GetByID(id)
 }
 
+// versionMismatch explains why a conditional write matched nothing: either the
+// transaction is gone or its version moved on.
+func (r *transactionsEntryRepository) versionMismatch(ctx context.Context, objectID primitive.ObjectID) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrTransactionNotFound
+	}
+
+	return ErrVersionMismatch
+}
+
 func (r *transactionsEntryRepository) GetByID(id string) (*model.TransactionsEntryModel, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	objectID, err := primitive.ObjectIDFromHex(id)
+	objectID, err := objectIDFromHex(id)
 	if err != nil {
 		return nil, err
 	}
@@ -201,32 +354,576 @@ func (r *transactionsEntryRepository) GetByID(id string) (*model.TransactionsEnt
 	var entry model.TransactionsEntryModel
 	err = r.collection.FindOne(ctx, filter).Decode(&entry)
 	if err != nil {
-		return nil, err
+		return nil, findError(err, ErrTransactionNotFound)
 	}
 
 	return &entry, nil
 }
 
-func (r *transactionsEntryRepository) GetTransactions() ([]*model.TransactionsEntryModel, error) {
+func (r *transactionsEntryRepository) CreateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	cursor, err := r.collection.Find(ctx, bson.M{})
+	now := time.Now().UTC().Local()
 
+	outgoing.ID = primitive.NewObjectID()
+	incoming.ID = primitive.NewObjectID()
+	outgoing.LinkedTransactionID = incoming.ID
+	incoming.LinkedTransactionID = outgoing.ID
+
+	for _, entry := range []*model.TransactionsEntryModel{outgoing, incoming} {
+		if entry.Timestamp == 0 {
+			entry.Timestamp = now.Unix()
+		}
+		entry.CreatedAt = now
+		entry.UpdatedAt = now
+		entry.Version = 1
+	}
+
+	err := withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		if _, err := r.collection.InsertOne(sc, outgoing); err != nil {
+			return err
+		}
+
+		_, err := r.collection.InsertOne(sc, incoming)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return outgoing, incoming, nil
+}
+
+func (r *transactionsEntryRepository) UpdateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC().Local()
+
+	err := withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		for _, entry := range []*model.TransactionsEntryModel{outgoing, incoming} {
+			entry.UpdatedAt = now
+
+			update := bson.M{
+				"$set": bson.M{
+					"amount":      entry.Amount,
+					"title":       entry.Title,
+					"currency":    entry.Currency,
+					"description": entry.Description,
+					"date":        entry.Date,
+					"account_id":  entry.AccountID,
+					"updated_at":  entry.UpdatedAt,
+				},
+				"$inc": bson.M{"version": 1},
+			}
+
+			result, err := r.collection.UpdateOne(sc, bson.M{"_id": entry.ID, "version": entry.Version}, update)
+			if err != nil {
+				return err
+			}
+
+			if result.MatchedCount == 0 {
+				return ErrVersionMismatch
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outgoing.Version++
+	incoming.Version++
+
+	return outgoing, incoming, nil
+}
+
+// DeleteTransfer removes both legs of a transfer, each only if it is still at
+// the version it was read at.
+func (r *transactionsEntryRepository) DeleteTransfer(entry, linked *model.TransactionsEntryModel) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		if err := r.deleteVersion(sc, entry); err != nil {
+			return err
+		}
+
+		return r.deleteVersion(sc, linked)
+	})
+}
+
+func (r *transactionsEntryRepository) deleteVersion(ctx context.Context, entry *model.TransactionsEntryModel) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": entry.ID, "version": entry.Version})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func (r *transactionsEntryRepository) ApplyWrites(writes []types.TransactionWrite) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := withTransaction(ctx, r.database, func(sc mongo.SessionContext) error {
+		now := time.Now().UTC().Local()
+
+		for i, write := range writes {
+			entry := write.Entry
+
+			var err error
+			switch write.Op {
+			case types.TransactionWriteCreate:
+				entry.ID = primitive.NewObjectID()
+				if entry.Timestamp == 0 {
+					entry.Timestamp = now.Unix()
+				}
+				entry.CreatedAt = now
+				entry.UpdatedAt = now
+				entry.Version = 1
+				_, err = r.collection.InsertOne(sc, entry)
+			case types.TransactionWriteUpdate:
+				entry.UpdatedAt = now
+				var result *mongo.UpdateResult
+				result, err = r.collection.UpdateOne(sc, bson.M{"_id": entry.ID, "version": entry.Version}, entryUpdate(entry))
+				if err == nil && result.MatchedCount == 0 {
+					err = ErrVersionMismatch
+				}
+			case types.TransactionWriteDelete:
+				err = r.deleteVersion(sc, entry)
+				if err == nil && write.Linked != nil {
+					err = r.deleteVersion(sc, write.Linked)
+				}
+			}
+			if err != nil {
+				return &types.TransactionWriteError{Index: i, Err: err}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, write := range writes {
+		if write.Op == types.TransactionWriteUpdate {
+			write.Entry.Version++
+		}
+	}
+
+	return nil
+}
+
+// withTransaction runs fn in a multi-document transaction on database, which
+// needs a replica set or sharded cluster.
+func withTransaction(ctx context.Context, database *mongo.Database, fn func(sc mongo.SessionContext) error) error {
+	session, err := database.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return transactionError(err)
+}
+
+func (r *transactionsEntryRepository) GetExpensesByCategory(from, to time.Time) ([]*types.CategoryTotal, error) {
+	return r.GetCategoryTotals("expense", types.DateRange{From: from, To: to}, nil)
+}
+
+// GetCategoryTotals groups categories regardless of case with categoryCollation,
+// since $toLower only lowercases ASCII and would keep "ALIMENTAÇÃO" apart from
+// "alimentação". Categories are returned lowercased.
+func (r *transactionsEntryRepository) GetCategoryTotals(transactionType string, dateRange types.DateRange, expression bson.M) ([]*types.CategoryTotal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{"type": transactionType}
+	if dateFilter := dateRangeFilter(dateRange); len(dateFilter) > 0 {
+		match["date"] = dateFilter
+	}
+	if expression != nil {
+		match["$and"] = bson.A{expression}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"category": "$category",
+				"currency": bson.M{"$toUpper": "$currency"},
+			},
+			"total": bson.M{"$sum": "$amount"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"category": "$_id.category",
+			"currency": "$_id.currency",
+			"total":    1,
+			"count":    1,
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "total", Value: -1},
+			{Key: "category", Value: 1},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetCollation(categoryCollation))
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	entries := make([]*model.TransactionsEntryModel, 0)
+	totals := make([]*types.CategoryTotal, 0)
 
 	for cursor.Next(ctx) {
-		var entry model.TransactionsEntryModel
-		if err := cursor.Decode(&entry); err != nil {
+		var total types.CategoryTotal
+		if err := cursor.Decode(&total); err != nil {
 			return nil, err
 		}
-		entries = append(entries, &entry)
+		total.Category = strings.ToLower(total.Category)
+		totals = append(totals, &total)
 	}
 
-	return entries, cursor.Err()
+	return totals, cursor.Err()
+}
+
+func (r *transactionsEntryRepository) GetTotalsByCurrency(dateRange types.DateRange, expression bson.M) ([]*types.CurrencyTotal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{"type": bson.M{"$in": bson.A{"income", "expense"}}}
+	if dateFilter := dateRangeFilter(dateRange); len(dateFilter) > 0 {
+		match["date"] = dateFilter
+	}
+	if expression != nil {
+		match["$and"] = bson.A{expression}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"currency": bson.M{"$toUpper": "$currency"},
+				"type":     "$type",
+			},
+			"total": bson.M{"$sum": "$amount"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"currency": "$_id.currency",
+			"type":     "$_id.type",
+			"total":    1,
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	totals := make([]*types.CurrencyTotal, 0)
+
+	for cursor.Next(ctx) {
+		var total types.CurrencyTotal
+		if err := cursor.Decode(&total); err != nil {
+			return nil, err
+		}
+		totals = append(totals, &total)
+	}
+
+	return totals, cursor.Err()
+}
+
+func (r *transactionsEntryRepository) GetCashflowTotals(interval string, dateRange types.DateRange, expression bson.M) ([]*types.CashflowTotal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{"type": bson.M{"$in": bson.A{"income", "expense"}}}
+	if dateFilter := dateRangeFilter(dateRange); len(dateFilter) > 0 {
+		match["date"] = dateFilter
+	}
+	if expression != nil {
+		match["$and"] = bson.A{expression}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"period": bson.M{"$dateTrunc": bson.M{
+					"date":        "$date",
+					"unit":        interval,
+					"startOfWeek": "monday",
+				}},
+				"currency": bson.M{"$toUpper": "$currency"},
+				"type":     "$type",
+			},
+			"total": bson.M{"$sum": "$amount"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"period":   "$_id.period",
+			"currency": "$_id.currency",
+			"type":     "$_id.type",
+			"total":    1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "period", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	totals := make([]*types.CashflowTotal, 0)
+
+	for cursor.Next(ctx) {
+		var total types.CashflowTotal
+		if err := cursor.Decode(&total); err != nil {
+			return nil, err
+		}
+		totals = append(totals, &total)
+	}
+
+	return totals, cursor.Err()
+}
+
+func (r *transactionsEntryRepository) GetAccountTotals(until time.Time) ([]*types.AccountTotal, error) {
+	match := bson.M{"account_id": bson.M{"$exists": true}}
+	if !until.IsZero() {
+		match["date"] = bson.M{"$lt": until}
+	}
+
+	return r.aggregateAccountTotals(match)
+}
+
+func (r *transactionsEntryRepository) GetAccountTotalsByID(accountID string) ([]*types.AccountTotal, error) {
+	objectID, err := objectIDFromHex(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.aggregateAccountTotals(bson.M{"account_id": objectID})
+}
+
+func (r *transactionsEntryRepository) aggregateAccountTotals(match bson.M) ([]*types.AccountTotal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"account_id":         "$account_id",
+				"type":               "$type",
+				"transfer_direction": "$transfer_direction",
+			},
+			"total": bson.M{"$sum": "$amount"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":                0,
+			"account_id":         "$_id.account_id",
+			"type":               "$_id.type",
+			"transfer_direction": "$_id.transfer_direction",
+			"total":              1,
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	totals := make([]*types.AccountTotal, 0)
+
+	for cursor.Next(ctx) {
+		var total types.AccountTotal
+		if err := cursor.Decode(&total); err != nil {
+			return nil, err
+		}
+		totals = append(totals, &total)
+	}
+
+	return totals, cursor.Err()
+}
+
+func buildTransactionsFilter(filter types.FilterOptions) bson.M {
+	query := bson.M{}
+
+	if filter.Search != "" {
+		query["$text"] = bson.M{"$search": filter.Search}
+	}
+
+	if filter.Title != "" {
+		query["title"] = bson.M{"$regex": regexp.QuoteMeta(filter.Title), "$options": "i"}
+	}
+
+	if len(filter.Categories) > 0 {
+		categories := make(bson.A, 0, len(filter.Categories))
+		for _, category := range filter.Categories {
+			categories = append(categories, exactMatchRegex(category))
+		}
+		query["category"] = bson.M{"$in": categories}
+	}
+
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+
+	if filter.Currency != "" {
+		query["currency"] = exactMatchRegex(filter.Currency)
+	}
+
+	if filter.PaymentMethod != "" {
+		query["payment_method"] = exactMatchRegex(filter.PaymentMethod)
+	}
+
+	if dateFilter := dateRangeFilter(filter.DateRange); len(dateFilter) > 0 {
+		query["date"] = dateFilter
+	}
+
+	amountFilter := bson.M{}
+	if filter.MinAmount != nil {
+		amountFilter["$gte"] = *filter.MinAmount
+	}
+	if filter.MaxAmount != nil {
+		amountFilter["$lte"] = *filter.MaxAmount
+	}
+	if len(amountFilter) > 0 {
+		query["amount"] = amountFilter
+	}
+
+	if filter.Expression != nil {
+		query["$and"] = bson.A{filter.Expression}
+	}
+
+	return query
+}
+
+func buildTransactionsSort(sortFields []types.SortField) bson.D {
+	if len(sortFields) == 0 {
+		sortFields = []types.SortField{{Field: "date", Descending: true}}
+	}
+
+	sort := make(bson.D, 0, len(sortFields)+1)
+	for _, sortField := range sortFields {
+		sort = append(sort, bson.E{Key: sortField.Field, Value: sortDirection(sortField.Descending)})
+	}
+
+	return append(sort, bson.E{Key: "_id", Value: sortDirection(sortFields[0].Descending)})
+}
+
+func reverseSort(sort bson.D) bson.D {
+	reversed := make(bson.D, 0, len(sort))
+	for _, element := range sort {
+		if direction, ok := element.Value.(int); ok {
+			element.Value = -direction
+		}
+		reversed = append(reversed, element)
+	}
+
+	return reversed
+}
+
+func cursorFilter(cursor *types.Cursor) bson.A {
+	operator := "$lt"
+	if cursor.Backward {
+		operator = "$gt"
+	}
+
+	return bson.A{
+		bson.M{"date": bson.M{operator: cursor.Date}},
+		bson.M{"date": cursor.Date, "_id": bson.M{operator: cursor.ID}},
+	}
+}
+
+func sortDirection(descending bool) int {
+	if descending {
+		return -1
+	}
+	return 1
+}
+
+func exactMatchRegex(value string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(value) + "$", Options: "i"}
+}
+
+func dateRangeFilter(dateRange types.DateRange) bson.M {
+	filter := bson.M{}
+	if !dateRange.From.IsZero() {
+		filter["$gte"] = dateRange.From
+	}
+	if !dateRange.To.IsZero() {
+		filter["$lt"] = dateRange.To
+	}
+
+	return filter
+}
+
+func (r *transactionsEntryRepository) CreateRecurringOccurrence(entry *model.TransactionsEntryModel) (bool, error) {
+	_, err := r.Create(entry)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *transactionsEntryRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	recurringOccurrenceIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "recurring_rule_id", Value: 1},
+			{Key: "recurring_occurrence", Value: 1},
+		},
+		Options: options.Index().
+			SetName("recurring_rule_occurrence_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"recurring_rule_id": bson.M{"$exists": true}}),
+	}
+
+	dateIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "date", Value: 1}},
+		Options: options.Index().SetName("date"),
+	}
+
+	textIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "title", Value: "text"},
+			{Key: "description", Value: "text"},
+			{Key: "category", Value: "text"},
+		},
+		Options: options.Index().
+			SetName("transactions_text").
+			SetDefaultLanguage("none").
+			SetWeights(bson.D{
+				{Key: "title", Value: 10},
+				{Key: "category", Value: 5},
+				{Key: "description", Value: 1},
+			}),
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{recurringOccurrenceIndex, dateIndex, textIndex})
+	return err
 }
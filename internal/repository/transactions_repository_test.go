@@ -1,10 +1,13 @@
 package repository_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
+	"myfin-api/internal/domain"
+	"myfin-api/internal/filterql"
 	"myfin-api/internal/model"
 	"myfin-api/internal/repository"
 	"myfin-api/internal/repository/types"
@@ -30,7 +33,7 @@ func TestTransactionsEntryRepositoryCreate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		entry := &model.TransactionsEntryModel{
-			Amount:      100.0,
+			Amount:      10000,
 			Description: "Test entry",
 		}
 
@@ -54,7 +57,7 @@ func TestTransactionsEntryRepositoryCreate(t *testing.T) {
 
 		customTimestamp := int64(1234567890)
 		entry := &model.TransactionsEntryModel{
-			Amount:      200.0,
+			Amount:      20000,
 			Description: "Test entry with timestamp",
 			Timestamp:   customTimestamp,
 		}
@@ -77,7 +80,7 @@ func TestTransactionsEntryRepositoryCreate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		entry := &model.TransactionsEntryModel{
-			Amount:      300.0,
+			Amount:      30000,
 			Description: "Test entry that will fail",
 		}
 
@@ -98,7 +101,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID1},
-			{"amount", 150.75},
+			{"amount", int64(15075)},
 			{"title", "Lunch at restaurant"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -113,7 +116,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 
 		second := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.NextBatch, bson.D{
 			{"_id", objectID2},
-			{"amount", 2500.0},
+			{"amount", int64(250000)},
 			{"title", "Monthly salary"},
 			{"currency", "BRL"},
 			{"type", "income"},
@@ -139,7 +142,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		assert.Len(t, result, 2)
 
 		assert.Equal(t, objectID1, result[0].ID)
-		assert.Equal(t, 150.75, result[0].Amount)
+		assert.Equal(t, int64(15075), result[0].Amount)
 		assert.Equal(t, "BRL", result[0].Currency)
 		assert.Equal(t, "expense", result[0].Type)
 		assert.Equal(t, "food", result[0].Category)
@@ -147,7 +150,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		assert.Equal(t, "Lunch at restaurant", result[0].Description)
 
 		assert.Equal(t, objectID2, result[1].ID)
-		assert.Equal(t, 2500.0, result[1].Amount)
+		assert.Equal(t, int64(250000), result[1].Amount)
 		assert.Equal(t, "income", result[1].Type)
 		assert.Equal(t, "salary", result[1].Category)
 	})
@@ -157,7 +160,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 89.99},
+			{"amount", int64(8999)},
 			{"title", "Lunch at restaurant"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -182,7 +185,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Len(t, result, 1)
 		assert.Equal(t, objectID, result[0].ID)
-		assert.Equal(t, 89.99, result[0].Amount)
+		assert.Equal(t, int64(8999), result[0].Amount)
 		assert.Equal(t, "entertainment", result[0].Category)
 	})
 
@@ -222,7 +225,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 45.90},
+			{"amount", int64(4590)},
 			{"title", "Lunch at restaurant"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -244,7 +247,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Len(t, result, 1)
-		assert.Equal(t, 45.90, result[0].Amount)
+		assert.Equal(t, int64(4590), result[0].Amount)
 		assert.Equal(t, "transport", result[0].Category)
 	})
 	mt.Run("only_skip_parameter", func(mt *mtest.T) {
@@ -252,7 +255,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 320.50},
+			{"amount", int64(32050)},
 			{"title", "Lunch at restaurant"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -274,7 +277,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Len(t, result, 1)
-		assert.Equal(t, 320.50, result[0].Amount)
+		assert.Equal(t, int64(32050), result[0].Amount)
 		assert.Equal(t, "utilities", result[0].Category)
 	})
 	mt.Run("negative_parameters", func(mt *mtest.T) {
@@ -283,7 +286,7 @@ func TestTransactionsEntryRepositoryGetAll(t *testing.T) {
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
 			{"title", "Lunch at restaurant"},
-			{"amount", 100.0},
+			{"amount", int64(10000)},
 			{"currency", "USD"},
 			{"type", "income"},
 		})
@@ -310,7 +313,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{Key: "_id", Value: objectID},
-			{Key: "amount", Value: 50.0},
+			{Key: "amount", Value: int64(5000)},
 			{Key: "title", Value: "Coffee Shop Visit"},
 			{Key: "currency", Value: "BRL"},
 			{Key: "type", Value: "expense"},
@@ -330,8 +333,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "coffee",
-			Category: "", // Empty category
+			Title: "coffee",
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -349,7 +351,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{Key: "_id", Value: objectID},
-			{Key: "amount", Value: 25.0},
+			{Key: "amount", Value: int64(2500)},
 			{Key: "title", Value: "Bus Ticket"},
 			{Key: "currency", Value: "BRL"},
 			{Key: "type", Value: "expense"},
@@ -367,8 +369,8 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "", // Empty title
-			Category: "transport",
+			Title:      "", // Empty title
+			Categories: []string{"transport"},
 		}
 
 		result, err := repo.GetAllWithFilter(5, 0, filter)
@@ -385,7 +387,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID1},
-			{"amount", 120.0},
+			{"amount", int64(12000)},
 			{"title", "Lunch at Restaurant"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -397,7 +399,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		second := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.NextBatch, bson.D{
 			{"_id", objectID2},
-			{"amount", 35.0},
+			{"amount", int64(3500)},
 			{"title", "Quick lunch"},
 			{"currency", "BRL"},
 			{"type", "expense"},
@@ -414,8 +416,8 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "lunch",
-			Category: "food",
+			Title:      "lunch",
+			Categories: []string{"food"},
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -430,12 +432,168 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		}
 	})
 
+	mt.Run("rich_filters_build_query", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		minAmount := int64(1000)
+		maxAmount := int64(5000)
+		from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+		filter := types.FilterOptions{
+			Categories:    []string{"food", "c++"},
+			Type:          "expense",
+			Currency:      "brl",
+			PaymentMethod: "pix",
+			DateRange:     types.DateRange{From: from},
+			MinAmount:     &minAmount,
+			MaxAmount:     &maxAmount,
+		}
+
+		result, err := repo.GetAllWithFilter(10, 0, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		started := mt.GetStartedEvent()
+		query := started.Command.Lookup("filter").Document()
+
+		categories := query.Lookup("category", "$in").Array()
+		pattern, options := categories.Index(1).Value().Regex()
+		assert.Equal(t, `^c\+\+$`, pattern)
+		assert.Equal(t, "i", options)
+
+		assert.Equal(t, "expense", query.Lookup("type").StringValue())
+		currencyPattern, _ := query.Lookup("currency").Regex()
+		assert.Equal(t, "^brl$", currencyPattern)
+		assert.Equal(t, from, query.Lookup("date", "$gte").Time().UTC())
+		assert.Equal(t, int64(1000), query.Lookup("amount", "$gte").Int64())
+		assert.Equal(t, int64(5000), query.Lookup("amount", "$lte").Int64())
+	})
+
+	mt.Run("filter_expression_combined_with_cursor", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		expression, err := filterql.Compile("category = food or category = bars", repository.TransactionFilterFields)
+		assert.NoError(t, err)
+
+		_, err = repo.GetAllWithFilter(10, 0, types.FilterOptions{
+			Type:       "expense",
+			Expression: expression,
+			Cursor:     &types.Cursor{Date: time.Now(), ID: primitive.NewObjectID()},
+		})
+
+		assert.NoError(t, err)
+
+		query := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "expense", query.Lookup("type").StringValue())
+
+		branches := query.Lookup("$and").Array().Index(0).Value().Document().Lookup("$or").Array()
+		pattern, _ := branches.Index(1).Value().Document().Lookup("category").Regex()
+		assert.Equal(t, "^bars$", pattern)
+
+		_, err = query.LookupErr("$or")
+		assert.NoError(t, err)
+	})
+
+	mt.Run("custom_sort_with_id_tiebreaker", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(10, 0, types.FilterOptions{
+			Sort: []types.SortField{{Field: "amount"}, {Field: "date", Descending: true}},
+		})
+
+		assert.NoError(t, err)
+
+		sort := mt.GetStartedEvent().Command.Lookup("sort").Document()
+		elements, _ := sort.Elements()
+		assert.Len(t, elements, 3)
+		assert.Equal(t, "amount", elements[0].Key())
+		assert.Equal(t, int32(1), elements[0].Value().Int32())
+		assert.Equal(t, "date", elements[1].Key())
+		assert.Equal(t, int32(-1), elements[1].Value().Int32())
+		assert.Equal(t, "_id", elements[2].Key())
+		assert.Equal(t, int32(1), elements[2].Value().Int32())
+	})
+
+	mt.Run("default_sort", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(10, 0, types.FilterOptions{Title: "lunch"})
+
+		assert.NoError(t, err)
+
+		sort := mt.GetStartedEvent().Command.Lookup("sort").Document()
+		elements, _ := sort.Elements()
+		assert.Len(t, elements, 2)
+		assert.Equal(t, "date", elements[0].Key())
+		assert.Equal(t, int32(-1), elements[0].Value().Int32())
+		assert.Equal(t, "_id", elements[1].Key())
+		assert.Equal(t, int32(-1), elements[1].Value().Int32())
+	})
+
+	mt.Run("text_search_orders_by_relevance", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(10, 0, types.FilterOptions{Search: "sao joao"})
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "sao joao", started.Command.Lookup("filter", "$text", "$search").StringValue())
+
+		sort, _ := started.Command.Lookup("sort").Document().Elements()
+		assert.Len(t, sort, 3)
+		assert.Equal(t, "score", sort[0].Key())
+		assert.Equal(t, "textScore", sort[0].Value().Document().Lookup("$meta").StringValue())
+		assert.Equal(t, "date", sort[1].Key())
+	})
+
+	mt.Run("text_search_with_explicit_sort", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(10, 0, types.FilterOptions{
+			Search: "padaria",
+			Sort:   []types.SortField{{Field: "amount", Descending: true}},
+		})
+
+		assert.NoError(t, err)
+
+		sort, _ := mt.GetStartedEvent().Command.Lookup("sort").Document().Elements()
+		assert.Len(t, sort, 2)
+		assert.Equal(t, "amount", sort[0].Key())
+	})
+
+	mt.Run("title_special_characters_are_escaped", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(10, 0, types.FilterOptions{Title: "lunch (team)"})
+
+		assert.NoError(t, err)
+
+		pattern := mt.GetStartedEvent().Command.Lookup("filter", "title", "$regex").StringValue()
+		assert.Equal(t, `lunch \(team\)`, pattern)
+	})
+
 	mt.Run("no_filters_empty_strings", func(mt *mtest.T) {
 		objectID := primitive.NewObjectID()
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 200.0},
+			{"amount", int64(20000)},
 			{"title", "Any Title"},
 			{"category", "any_category"},
 		})
@@ -447,8 +605,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "", // Empty filters should return all entries
-			Category: "",
+			Title: "", // Empty filters should return all entries
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -463,7 +620,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 150.0},
+			{"amount", int64(15000)},
 			{"title", "Test Entry"},
 			{"category", "test"},
 		})
@@ -475,8 +632,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "test",
-			Category: "",
+			Title: "test",
 		}
 
 		result, err := repo.GetAllWithFilter(0, -1, filter)
@@ -495,8 +651,8 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "test",
-			Category: "error",
+			Title:      "test",
+			Categories: []string{"error"},
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -511,7 +667,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{Key: "_id", Value: objectID},
-			{Key: "amount", Value: 100.0},
+			{Key: "amount", Value: int64(10000)},
 			{Key: "title", Value: "Test Entry"},
 			{Key: "currency", Value: "BRL"},
 			{Key: "type", Value: "expense"},
@@ -527,8 +683,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "test",
-			Category: "",
+			Title: "test",
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -547,7 +702,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 
 		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{"_id", objectID},
-			{"amount", 75.0},
+			{"amount", int64(7500)},
 			{"title", "Complete Test"},
 			{"category", "coverage"},
 			{"currency", "BRL"},
@@ -563,8 +718,8 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "Complete",
-			Category: "coverage",
+			Title:      "Complete",
+			Categories: []string{"coverage"},
 		}
 
 		result, err := repo.GetAllWithFilter(5, 2, filter)
@@ -589,8 +744,7 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		filter := types.FilterOptions{
-			Title:    "test",
-			Category: "",
+			Title: "test",
 		}
 
 		result, err := repo.GetAllWithFilter(10, 0, filter)
@@ -600,6 +754,113 @@ func TestTransactionsRepositoryGetAllWithFilter(t *testing.T) {
 	})
 }
 
+func TestTransactionsRepositoryGetAllWithCursor(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	cursorDate := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+	cursorID := primitive.NewObjectID()
+
+	mt.Run("forward_cursor", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		_, err := repo.GetAllWithFilter(11, 0, types.FilterOptions{
+			Type:   "expense",
+			Cursor: &types.Cursor{Date: cursorDate, ID: cursorID},
+		})
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		query := started.Command.Lookup("filter").Document()
+		assert.Equal(t, "expense", query.Lookup("type").StringValue())
+
+		conditions := query.Lookup("$or").Array()
+		assert.Equal(t, cursorDate, conditions.Index(0).Value().Document().Lookup("date", "$lt").Time().UTC())
+		assert.Equal(t, cursorDate, conditions.Index(1).Value().Document().Lookup("date").Time().UTC())
+		assert.Equal(t, cursorID, conditions.Index(1).Value().Document().Lookup("_id", "$lt").ObjectID())
+
+		sort, _ := started.Command.Lookup("sort").Document().Elements()
+		assert.Equal(t, int32(-1), sort[0].Value().Int32())
+		assert.Equal(t, int32(-1), sort[1].Value().Int32())
+	})
+
+	mt.Run("backward_cursor_returns_descending_order", func(mt *mtest.T) {
+		olderID := primitive.NewObjectID()
+		newerID := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch,
+			bson.D{{Key: "_id", Value: olderID}, {Key: "date", Value: cursorDate.AddDate(0, 0, 1)}},
+			bson.D{{Key: "_id", Value: newerID}, {Key: "date", Value: cursorDate.AddDate(0, 0, 2)}},
+		)
+		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.NextBatch)
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetAllWithFilter(11, 0, types.FilterOptions{
+			Cursor: &types.Cursor{Date: cursorDate, ID: cursorID, Backward: true},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, newerID, result[0].ID)
+		assert.Equal(t, olderID, result[1].ID)
+
+		started := mt.GetStartedEvent()
+		conditions := started.Command.Lookup("filter", "$or").Array()
+		assert.Equal(t, cursorID, conditions.Index(1).Value().Document().Lookup("_id", "$gt").ObjectID())
+
+		sort, _ := started.Command.Lookup("sort").Document().Elements()
+		assert.Equal(t, "date", sort[0].Key())
+		assert.Equal(t, int32(1), sort[0].Value().Int32())
+		assert.Equal(t, "_id", sort[1].Key())
+		assert.Equal(t, int32(1), sort[1].Value().Int32())
+	})
+}
+
+func TestTransactionsRepositoryCount(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("counts_matching_documents_without_cursor", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch,
+			bson.D{{Key: "n", Value: int32(42)}},
+		))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		total, err := repo.Count(types.FilterOptions{
+			Type:   "income",
+			Cursor: &types.Cursor{Date: time.Now(), ID: primitive.NewObjectID()},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), total)
+
+		match := mt.GetStartedEvent().Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, "income", match.Lookup("type").StringValue())
+		_, err = match.LookupErr("$or")
+		assert.Error(t, err)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		total, err := repo.Count(types.FilterOptions{})
+
+		assert.Error(t, err)
+		assert.Zero(t, total)
+	})
+}
+
 func TestTransactionsRepositoryDelete(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
@@ -615,7 +876,7 @@ func TestTransactionsRepositoryDelete(t *testing.T) {
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		err := repo.Delete(objectID.Hex())
+		err := repo.Delete(objectID.Hex(), 1)
 
 		assert.NoError(t, err)
 	})
@@ -623,10 +884,10 @@ func TestTransactionsRepositoryDelete(t *testing.T) {
 	mt.Run("invalid_object_id", func(mt *mtest.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		err := repo.Delete("invalid-id")
+		err := repo.Delete("invalid-id", 1)
 
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "ObjectID")
+		assert.ErrorIs(t, err, domain.ErrInvalidID)
 	})
 
 	mt.Run("not_found", func(mt *mtest.T) {
@@ -636,13 +897,33 @@ func TestTransactionsRepositoryDelete(t *testing.T) {
 			bson.E{Key: "ok", Value: 1},
 			bson.E{Key: "n", Value: 0},
 			bson.E{Key: "deletedCount", Value: 0},
-		))
+		), mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch))
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		err := repo.Delete(objectID.Hex())
+		err := repo.Delete(objectID.Hex(), 1)
 
-		assert.NoError(t, err)
+		assert.ErrorIs(t, err, repository.ErrTransactionNotFound)
+	})
+
+	mt.Run("version_mismatch", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
+
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}),
+			mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		err := repo.Delete(objectID.Hex(), 3)
+
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+		started := mt.GetStartedEvent()
+		query := started.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.Equal(t, objectID, query.Lookup("_id").ObjectID())
+		assert.Equal(t, int64(3), query.Lookup("version").Int64())
 	})
 
 	mt.Run("database_error", func(mt *mtest.T) {
@@ -655,7 +936,7 @@ func TestTransactionsRepositoryDelete(t *testing.T) {
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		err := repo.Delete(objectID.Hex())
+		err := repo.Delete(objectID.Hex(), 1)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Database error")
@@ -672,7 +953,7 @@ func TestTransactionsRepositoryGetByID(t *testing.T) {
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 			{Key: "_id", Value: objectID},
-			{Key: "amount", Value: 150.75},
+			{Key: "amount", Value: int64(15075)},
 			{Key: "title", Value: "Lunch at restaurant"},
 			{Key: "currency", Value: "BRL"},
 			{Key: "type", Value: "expense"},
@@ -692,7 +973,7 @@ func TestTransactionsRepositoryGetByID(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, objectID, result.ID)
-		assert.Equal(t, 150.75, result.Amount)
+		assert.Equal(t, int64(15075), result.Amount)
 		assert.Equal(t, "Lunch at restaurant", result.Title)
 		assert.Equal(t, "BRL", result.Currency)
 		assert.Equal(t, "expense", result.Type)
@@ -712,7 +993,7 @@ func TestTransactionsRepositoryGetByID(t *testing.T) {
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "ObjectID")
+		assert.ErrorIs(t, err, domain.ErrInvalidID)
 	})
 
 	mt.Run("database_error", func(mt *mtest.T) {
@@ -752,7 +1033,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 
 			mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 				{Key: "_id", Value: objectID},
-				{Key: "amount", Value: 200.50},
+				{Key: "amount", Value: int64(20050)},
 				{Key: "title", Value: "Updated Title"},
 				{Key: "currency", Value: "USD"},
 				{Key: "type", Value: "expense"},
@@ -769,7 +1050,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		updateEntry := &model.TransactionsEntryModel{
-			Amount:        200.50,
+			Amount:        20050,
 			Title:         "Updated Title",
 			Currency:      "USD",
 			Type:          "expense",
@@ -786,7 +1067,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, objectID, result.ID)
-		assert.Equal(t, 200.50, result.Amount)
+		assert.Equal(t, int64(20050), result.Amount)
 		assert.Equal(t, "Updated Title", result.Title)
 		assert.Equal(t, "USD", result.Currency)
 		assert.Equal(t, "expense", result.Type)
@@ -804,7 +1085,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		updateEntry := &model.TransactionsEntryModel{
-			Amount:   100.0,
+			Amount:   10000,
 			Title:    "Test Entry",
 			Date:     time.Now(),
 			Type:     "expense",
@@ -815,7 +1096,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "ObjectID")
+		assert.ErrorIs(t, err, domain.ErrInvalidID)
 	})
 
 	mt.Run("update_error", func(mt *mtest.T) {
@@ -829,7 +1110,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		updateEntry := &model.TransactionsEntryModel{
-			Amount:   100.0,
+			Amount:   10000,
 			Title:    "Test Entry",
 			Date:     time.Now(),
 			Type:     "expense",
@@ -863,7 +1144,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
 		updateEntry := &model.TransactionsEntryModel{
-			Amount:   100.0,
+			Amount:   10000,
 			Title:    "Test Entry",
 			Date:     time.Now(),
 			Type:     "expense",
@@ -891,7 +1172,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 
 			mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
 				{Key: "_id", Value: objectID},
-				{Key: "amount", Value: 75.0},
+				{Key: "amount", Value: int64(7500)},
 				{Key: "title", Value: "Test Entry"},
 				{Key: "date", Value: time.Now()},
 				{Key: "timestamp", Value: createdTime.Unix()},
@@ -905,7 +1186,7 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 		differentID := primitive.NewObjectID()
 		updateEntry := &model.TransactionsEntryModel{
 			ID:     differentID, // This should be ignored and overwritten with the ID from the parameter
-			Amount: 75.0,
+			Amount: 7500,
 			Title:  "Test Entry",
 			Date:   time.Now(),
 		}
@@ -920,111 +1201,70 @@ func TestTransactionsRepositoryUpdate(t *testing.T) {
 	})
 }
 
-func TestTransactionsEntryRepositoryGetTransactions(t *testing.T) {
+func TestTransactionsRepositoryPatch(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
 
-	mt.Run("successful_retrieval_with_data", func(mt *mtest.T) {
-		objectID1 := primitive.NewObjectID()
-		objectID2 := primitive.NewObjectID()
+	mt.Run("sets_only_given_fields", func(mt *mtest.T) {
+		objectID := primitive.NewObjectID()
 
-		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
-			{"_id", objectID1},
-			{"amount", 150.75},
-			{"title", "Lunch at restaurant"},
-			{"currency", "BRL"},
-			{"type", "expense"},
-			{"category", "food"},
-			{"payment_method", "credit_card"},
-			{"description", "Lunch at restaurant"},
-			{"date", time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)},
-			{"timestamp", int64(1725635400)},
-			{"created_at", time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)},
-			{"updated_at", time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)},
-		})
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(
+				bson.E{Key: "n", Value: 1},
+				bson.E{Key: "nModified", Value: 1},
+			),
+			mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: objectID},
+				{Key: "amount", Value: int64(1250)},
+				{Key: "title", Value: "Groceries"},
+				{Key: "currency", Value: "BRL"},
+				{Key: "type", Value: "expense"},
+			}),
+		)
 
-		second := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.NextBatch, bson.D{
-			{"_id", objectID2},
-			{"amount", 2500.0},
-			{"title", "Monthly salary"},
-			{"currency", "BRL"},
-			{"type", "income"},
-			{"category", "salary"},
-			{"payment_method", "bank_transfer"},
-			{"description", "Monthly salary"},
-			{"date", time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)},
-			{"timestamp", int64(1725008400)},
-			{"created_at", time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)},
-			{"updated_at", time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)},
-		})
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		third := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.NextBatch, bson.D{
-			{"_id", primitive.NewObjectID()},
-			{"amount", 89.99},
-			{"title", "Netflix subscription"},
-			{"currency", "BRL"},
-			{"type", "expense"},
-			{"category", "entertainment"},
-			{"payment_method", "credit_card"},
-			{"description", "Monthly streaming service"},
-			{"date", time.Date(2025, 8, 28, 20, 15, 0, 0, time.UTC)},
-			{"timestamp", int64(1724873700)},
-			{"created_at", time.Date(2025, 8, 28, 20, 15, 0, 0, time.UTC)},
-			{"updated_at", time.Date(2025, 8, 28, 20, 15, 0, 0, time.UTC)},
+		amount := int64(1250)
+		title := "Groceries"
+		result, err := repo.Patch(objectID.Hex(), types.TransactionPatch{
+			Amount:            &amount,
+			Title:             &title,
+			RemoveDescription: true,
 		})
 
-		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.NextBatch)
+		assert.NoError(t, err)
+		assert.Equal(t, objectID, result.ID)
+		assert.Equal(t, "Groceries", result.Title)
 
-		mt.AddMockResponses(first, second, third, killCursors)
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "update", started.CommandName)
 
-		repo := repository.NewTransactionsEntryRepository(mt.DB)
+		update := started.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u").Document()
+		set := update.Lookup("$set").Document()
 
-		result, err := repo.GetTransactions()
+		assert.Equal(t, int64(1250), set.Lookup("amount").Int64())
+		assert.Equal(t, "Groceries", set.Lookup("title").StringValue())
+		assert.False(t, set.Lookup("updated_at").Time().IsZero())
+		_, err = set.LookupErr("currency")
+		assert.Error(t, err)
 
+		unset := update.Lookup("$unset").Document()
+		_, err = unset.LookupErr("description")
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Len(t, result, 3)
-
-		// Verify first transaction
-		assert.Equal(t, objectID1, result[0].ID)
-		assert.Equal(t, 150.75, result[0].Amount)
-		assert.Equal(t, "Lunch at restaurant", result[0].Title)
-		assert.Equal(t, "BRL", result[0].Currency)
-		assert.Equal(t, "expense", result[0].Type)
-		assert.Equal(t, "food", result[0].Category)
-		assert.Equal(t, "credit_card", result[0].PaymentMethod)
-		assert.Equal(t, "Lunch at restaurant", result[0].Description)
-		assert.Equal(t, int64(1725635400), result[0].Timestamp)
-
-		// Verify second transaction
-		assert.Equal(t, objectID2, result[1].ID)
-		assert.Equal(t, 2500.0, result[1].Amount)
-		assert.Equal(t, "Monthly salary", result[1].Title)
-		assert.Equal(t, "income", result[1].Type)
-		assert.Equal(t, "salary", result[1].Category)
-		assert.Equal(t, "bank_transfer", result[1].PaymentMethod)
-
-		// Verify third transaction
-		assert.Equal(t, 89.99, result[2].Amount)
-		assert.Equal(t, "Netflix subscription", result[2].Title)
-		assert.Equal(t, "entertainment", result[2].Category)
+		_, err = unset.LookupErr("account_id")
+		assert.Error(t, err)
 	})
 
-	mt.Run("successful_retrieval_with_empty_result", func(mt *mtest.T) {
-		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.FirstBatch)
-
-		mt.AddMockResponses(killCursors)
-
+	mt.Run("invalid_object_id", func(mt *mtest.T) {
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		result, err := repo.GetTransactions()
+		result, err := repo.Patch("invalid-id", types.TransactionPatch{})
 
-		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Len(t, result, 0)
+		assert.Error(t, err)
+		assert.Nil(t, result)
 	})
 
-	mt.Run("database_connection_error", func(mt *mtest.T) {
+	mt.Run("update_error", func(mt *mtest.T) {
 		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
 			Code:    2,
 			Message: "BadValue",
@@ -1032,111 +1272,813 @@ func TestTransactionsEntryRepositoryGetTransactions(t *testing.T) {
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		result, err := repo.GetTransactions()
+		title := "Groceries"
+		result, err := repo.Patch(primitive.NewObjectID().Hex(), types.TransactionPatch{Title: &title})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "BadValue")
 	})
+}
 
-	mt.Run("cursor_decode_error", func(mt *mtest.T) {
-		// Create a response with invalid data that will cause decode error
-		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
-			{"_id", "invalid_object_id"}, // This should cause a decode error
-			{"amount", "invalid_amount"}, // This should also cause a decode error
-		})
-
-		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.NextBatch)
+func TestTransactionsEntryRepositoryCreateTransfer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
 
-		mt.AddMockResponses(first, killCursors)
+	mt.Run("successful_creation", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		result, err := repo.GetTransactions()
+		outgoing := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "out"}
+		incoming := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "in"}
 
-		assert.Error(t, err)
-		assert.Nil(t, result)
-	})
+		createdOutgoing, createdIncoming, err := repo.CreateTransfer(outgoing, incoming)
 
-	mt.Run("cursor_iteration_error", func(mt *mtest.T) {
-		objectID := primitive.NewObjectID()
-		testDate := time.Date(2025, 9, 27, 10, 0, 0, 0, time.UTC)
+		assert.NoError(t, err)
+		assert.NotZero(t, createdOutgoing.ID)
+		assert.NotZero(t, createdIncoming.ID)
+		assert.Equal(t, createdIncoming.ID, createdOutgoing.LinkedTransactionID)
+		assert.Equal(t, createdOutgoing.ID, createdIncoming.LinkedTransactionID)
+		assert.NotZero(t, createdOutgoing.Timestamp)
+		assert.NotZero(t, createdIncoming.CreatedAt)
+
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "commitTransaction", events[len(events)-1].CommandName)
+	})
 
-		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
-			{"_id", objectID},
-			{"amount", 100.0},
-			{"title", "Test Transaction"},
-			{"currency", "BRL"},
-			{"type", "expense"},
-			{"category", "test"},
-			{"payment_method", "cash"},
-			{"description", "Test description"},
-			{"date", testDate},
-			{"timestamp", testDate.Unix()},
-			{"created_at", testDate},
-			{"updated_at", testDate},
-		})
+	mt.Run("second_leg_fails", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    2,
+				Message: "BadValue",
+			}),
+			mtest.CreateSuccessResponse(),
+		)
 
-		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.NextBatch)
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		mt.AddMockResponses(first, killCursors)
+		outgoing := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "out"}
+		incoming := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "in"}
 
-		repo := repository.NewTransactionsEntryRepository(mt.DB)
+		createdOutgoing, createdIncoming, err := repo.CreateTransfer(outgoing, incoming)
 
-		result, err := repo.GetTransactions()
+		assert.Error(t, err)
+		assert.Nil(t, createdOutgoing)
+		assert.Nil(t, createdIncoming)
 
-		// Based on the implementation, the method returns entries and cursor.Err()
-		// If cursor iteration succeeds, there should be no error
-		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Len(t, result, 1)
-		assert.Equal(t, objectID, result[0].ID)
-		assert.Equal(t, 100.0, result[0].Amount)
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "abortTransaction", events[len(events)-1].CommandName)
 	})
 
-	mt.Run("single_transaction_retrieval", func(mt *mtest.T) {
-		objectID := primitive.NewObjectID()
-		testDate := time.Date(2025, 9, 27, 10, 0, 0, 0, time.UTC)
+	mt.Run("standalone_server", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    20,
+				Name:    "IllegalOperation",
+				Message: "Transaction numbers are only allowed on a replica set member or mongos",
+			}),
+			mtest.CreateSuccessResponse(),
+		)
 
-		first := mtest.CreateCursorResponse(1, "transactions_entries.entries", mtest.FirstBatch, bson.D{
-			{"_id", objectID},
-			{"amount", 42.50},
-			{"title", "Coffee Shop"},
-			{"currency", "USD"},
-			{"type", "expense"},
-			{"category", "food"},
-			{"payment_method", "debit_card"},
-			{"description", "Morning coffee"},
-			{"date", testDate},
-			{"timestamp", testDate.Unix()},
-			{"created_at", testDate},
-			{"updated_at", testDate},
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		outgoing := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "out"}
+		incoming := &model.TransactionsEntryModel{Amount: 10000, Type: "transfer", TransferDirection: "in"}
+
+		_, _, err := repo.CreateTransfer(outgoing, incoming)
+
+		assert.ErrorIs(t, err, repository.ErrTransactionsUnsupported)
+		assert.ErrorIs(t, err, domain.ErrUnsupported)
+	})
+}
+
+func TestTransactionsEntryRepositoryUpdateTransfer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_update", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		outgoing := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Amount: 15000, Version: 2}
+		incoming := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Amount: 15000, Version: 2}
+
+		updatedOutgoing, updatedIncoming, err := repo.UpdateTransfer(outgoing, incoming)
+
+		assert.NoError(t, err)
+		assert.NotZero(t, updatedOutgoing.UpdatedAt)
+		assert.NotZero(t, updatedIncoming.UpdatedAt)
+		assert.Equal(t, int64(3), updatedOutgoing.Version)
+		assert.Equal(t, int64(3), updatedIncoming.Version)
+
+		for _, entry := range []*model.TransactionsEntryModel{outgoing, incoming} {
+			command := mt.GetStartedEvent()
+			assert.Equal(t, "update", command.CommandName)
+			query := command.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document()
+			assert.Equal(t, entry.ID, query.Lookup("_id").ObjectID())
+			assert.Equal(t, int64(2), query.Lookup("version").Int64())
+		}
+	})
+
+	mt.Run("stale_leg_aborts", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		outgoing := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+		incoming := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+
+		_, _, err := repo.UpdateTransfer(outgoing, incoming)
+
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+		assert.Equal(t, int64(1), outgoing.Version)
+
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "abortTransaction", events[len(events)-1].CommandName)
+	})
+}
+
+func TestTransactionsEntryRepositoryDeleteTransfer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_deletion", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		entry := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 2}
+		linked := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 2}
+
+		err := repo.DeleteTransfer(entry, linked)
+
+		assert.NoError(t, err)
+
+		for _, leg := range []*model.TransactionsEntryModel{entry, linked} {
+			command := mt.GetStartedEvent()
+			assert.Equal(t, "delete", command.CommandName)
+			query := command.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+			assert.Equal(t, leg.ID, query.Lookup("_id").ObjectID())
+			assert.Equal(t, int64(2), query.Lookup("version").Int64())
+		}
+	})
+
+	mt.Run("stale_leg_aborts", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		entry := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+		linked := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+
+		err := repo.DeleteTransfer(entry, linked)
+
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+		events := mt.GetAllStartedEvents()
+		assert.Equal(t, "abortTransaction", events[len(events)-1].CommandName)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    2,
+				Message: "Database error",
+			}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		entry := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+		linked := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}
+
+		err := repo.DeleteTransfer(entry, linked)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestTransactionsEntryRepositoryApplyWrites(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_writes", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		created := &model.TransactionsEntryModel{Amount: 1000, Title: "Coffee"}
+		updated := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Amount: 2000, Title: "Lunch", Version: 2}
+		linked := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Type: "transfer", Version: 1}
+		deleted := &model.TransactionsEntryModel{ID: primitive.NewObjectID(), LinkedTransactionID: linked.ID, Type: "transfer", Version: 1}
+
+		err := repo.ApplyWrites([]types.TransactionWrite{
+			{Op: types.TransactionWriteCreate, Entry: created},
+			{Op: types.TransactionWriteUpdate, Entry: updated},
+			{Op: types.TransactionWriteDelete, Entry: deleted, Linked: linked},
 		})
 
-		killCursors := mtest.CreateCursorResponse(0, "transactions_entries.entries", mtest.NextBatch)
+		assert.NoError(t, err)
+		assert.False(t, created.ID.IsZero())
+		assert.NotZero(t, created.Timestamp)
+		assert.NotZero(t, created.CreatedAt)
+		assert.Equal(t, int64(1), created.Version)
+		assert.NotZero(t, updated.UpdatedAt)
+		assert.Equal(t, int64(3), updated.Version)
+
+		assert.Equal(t, "insert", mt.GetStartedEvent().CommandName)
+
+		updateCommand := mt.GetStartedEvent()
+		assert.Equal(t, "update", updateCommand.CommandName)
+		updateQuery := updateCommand.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.Equal(t, int64(2), updateQuery.Lookup("version").Int64())
+
+		deleteCommand := mt.GetStartedEvent()
+		assert.Equal(t, "delete", deleteCommand.CommandName)
+		deleteQuery := deleteCommand.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.Equal(t, deleted.ID, deleteQuery.Lookup("_id").ObjectID())
+		assert.Equal(t, int64(1), deleteQuery.Lookup("version").Int64())
+
+		linkedCommand := mt.GetStartedEvent()
+		assert.Equal(t, "delete", linkedCommand.CommandName)
+		linkedQuery := linkedCommand.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.Equal(t, linked.ID, linkedQuery.Lookup("_id").ObjectID())
+		assert.Equal(t, int64(1), linkedQuery.Lookup("version").Int64())
+	})
+
+	mt.Run("stale_version_reports_index", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		err := repo.ApplyWrites([]types.TransactionWrite{
+			{Op: types.TransactionWriteUpdate, Entry: &model.TransactionsEntryModel{ID: primitive.NewObjectID(), Version: 1}},
+		})
+
+		var writeErr *types.TransactionWriteError
+		if assert.ErrorAs(t, err, &writeErr) {
+			assert.Equal(t, 0, writeErr.Index)
+			assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+		}
+	})
+
+	mt.Run("write_error_reports_index", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		err := repo.ApplyWrites([]types.TransactionWrite{
+			{Op: types.TransactionWriteCreate, Entry: &model.TransactionsEntryModel{Title: "Coffee"}},
+			{Op: types.TransactionWriteCreate, Entry: &model.TransactionsEntryModel{Title: "Lunch"}},
+		})
+
+		var writeErr *types.TransactionWriteError
+		if assert.ErrorAs(t, err, &writeErr) {
+			assert.Equal(t, 1, writeErr.Index)
+		}
+	})
+
+	mt.Run("standalone_server_fails_whole_batch", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    20,
+				Name:    "IllegalOperation",
+				Message: "Transaction numbers are only allowed on a replica set member or mongos",
+			}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		err := repo.ApplyWrites([]types.TransactionWrite{
+			{Op: types.TransactionWriteCreate, Entry: &model.TransactionsEntryModel{Title: "Coffee"}},
+		})
+
+		var writeErr *types.TransactionWriteError
+		assert.False(t, errors.As(err, &writeErr))
+		assert.ErrorIs(t, err, repository.ErrTransactionsUnsupported)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetExpensesByCategory(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "category", Value: "food"},
+				{Key: "currency", Value: "BRL"},
+				{Key: "total", Value: int64(62075)},
+			},
+			bson.D{
+				{Key: "category", Value: "transport"},
+				{Key: "currency", Value: "BRL"},
+				{Key: "total", Value: int64(18000)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
 
 		mt.AddMockResponses(first, killCursors)
 
 		repo := repository.NewTransactionsEntryRepository(mt.DB)
 
-		result, err := repo.GetTransactions()
+		from := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 1, 0)
+
+		result, err := repo.GetExpensesByCategory(from, to)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "food", result[0].Category)
+		assert.Equal(t, "BRL", result[0].Currency)
+		assert.Equal(t, int64(62075), result[0].Total)
+		assert.Equal(t, int64(18000), result[1].Total)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		assert.Equal(t, "aggregate", started.CommandName)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetExpensesByCategory(time.Now(), time.Now())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetCategoryTotals(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "category", Value: "salary"},
+				{Key: "currency", Value: "BRL"},
+				{Key: "total", Value: int64(800000)},
+				{Key: "count", Value: int32(2)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		from := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+		result, err := repo.GetCategoryTotals("income", types.DateRange{From: from}, nil)
 
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
 		assert.Len(t, result, 1)
+		assert.Equal(t, "salary", result[0].Category)
+		assert.Equal(t, int64(800000), result[0].Total)
+		assert.Equal(t, int64(2), result[0].Count)
+
+		started := mt.GetStartedEvent()
+		pipeline := started.Command.Lookup("pipeline").Array()
+
+		match := pipeline.Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, "income", match.Lookup("type").StringValue())
+		assert.Equal(t, from, match.Lookup("date", "$gte").Time().UTC())
+		_, err = match.LookupErr("date", "$lt")
+		assert.Error(t, err)
+
+		sortStage := pipeline.Index(3).Value().Document().Lookup("$sort").Document()
+		assert.Equal(t, int32(-1), sortStage.Lookup("total").Int32())
+	})
+
+	mt.Run("groups_categories_ignoring_case", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch, bson.D{
+				{Key: "category", Value: "ALIMENTAÇÃO"},
+				{Key: "currency", Value: "BRL"},
+				{Key: "total", Value: int64(30000)},
+				{Key: "count", Value: int32(3)},
+			}),
+			mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch),
+		)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetCategoryTotals("expense", types.DateRange{}, nil)
+
+		assert.NoError(t, err)
+		if assert.Len(t, result, 1) {
+			assert.Equal(t, "alimentação", result[0].Category)
+		}
+
+		command := mt.GetStartedEvent().Command
+		assert.Equal(t, "en", command.Lookup("collation", "locale").StringValue())
+		assert.Equal(t, int32(2), command.Lookup("collation", "strength").Int32())
+
+		group := command.Lookup("pipeline").Array().Index(1).Value().Document().Lookup("$group").Document()
+		assert.Equal(t, "$category", group.Lookup("_id", "category").StringValue())
+	})
+
+	mt.Run("filter_expression_in_match", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetCategoryTotals("expense", types.DateRange{}, bson.M{"payment_method": "pix"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		match := mt.GetStartedEvent().Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, "expense", match.Lookup("type").StringValue())
+		assert.Equal(t, "pix", match.Lookup("$and").Array().Index(0).Value().Document().Lookup("payment_method").StringValue())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetCategoryTotals("expense", types.DateRange{}, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetTotalsByCurrency(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "currency", Value: "BRL"},
+				{Key: "type", Value: "income"},
+				{Key: "total", Value: int64(350125)},
+			},
+			bson.D{
+				{Key: "currency", Value: "BRL"},
+				{Key: "type", Value: "expense"},
+				{Key: "total", Value: int64(80025)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		from := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 1, 0)
+
+		result, err := repo.GetTotalsByCurrency(types.DateRange{From: from, To: to}, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "BRL", result[0].Currency)
+		assert.Equal(t, "income", result[0].Type)
+		assert.Equal(t, int64(350125), result[0].Total)
+		assert.Equal(t, int64(80025), result[1].Total)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		assert.Equal(t, "aggregate", started.CommandName)
+
+		match := started.Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		dateFilter := match.Lookup("date").Document()
+		assert.Equal(t, from, dateFilter.Lookup("$gte").Time().UTC())
+		assert.Equal(t, to, dateFilter.Lookup("$lt").Time().UTC())
+	})
+
+	mt.Run("unbounded_range", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "transactions.entries", mtest.FirstBatch))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetTotalsByCurrency(types.DateRange{}, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		started := mt.GetStartedEvent()
+		match := started.Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		_, err = match.LookupErr("date")
+		assert.Error(t, err)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetTotalsByCurrency(types.DateRange{}, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetCashflowTotals(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		period := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "period", Value: period},
+				{Key: "currency", Value: "BRL"},
+				{Key: "type", Value: "income"},
+				{Key: "total", Value: int64(500000)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetCashflowTotals("month", types.DateRange{From: period}, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, period, result[0].Period.UTC())
+		assert.Equal(t, "income", result[0].Type)
+		assert.Equal(t, int64(500000), result[0].Total)
+
+		started := mt.GetStartedEvent()
+		group := started.Command.Lookup("pipeline").Array().Index(1).Value().Document().Lookup("$group").Document()
+		assert.Equal(t, "month", group.Lookup("_id", "period", "$dateTrunc", "unit").StringValue())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetCashflowTotals("day", types.DateRange{}, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetAccountTotals(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		accountID := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "account_id", Value: accountID},
+				{Key: "type", Value: "income"},
+				{Key: "total", Value: int64(300000)},
+			},
+			bson.D{
+				{Key: "account_id", Value: accountID},
+				{Key: "type", Value: "transfer"},
+				{Key: "transfer_direction", Value: "out"},
+				{Key: "total", Value: int64(40000)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		until := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+		result, err := repo.GetAccountTotals(until)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, accountID, result[0].AccountID)
+		assert.Equal(t, int64(300000), result[0].Total)
+		assert.Equal(t, "out", result[1].TransferDirection)
+
+		started := mt.GetStartedEvent()
+		match := started.Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, until, match.Lookup("date", "$lt").Time().UTC())
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetAccountTotals(time.Time{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryGetAccountTotalsByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("successful_aggregation", func(mt *mtest.T) {
+		accountID := primitive.NewObjectID()
+
+		first := mtest.CreateCursorResponse(1, "transactions.entries", mtest.FirstBatch,
+			bson.D{
+				{Key: "account_id", Value: accountID},
+				{Key: "type", Value: "expense"},
+				{Key: "total", Value: int64(7500)},
+			},
+		)
+
+		killCursors := mtest.CreateCursorResponse(0, "transactions.entries", mtest.NextBatch)
+
+		mt.AddMockResponses(first, killCursors)
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetAccountTotalsByID(accountID.Hex())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, accountID, result[0].AccountID)
+		assert.Equal(t, "expense", result[0].Type)
+		assert.Equal(t, int64(7500), result[0].Total)
+
+		started := mt.GetStartedEvent()
+		assert.Equal(t, "aggregate", started.CommandName)
+
+		match := started.Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		assert.Equal(t, accountID, match.Lookup("account_id").ObjectID())
+	})
+
+	mt.Run("invalid_account_id", func(mt *mtest.T) {
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetAccountTotalsByID("invalid-id")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		result, err := repo.GetAccountTotalsByID(primitive.NewObjectID().Hex())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionsEntryRepositoryCreateRecurringOccurrence(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("creates_new_occurrence", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		created, err := repo.CreateRecurringOccurrence(&model.TransactionsEntryModel{
+			Title:               "Rent",
+			RecurringRuleID:     primitive.NewObjectID(),
+			RecurringOccurrence: 1,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	mt.Run("duplicate_occurrence_is_ignored", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   0,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		created, err := repo.CreateRecurringOccurrence(&model.TransactionsEntryModel{
+			Title:               "Rent",
+			RecurringRuleID:     primitive.NewObjectID(),
+			RecurringOccurrence: 1,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, created)
+	})
+
+	mt.Run("database_error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "BadValue",
+		}))
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		created, err := repo.CreateRecurringOccurrence(&model.TransactionsEntryModel{Title: "Rent"})
+
+		assert.Error(t, err)
+		assert.False(t, created)
+	})
+}
+
+func TestTransactionsEntryRepositoryEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("creates_unique_occurrence_index", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		repo := repository.NewTransactionsEntryRepository(mt.DB)
+
+		err := repo.EnsureIndexes()
+
+		assert.NoError(t, err)
+
+		started := mt.GetStartedEvent()
+		assert.NotNil(t, started)
+		assert.Equal(t, "createIndexes", started.CommandName)
+
+		index := started.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		assert.True(t, index.Lookup("unique").Boolean())
+		assert.Equal(t, "recurring_rule_occurrence_unique", index.Lookup("name").StringValue())
+
+		dateIndex := started.Command.Lookup("indexes").Array().Index(1).Value().Document()
+		assert.Equal(t, "date", dateIndex.Lookup("name").StringValue())
 
-		transaction := result[0]
-		assert.Equal(t, objectID, transaction.ID)
-		assert.Equal(t, 42.50, transaction.Amount)
-		assert.Equal(t, "Coffee Shop", transaction.Title)
-		assert.Equal(t, "USD", transaction.Currency)
-		assert.Equal(t, "expense", transaction.Type)
-		assert.Equal(t, "food", transaction.Category)
-		assert.Equal(t, "debit_card", transaction.PaymentMethod)
-		assert.Equal(t, "Morning coffee", transaction.Description)
-		assert.Equal(t, testDate, transaction.Date)
-		assert.Equal(t, testDate.Unix(), transaction.Timestamp)
-		assert.Equal(t, testDate, transaction.CreatedAt)
-		assert.Equal(t, testDate, transaction.UpdatedAt)
+		textIndex := started.Command.Lookup("indexes").Array().Index(2).Value().Document()
+		assert.Equal(t, "transactions_text", textIndex.Lookup("name").StringValue())
+		assert.Equal(t, "none", textIndex.Lookup("default_language").StringValue())
+		assert.Equal(t, "text", textIndex.Lookup("key", "title").StringValue())
+		assert.Equal(t, "text", textIndex.Lookup("key", "description").StringValue())
+		assert.Equal(t, "text", textIndex.Lookup("key", "category").StringValue())
 	})
 }
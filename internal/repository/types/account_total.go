@@ -0,0 +1,10 @@
+package types
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type AccountTotal struct {
+	AccountID         primitive.ObjectID `bson:"account_id"`
+	Type              string             `bson:"type"`
+	TransferDirection string             `bson:"transfer_direction"`
+	Total             int64              `bson:"total"`
+}
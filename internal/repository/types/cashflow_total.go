@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+type CashflowTotal struct {
+	Period   time.Time `bson:"period"`
+	Currency string    `bson:"currency"`
+	Type     string    `bson:"type"`
+	Total    int64     `bson:"total"`
+}
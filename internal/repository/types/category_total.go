@@ -0,0 +1,8 @@
+package types
+
+type CategoryTotal struct {
+	Category string `bson:"category"`
+	Currency string `bson:"currency"`
+	Total    int64  `bson:"total"`
+	Count    int64  `bson:"count"`
+}
@@ -0,0 +1,7 @@
+package types
+
+type CurrencyTotal struct {
+	Currency string `bson:"currency"`
+	Type     string `bson:"type"`
+	Total    int64  `bson:"total"`
+}
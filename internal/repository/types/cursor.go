@@ -0,0 +1,13 @@
+package types
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Cursor struct {
+	Date     time.Time
+	ID       primitive.ObjectID
+	Backward bool
+}
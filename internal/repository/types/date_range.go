@@ -0,0 +1,8 @@
+package types
+
+import "time"
+
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
@@ -0,0 +1,6 @@
+package types
+
+type SortField struct {
+	Field      string
+	Descending bool
+}
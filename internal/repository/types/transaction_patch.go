@@ -0,0 +1,22 @@
+package types
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TransactionPatch struct {
+	Amount            *int64
+	Title             *string
+	Currency          *string
+	Type              *string
+	Category          *string
+	PaymentMethod     *string
+	Description       *string
+	Date              *time.Time
+	AccountID         *primitive.ObjectID
+	RemoveDescription bool
+	RemoveAccountID   bool
+	Version           int64
+}
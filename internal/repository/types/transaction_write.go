@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	"myfin-api/internal/model"
+)
+
+const (
+	TransactionWriteCreate = "create"
+	TransactionWriteUpdate = "update"
+	TransactionWriteDelete = "delete"
+)
+
+// TransactionWrite is a single operation applied by TransactionsEntryRepository.ApplyWrites.
+// Deletes remove Entry and, for transfer legs, Linked, each only if it is
+// still at the version it was read at.
+type TransactionWrite struct {
+	Op     string
+	Entry  *model.TransactionsEntryModel
+	Linked *model.TransactionsEntryModel
+}
+
+// TransactionWriteError reports which write of a batch failed.
+type TransactionWriteError struct {
+	Index int
+	Err   error
+}
+
+func (e *TransactionWriteError) Error() string {
+	return fmt.Sprintf("write %d failed: %v", e.Index, e.Err)
+}
+
+func (e *TransactionWriteError) Unwrap() error {
+	return e.Err
+}
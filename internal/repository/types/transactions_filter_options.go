@@ -1,6 +1,18 @@
 package types
 
+import "go.mongodb.org/mongo-driver/bson"
+
 type FilterOptions struct {
-	Title    string
-	Category string
+	Search        string
+	Title         string
+	Categories    []string
+	Type          string
+	Currency      string
+	PaymentMethod string
+	DateRange     DateRange
+	MinAmount     *int64
+	MaxAmount     *int64
+	Sort          []SortField
+	Cursor        *Cursor
+	Expression    bson.M
 }
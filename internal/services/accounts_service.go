@@ -0,0 +1,194 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+	"myfin-api/internal/repository/types"
+)
+
+var (
+	ErrUnknownAccount          = domain.NewError(domain.ErrValidation, i18n.UnknownAccount)
+	ErrAccountCurrencyMismatch = domain.NewError(domain.ErrValidation, i18n.AccountCurrencyMismatch)
+	ErrAccountCurrencyLocked   = repository.ErrAccountCurrencyLocked
+	ErrAccountInUse            = repository.ErrAccountInUse
+)
+
+type AccountsService interface {
+	CreateAccount(account dtos.CreateAccountDTO) (dtos.AccountResponseDTO, error)
+	GetAllAccounts() ([]dtos.AccountResponseDTO, error)
+	GetAccountByID(id string) (dtos.AccountResponseDTO, error)
+	UpdateAccount(id string, account dtos.UpdateAccountDTO) (dtos.AccountResponseDTO, error)
+	DeleteAccount(id string) error
+	GetAccountBalance(id string) (dtos.AccountBalanceResponseDTO, error)
+}
+
+type accountsService struct {
+	accountsRepo     repository.AccountsRepository
+	transactionsRepo repository.TransactionsEntryRepository
+}
+
+func NewAccountsService(accountsRepo repository.AccountsRepository, transactionsRepo repository.TransactionsEntryRepository) AccountsService {
+	return &accountsService{
+		accountsRepo:     accountsRepo,
+		transactionsRepo: transactionsRepo,
+	}
+}
+
+func (s *accountsService) CreateAccount(account dtos.CreateAccountDTO) (dtos.AccountResponseDTO, error) {
+	openingBalance, err := parseOptionalAmount(account.OpeningBalance, account.Currency)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	accountModel := &model.AccountModel{
+		Name:           account.Name,
+		Type:           account.Type,
+		Currency:       strings.ToUpper(account.Currency),
+		OpeningBalance: openingBalance,
+		Description:    account.Description,
+	}
+
+	createdAccount, err := s.accountsRepo.Create(accountModel)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	return toAccountResponseDTO(createdAccount), nil
+}
+
+func (s *accountsService) GetAllAccounts() ([]dtos.AccountResponseDTO, error) {
+	accounts, err := s.accountsRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dtos.AccountResponseDTO, 0, len(accounts))
+	for _, account := range accounts {
+		response = append(response, toAccountResponseDTO(account))
+	}
+
+	return response, nil
+}
+
+func (s *accountsService) GetAccountByID(id string) (dtos.AccountResponseDTO, error) {
+	account, err := s.accountsRepo.GetByID(id)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	return toAccountResponseDTO(account), nil
+}
+
+func (s *accountsService) UpdateAccount(id string, account dtos.UpdateAccountDTO) (dtos.AccountResponseDTO, error) {
+	existingAccount, err := s.accountsRepo.GetByID(id)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	openingBalance, err := parseOptionalAmount(account.OpeningBalance, account.Currency)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	accountModel := &model.AccountModel{
+		Name:           account.Name,
+		Type:           account.Type,
+		Currency:       strings.ToUpper(account.Currency),
+		OpeningBalance: openingBalance,
+		Description:    account.Description,
+		CreatedAt:      existingAccount.CreatedAt,
+	}
+
+	updatedAccount, err := s.accountsRepo.Update(id, accountModel)
+	if err != nil {
+		return dtos.AccountResponseDTO{}, err
+	}
+
+	return toAccountResponseDTO(updatedAccount), nil
+}
+
+func (s *accountsService) DeleteAccount(id string) error {
+	return s.accountsRepo.Delete(id)
+}
+
+func (s *accountsService) GetAccountBalance(id string) (dtos.AccountBalanceResponseDTO, error) {
+	account, err := s.accountsRepo.GetByID(id)
+	if err != nil {
+		return dtos.AccountBalanceResponseDTO{}, err
+	}
+
+	totals, err := s.transactionsRepo.GetAccountTotalsByID(id)
+	if err != nil {
+		return dtos.AccountBalanceResponseDTO{}, err
+	}
+
+	return calculateAccountBalance(account, totals), nil
+}
+
+func calculateAccountBalance(account *model.AccountModel, totals []*types.AccountTotal) dtos.AccountBalanceResponseDTO {
+	var incomeTotal, expenseTotal, transfersIn, transfersOut int64
+
+	for _, total := range totals {
+		if total.AccountID != account.ID {
+			continue
+		}
+
+		switch total.Type {
+		case "income":
+			incomeTotal += total.Total
+		case "expense":
+			expenseTotal += total.Total
+		case transferType:
+			if total.TransferDirection == transferDirectionIn {
+				transfersIn += total.Total
+			} else {
+				transfersOut += total.Total
+			}
+		}
+	}
+
+	balance := account.OpeningBalance + incomeTotal - expenseTotal + transfersIn - transfersOut
+
+	return dtos.AccountBalanceResponseDTO{
+		AccountID:      account.ID.Hex(),
+		Name:           account.Name,
+		Currency:       account.Currency,
+		OpeningBalance: money.ToNumber(account.OpeningBalance, account.Currency),
+		IncomeAmount:   money.ToNumber(incomeTotal, account.Currency),
+		ExpenseAmount:  money.ToNumber(expenseTotal, account.Currency),
+		TransfersIn:    money.ToNumber(transfersIn, account.Currency),
+		TransfersOut:   money.ToNumber(transfersOut, account.Currency),
+		Balance:        money.ToNumber(balance, account.Currency),
+	}
+}
+
+func toAccountResponseDTO(account *model.AccountModel) dtos.AccountResponseDTO {
+	return dtos.AccountResponseDTO{
+		ID:             account.ID.Hex(),
+		Name:           account.Name,
+		Type:           account.Type,
+		Currency:       account.Currency,
+		OpeningBalance: money.ToNumber(account.OpeningBalance, account.Currency),
+		Description:    account.Description,
+		CreatedAt:      account.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      account.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// referencedAccountError reports a missing account as invalid input when its ID
+// comes from the request body rather than the URL.
+func referencedAccountError(err error) error {
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return ErrUnknownAccount
+	}
+
+	return err
+}
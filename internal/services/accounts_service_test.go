@@ -0,0 +1,260 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+	"myfin-api/internal/repository/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MockAccountsRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountsRepository) Create(account *model.AccountModel) (*model.AccountModel, error) {
+	args := m.Called(account)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AccountModel), args.Error(1)
+}
+
+func (m *MockAccountsRepository) GetAll() ([]*model.AccountModel, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.AccountModel), args.Error(1)
+}
+
+func (m *MockAccountsRepository) GetByID(id string) (*model.AccountModel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AccountModel), args.Error(1)
+}
+
+func (m *MockAccountsRepository) Update(id string, account *model.AccountModel) (*model.AccountModel, error) {
+	args := m.Called(id, account)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AccountModel), args.Error(1)
+}
+
+func (m *MockAccountsRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func TestAccountsServiceCreateAccountSuccess(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewAccountsService(mockAccountsRepo, new(MockTransactionsRepository))
+
+	inputDTO := dtos.CreateAccountDTO{
+		Name:           "Nubank",
+		Type:           "checking",
+		Currency:       "brl",
+		OpeningBalance: json.Number("1500.50"),
+	}
+
+	createdTime := time.Now().UTC()
+	createdAccount := &model.AccountModel{
+		ID:             primitive.NewObjectID(),
+		Name:           "Nubank",
+		Type:           "checking",
+		Currency:       "BRL",
+		OpeningBalance: 150050,
+		CreatedAt:      createdTime,
+		UpdatedAt:      createdTime,
+	}
+
+	mockAccountsRepo.On("Create", mock.MatchedBy(func(account *model.AccountModel) bool {
+		return account.Currency == "BRL" && account.Name == "Nubank" && account.OpeningBalance == 150050
+	})).Return(createdAccount, nil)
+
+	result, err := service.CreateAccount(inputDTO)
+
+	assert.NoError(t, err)
+	assert.Equal(t, createdAccount.ID.Hex(), result.ID)
+	assert.Equal(t, "BRL", result.Currency)
+	assert.Equal(t, json.Number("1500.50"), result.OpeningBalance)
+	assert.Equal(t, createdTime.Format(time.RFC3339), result.CreatedAt)
+
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceCreateAccountRepositoryError(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewAccountsService(mockAccountsRepo, new(MockTransactionsRepository))
+
+	expectedError := errors.New("database error")
+	mockAccountsRepo.On("Create", mock.AnythingOfType("*model.AccountModel")).Return(nil, expectedError)
+
+	result, err := service.CreateAccount(dtos.CreateAccountDTO{Name: "Wallet", Type: "cash", Currency: "BRL"})
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.AccountResponseDTO{}, result)
+
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceGetAllAccountsSuccess(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewAccountsService(mockAccountsRepo, new(MockTransactionsRepository))
+
+	accounts := []*model.AccountModel{
+		{ID: primitive.NewObjectID(), Name: "Nubank", Type: "checking", Currency: "BRL"},
+		{ID: primitive.NewObjectID(), Name: "Wallet", Type: "cash", Currency: "BRL"},
+	}
+
+	mockAccountsRepo.On("GetAll").Return(accounts, nil)
+
+	result, err := service.GetAllAccounts()
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "Nubank", result[0].Name)
+	assert.Equal(t, "Wallet", result[1].Name)
+
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceUpdateAccountKeepsCreatedAt(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockTransactionsRepo := new(MockTransactionsRepository)
+	service := NewAccountsService(mockAccountsRepo, mockTransactionsRepo)
+
+	objectID := primitive.NewObjectID()
+	createdTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	existingAccount := &model.AccountModel{ID: objectID, Name: "Old", CreatedAt: createdTime}
+	updatedAccount := &model.AccountModel{ID: objectID, Name: "New", Type: "savings", Currency: "USD", CreatedAt: createdTime}
+
+	mockAccountsRepo.On("GetByID", objectID.Hex()).Return(existingAccount, nil)
+	mockAccountsRepo.On("Update", objectID.Hex(), mock.MatchedBy(func(account *model.AccountModel) bool {
+		return account.CreatedAt.Equal(createdTime) && account.Currency == "USD"
+	})).Return(updatedAccount, nil)
+
+	result, err := service.UpdateAccount(objectID.Hex(), dtos.UpdateAccountDTO{Name: "New", Type: "savings", Currency: "usd"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "New", result.Name)
+
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceUpdateAccountCurrencyLocked(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockTransactionsRepo := new(MockTransactionsRepository)
+	service := NewAccountsService(mockAccountsRepo, mockTransactionsRepo)
+
+	objectID := primitive.NewObjectID()
+	existingAccount := &model.AccountModel{ID: objectID, Name: "Nubank", Currency: "BRL"}
+
+	mockAccountsRepo.On("GetByID", objectID.Hex()).Return(existingAccount, nil)
+	mockAccountsRepo.On("Update", objectID.Hex(), mock.AnythingOfType("*model.AccountModel")).Return(nil, repository.ErrAccountCurrencyLocked)
+
+	result, err := service.UpdateAccount(objectID.Hex(), dtos.UpdateAccountDTO{Name: "Nubank", Type: "checking", Currency: "USD"})
+
+	assert.ErrorIs(t, err, ErrAccountCurrencyLocked)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Equal(t, dtos.AccountResponseDTO{}, result)
+
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceGetAccountBalance(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockTransactionsRepo := new(MockTransactionsRepository)
+	service := NewAccountsService(mockAccountsRepo, mockTransactionsRepo)
+
+	accountID := primitive.NewObjectID()
+	account := &model.AccountModel{
+		ID:             accountID,
+		Name:           "Nubank",
+		Currency:       "BRL",
+		OpeningBalance: 100000,
+	}
+
+	totals := []*types.AccountTotal{
+		{AccountID: accountID, Type: "income", Total: 250010},
+		{AccountID: accountID, Type: "expense", Total: 40010},
+		{AccountID: accountID, Type: "transfer", TransferDirection: "in", Total: 5000},
+		{AccountID: accountID, Type: "transfer", TransferDirection: "out", Total: 5000},
+	}
+
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(account, nil)
+	mockTransactionsRepo.On("GetAccountTotalsByID", accountID.Hex()).Return(totals, nil)
+
+	result, err := service.GetAccountBalance(accountID.Hex())
+
+	assert.NoError(t, err)
+	assert.Equal(t, accountID.Hex(), result.AccountID)
+	assert.Equal(t, json.Number("1000.00"), result.OpeningBalance)
+	assert.Equal(t, json.Number("2500.10"), result.IncomeAmount)
+	assert.Equal(t, json.Number("400.10"), result.ExpenseAmount)
+	assert.Equal(t, json.Number("50.00"), result.TransfersIn)
+	assert.Equal(t, json.Number("50.00"), result.TransfersOut)
+	assert.Equal(t, json.Number("3100.00"), result.Balance)
+
+	mockAccountsRepo.AssertExpectations(t)
+	mockTransactionsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceGetAccountBalanceAccountNotFound(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockTransactionsRepo := new(MockTransactionsRepository)
+	service := NewAccountsService(mockAccountsRepo, mockTransactionsRepo)
+
+	accountID := primitive.NewObjectID()
+	expectedError := errors.New("account not found")
+
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(nil, expectedError)
+
+	result, err := service.GetAccountBalance(accountID.Hex())
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.AccountBalanceResponseDTO{}, result)
+
+	mockAccountsRepo.AssertExpectations(t)
+	mockTransactionsRepo.AssertNotCalled(t, "GetAccountTotalsByID", accountID.Hex())
+}
+
+func TestAccountsServiceDeleteAccount(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewAccountsService(mockAccountsRepo, new(MockTransactionsRepository))
+
+	objectID := primitive.NewObjectID()
+	mockAccountsRepo.On("Delete", objectID.Hex()).Return(nil)
+
+	err := service.DeleteAccount(objectID.Hex())
+
+	assert.NoError(t, err)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestAccountsServiceDeleteAccountInUse(t *testing.T) {
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewAccountsService(mockAccountsRepo, new(MockTransactionsRepository))
+
+	objectID := primitive.NewObjectID()
+	mockAccountsRepo.On("Delete", objectID.Hex()).Return(repository.ErrAccountInUse)
+
+	err := service.DeleteAccount(objectID.Hex())
+
+	assert.ErrorIs(t, err, ErrAccountInUse)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	mockAccountsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+)
+
+const BudgetMonthFormat = "2006-01"
+
+var ErrBudgetAlreadyExists = repository.ErrBudgetAlreadyExists
+
+type BudgetsService interface {
+	CreateBudget(budget dtos.CreateBudgetDTO) (dtos.BudgetResponseDTO, error)
+	GetAllBudgets(month string) ([]dtos.BudgetResponseDTO, error)
+	UpdateBudget(id string, budget dtos.UpdateBudgetDTO) (dtos.BudgetResponseDTO, error)
+	DeleteBudget(id string) error
+	GetBudgetStatus(month string) (dtos.BudgetStatusResponseDTO, error)
+}
+
+type budgetsService struct {
+	budgetsRepo      repository.BudgetsRepository
+	transactionsRepo repository.TransactionsEntryRepository
+}
+
+func NewBudgetsService(budgetsRepo repository.BudgetsRepository, transactionsRepo repository.TransactionsEntryRepository) BudgetsService {
+	return &budgetsService{
+		budgetsRepo:      budgetsRepo,
+		transactionsRepo: transactionsRepo,
+	}
+}
+
+func (s *budgetsService) CreateBudget(budget dtos.CreateBudgetDTO) (dtos.BudgetResponseDTO, error) {
+	limit, err := parseAmount(budget.Limit, budget.Currency)
+	if err != nil {
+		return dtos.BudgetResponseDTO{}, err
+	}
+
+	budgetModel := &model.BudgetModel{
+		Category: budget.Category,
+		Month:    budget.Month,
+		Limit:    limit,
+		Currency: strings.ToUpper(budget.Currency),
+	}
+
+	createdBudget, err := s.budgetsRepo.Create(budgetModel)
+	if err != nil {
+		return dtos.BudgetResponseDTO{}, err
+	}
+
+	return toBudgetResponseDTO(createdBudget), nil
+}
+
+func (s *budgetsService) GetAllBudgets(month string) ([]dtos.BudgetResponseDTO, error) {
+	budgets, err := s.budgetsRepo.GetAll(month)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dtos.BudgetResponseDTO, 0, len(budgets))
+	for _, budget := range budgets {
+		response = append(response, toBudgetResponseDTO(budget))
+	}
+
+	return response, nil
+}
+
+func (s *budgetsService) UpdateBudget(id string, budget dtos.UpdateBudgetDTO) (dtos.BudgetResponseDTO, error) {
+	existingBudget, err := s.budgetsRepo.GetByID(id)
+	if err != nil {
+		return dtos.BudgetResponseDTO{}, err
+	}
+
+	limit, err := parseAmount(budget.Limit, budget.Currency)
+	if err != nil {
+		return dtos.BudgetResponseDTO{}, err
+	}
+
+	budgetModel := &model.BudgetModel{
+		Category:  budget.Category,
+		Month:     budget.Month,
+		Limit:     limit,
+		Currency:  strings.ToUpper(budget.Currency),
+		CreatedAt: existingBudget.CreatedAt,
+	}
+
+	updatedBudget, err := s.budgetsRepo.Update(id, budgetModel)
+	if err != nil {
+		return dtos.BudgetResponseDTO{}, err
+	}
+
+	return toBudgetResponseDTO(updatedBudget), nil
+}
+
+func (s *budgetsService) DeleteBudget(id string) error {
+	return s.budgetsRepo.Delete(id)
+}
+
+func (s *budgetsService) GetBudgetStatus(month string) (dtos.BudgetStatusResponseDTO, error) {
+	from, err := time.Parse(BudgetMonthFormat, month)
+	if err != nil {
+		return dtos.BudgetStatusResponseDTO{}, err
+	}
+	to := from.AddDate(0, 1, 0)
+
+	budgets, err := s.budgetsRepo.GetAll(month)
+	if err != nil {
+		return dtos.BudgetStatusResponseDTO{}, err
+	}
+
+	expenses, err := s.transactionsRepo.GetExpensesByCategory(from, to)
+	if err != nil {
+		return dtos.BudgetStatusResponseDTO{}, err
+	}
+
+	spentByCategory := make(map[string]int64, len(expenses))
+	for _, expense := range expenses {
+		spentByCategory[budgetKey(expense.Category, expense.Currency)] += expense.Total
+	}
+
+	categories := make([]dtos.BudgetCategoryStatusDTO, 0, len(budgets))
+	for _, budget := range budgets {
+		spent := spentByCategory[budgetKey(budget.Category, budget.Currency)]
+
+		var percentUsed float64
+		if budget.Limit > 0 {
+			percentUsed = float64(spent) / float64(budget.Limit) * 100
+		}
+
+		categories = append(categories, dtos.BudgetCategoryStatusDTO{
+			BudgetID:    budget.ID.Hex(),
+			Category:    budget.Category,
+			Currency:    budget.Currency,
+			Limit:       money.ToNumber(budget.Limit, budget.Currency),
+			Spent:       money.ToNumber(spent, budget.Currency),
+			Remaining:   money.ToNumber(budget.Limit-spent, budget.Currency),
+			PercentUsed: math.Round(percentUsed*100) / 100,
+		})
+	}
+
+	return dtos.BudgetStatusResponseDTO{
+		Month:      month,
+		Categories: categories,
+	}, nil
+}
+
+func budgetKey(category, currency string) string {
+	return strings.ToLower(category) + "|" + strings.ToUpper(currency)
+}
+
+func toBudgetResponseDTO(budget *model.BudgetModel) dtos.BudgetResponseDTO {
+	return dtos.BudgetResponseDTO{
+		ID:        budget.ID.Hex(),
+		Category:  budget.Category,
+		Month:     budget.Month,
+		Limit:     money.ToNumber(budget.Limit, budget.Currency),
+		Currency:  budget.Currency,
+		CreatedAt: budget.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: budget.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MockBudgetsRepository struct {
+	mock.Mock
+}
+
+func (m *MockBudgetsRepository) Create(budget *model.BudgetModel) (*model.BudgetModel, error) {
+	args := m.Called(budget)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BudgetModel), args.Error(1)
+}
+
+func (m *MockBudgetsRepository) GetAll(month string) ([]*model.BudgetModel, error) {
+	args := m.Called(month)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.BudgetModel), args.Error(1)
+}
+
+func (m *MockBudgetsRepository) GetByID(id string) (*model.BudgetModel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BudgetModel), args.Error(1)
+}
+
+func (m *MockBudgetsRepository) Update(id string, budget *model.BudgetModel) (*model.BudgetModel, error) {
+	args := m.Called(id, budget)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BudgetModel), args.Error(1)
+}
+
+func (m *MockBudgetsRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockBudgetsRepository) EnsureIndexes() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func TestBudgetsServiceCreateBudgetSuccess(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, new(MockTransactionsRepository))
+
+	objectID := primitive.NewObjectID()
+	now := time.Now()
+
+	mockBudgetsRepo.On("Create", mock.MatchedBy(func(budget *model.BudgetModel) bool {
+		return budget.Category == "Food" && budget.Currency == "BRL" && budget.Limit == 80000
+	})).Return(&model.BudgetModel{
+		ID:        objectID,
+		Category:  "Food",
+		Month:     "2025-09",
+		Limit:     80000,
+		Currency:  "BRL",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil)
+
+	result, err := service.CreateBudget(dtos.CreateBudgetDTO{
+		Category: "Food",
+		Month:    "2025-09",
+		Limit:    json.Number("800.00"),
+		Currency: "brl",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, objectID.Hex(), result.ID)
+	assert.Equal(t, "BRL", result.Currency)
+	mockBudgetsRepo.AssertExpectations(t)
+}
+
+func TestBudgetsServiceCreateBudgetAlreadyExists(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, new(MockTransactionsRepository))
+
+	mockBudgetsRepo.On("Create", mock.AnythingOfType("*model.BudgetModel")).Return(nil, ErrBudgetAlreadyExists)
+
+	result, err := service.CreateBudget(dtos.CreateBudgetDTO{
+		Category: "Food",
+		Month:    "2025-09",
+		Limit:    json.Number("800.00"),
+		Currency: "BRL",
+	})
+
+	assert.ErrorIs(t, err, ErrBudgetAlreadyExists)
+	assert.Equal(t, dtos.BudgetResponseDTO{}, result)
+	mockBudgetsRepo.AssertExpectations(t)
+}
+
+func TestBudgetsServiceUpdateBudgetSuccess(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, new(MockTransactionsRepository))
+
+	objectID := primitive.NewObjectID()
+	existing := &model.BudgetModel{ID: objectID, Category: "Food", Month: "2025-09", Limit: 50000, Currency: "BRL"}
+
+	mockBudgetsRepo.On("GetByID", objectID.Hex()).Return(existing, nil)
+	mockBudgetsRepo.On("Update", objectID.Hex(), mock.AnythingOfType("*model.BudgetModel")).Return(&model.BudgetModel{
+		ID:       objectID,
+		Category: "Food",
+		Month:    "2025-09",
+		Limit:    65000,
+		Currency: "BRL",
+	}, nil)
+
+	result, err := service.UpdateBudget(objectID.Hex(), dtos.UpdateBudgetDTO{
+		Category: "Food",
+		Month:    "2025-09",
+		Limit:    json.Number("650"),
+		Currency: "BRL",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("650.00"), result.Limit)
+	mockBudgetsRepo.AssertExpectations(t)
+}
+
+func TestBudgetsServiceGetBudgetStatus(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	mockRepo := new(MockTransactionsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, mockRepo)
+
+	foodID := primitive.NewObjectID()
+	travelID := primitive.NewObjectID()
+	leisureID := primitive.NewObjectID()
+
+	mockBudgetsRepo.On("GetAll", "2025-09").Return([]*model.BudgetModel{
+		{ID: foodID, Category: "Food", Month: "2025-09", Limit: 80000, Currency: "BRL"},
+		{ID: travelID, Category: "Travel", Month: "2025-09", Limit: 30000, Currency: "USD"},
+		{ID: leisureID, Category: "Leisure", Month: "2025-09", Limit: 20000, Currency: "BRL"},
+	}, nil)
+
+	from := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("GetExpensesByCategory", from, to).Return([]*types.CategoryTotal{
+		{Category: "food", Currency: "BRL", Total: 60050},
+		{Category: "travel", Currency: "USD", Total: 45000},
+		{Category: "travel", Currency: "BRL", Total: 100000},
+	}, nil)
+
+	result, err := service.GetBudgetStatus("2025-09")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-09", result.Month)
+	assert.Len(t, result.Categories, 3)
+
+	assert.Equal(t, foodID.Hex(), result.Categories[0].BudgetID)
+	assert.Equal(t, json.Number("600.50"), result.Categories[0].Spent)
+	assert.Equal(t, json.Number("199.50"), result.Categories[0].Remaining)
+	assert.Equal(t, 75.06, result.Categories[0].PercentUsed)
+
+	assert.Equal(t, json.Number("450.00"), result.Categories[1].Spent)
+	assert.Equal(t, json.Number("-150.00"), result.Categories[1].Remaining)
+	assert.Equal(t, 150.0, result.Categories[1].PercentUsed)
+
+	assert.Equal(t, json.Number("0.00"), result.Categories[2].Spent)
+	assert.Equal(t, json.Number("200.00"), result.Categories[2].Remaining)
+	assert.Equal(t, 0.0, result.Categories[2].PercentUsed)
+
+	mockBudgetsRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBudgetsServiceGetBudgetStatusNonASCIICategory(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	mockRepo := new(MockTransactionsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, mockRepo)
+
+	mockBudgetsRepo.On("GetAll", "2025-09").Return([]*model.BudgetModel{
+		{ID: primitive.NewObjectID(), Category: "ALIMENTAÇÃO", Month: "2025-09", Limit: 80000, Currency: "BRL"},
+	}, nil)
+	mockRepo.On("GetExpensesByCategory", mock.Anything, mock.Anything).Return([]*types.CategoryTotal{
+		{Category: "alimentação", Currency: "BRL", Total: 30000},
+	}, nil)
+
+	result, err := service.GetBudgetStatus("2025-09")
+
+	assert.NoError(t, err)
+	if assert.Len(t, result.Categories, 1) {
+		assert.Equal(t, json.Number("300.00"), result.Categories[0].Spent)
+		assert.Equal(t, json.Number("500.00"), result.Categories[0].Remaining)
+	}
+}
+
+func TestBudgetsServiceGetBudgetStatusRepositoryError(t *testing.T) {
+	mockBudgetsRepo := new(MockBudgetsRepository)
+	mockRepo := new(MockTransactionsRepository)
+	service := NewBudgetsService(mockBudgetsRepo, mockRepo)
+
+	expectedError := errors.New("aggregation failed")
+
+	mockBudgetsRepo.On("GetAll", "2025-09").Return([]*model.BudgetModel{}, nil)
+	mockRepo.On("GetExpensesByCategory", mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	result, err := service.GetBudgetStatus("2025-09")
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.BudgetStatusResponseDTO{}, result)
+}
+
+func TestBudgetsServiceGetBudgetStatusInvalidMonth(t *testing.T) {
+	service := NewBudgetsService(new(MockBudgetsRepository), new(MockTransactionsRepository))
+
+	result, err := service.GetBudgetStatus("09/2025")
+
+	assert.Error(t, err)
+	assert.Equal(t, dtos.BudgetStatusResponseDTO{}, result)
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrExchangeRateAlreadyExists = repository.ErrExchangeRateAlreadyExists
+	ErrExchangeRateNotFound      = domain.NewError(domain.ErrValidation, i18n.MissingExchangeRate)
+)
+
+type ExchangeRatesService interface {
+	CreateExchangeRate(exchangeRate dtos.CreateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error)
+	GetAllExchangeRates() ([]dtos.ExchangeRateResponseDTO, error)
+	UpdateExchangeRate(id string, exchangeRate dtos.UpdateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error)
+	DeleteExchangeRate(id string) error
+}
+
+type exchangeRatesService struct {
+	exchangeRatesRepo repository.ExchangeRatesRepository
+}
+
+func NewExchangeRatesService(exchangeRatesRepo repository.ExchangeRatesRepository) ExchangeRatesService {
+	return &exchangeRatesService{
+		exchangeRatesRepo: exchangeRatesRepo,
+	}
+}
+
+func (s *exchangeRatesService) CreateExchangeRate(exchangeRate dtos.CreateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error) {
+	exchangeRateModel, err := buildExchangeRate(dtos.UpdateExchangeRateDTO(exchangeRate))
+	if err != nil {
+		return dtos.ExchangeRateResponseDTO{}, err
+	}
+
+	createdExchangeRate, err := s.exchangeRatesRepo.Create(exchangeRateModel)
+	if err != nil {
+		return dtos.ExchangeRateResponseDTO{}, err
+	}
+
+	return toExchangeRateResponseDTO(createdExchangeRate), nil
+}
+
+func (s *exchangeRatesService) GetAllExchangeRates() ([]dtos.ExchangeRateResponseDTO, error) {
+	exchangeRates, err := s.exchangeRatesRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dtos.ExchangeRateResponseDTO, 0, len(exchangeRates))
+	for _, exchangeRate := range exchangeRates {
+		response = append(response, toExchangeRateResponseDTO(exchangeRate))
+	}
+
+	return response, nil
+}
+
+func (s *exchangeRatesService) UpdateExchangeRate(id string, exchangeRate dtos.UpdateExchangeRateDTO) (dtos.ExchangeRateResponseDTO, error) {
+	existingExchangeRate, err := s.exchangeRatesRepo.GetByID(id)
+	if err != nil {
+		return dtos.ExchangeRateResponseDTO{}, err
+	}
+
+	exchangeRateModel, err := buildExchangeRate(exchangeRate)
+	if err != nil {
+		return dtos.ExchangeRateResponseDTO{}, err
+	}
+	exchangeRateModel.CreatedAt = existingExchangeRate.CreatedAt
+
+	updatedExchangeRate, err := s.exchangeRatesRepo.Update(id, exchangeRateModel)
+	if err != nil {
+		return dtos.ExchangeRateResponseDTO{}, err
+	}
+
+	return toExchangeRateResponseDTO(updatedExchangeRate), nil
+}
+
+func (s *exchangeRatesService) DeleteExchangeRate(id string) error {
+	return s.exchangeRatesRepo.Delete(id)
+}
+
+func buildExchangeRate(exchangeRate dtos.UpdateExchangeRateDTO) (*model.ExchangeRateModel, error) {
+	if _, err := money.ParseRate(exchangeRate.Rate.String()); err != nil {
+		return nil, err
+	}
+
+	rate, err := primitive.ParseDecimal128(exchangeRate.Rate.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ExchangeRateModel{
+		BaseCurrency:  strings.ToUpper(exchangeRate.BaseCurrency),
+		QuoteCurrency: strings.ToUpper(exchangeRate.QuoteCurrency),
+		Rate:          rate,
+	}, nil
+}
+
+type exchangeRateTable map[string]*big.Rat
+
+func newExchangeRateTable(exchangeRates []*model.ExchangeRateModel) (exchangeRateTable, error) {
+	table := make(exchangeRateTable, len(exchangeRates))
+
+	for _, exchangeRate := range exchangeRates {
+		rate, ok := new(big.Rat).SetString(exchangeRate.Rate.String())
+		if !ok || rate.Sign() <= 0 {
+			return nil, money.ErrInvalidRate
+		}
+
+		table[exchangeRateKey(exchangeRate.BaseCurrency, exchangeRate.QuoteCurrency)] = rate
+	}
+
+	return table, nil
+}
+
+func (t exchangeRateTable) rate(from, to string) (*big.Rat, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	if rate, ok := t[exchangeRateKey(from, to)]; ok {
+		return rate, nil
+	}
+
+	if rate, ok := t[exchangeRateKey(to, from)]; ok {
+		return new(big.Rat).Inv(rate), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s to %s", ErrExchangeRateNotFound, from, to)
+}
+
+func exchangeRateKey(base, quote string) string {
+	return strings.ToUpper(base) + "|" + strings.ToUpper(quote)
+}
+
+func toExchangeRateResponseDTO(exchangeRate *model.ExchangeRateModel) dtos.ExchangeRateResponseDTO {
+	return dtos.ExchangeRateResponseDTO{
+		ID:            exchangeRate.ID.Hex(),
+		BaseCurrency:  exchangeRate.BaseCurrency,
+		QuoteCurrency: exchangeRate.QuoteCurrency,
+		Rate:          json.Number(exchangeRate.Rate.String()),
+		CreatedAt:     exchangeRate.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:     exchangeRate.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MockExchangeRatesRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRatesRepository) Create(exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error) {
+	args := m.Called(exchangeRate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ExchangeRateModel), args.Error(1)
+}
+
+func (m *MockExchangeRatesRepository) GetAll() ([]*model.ExchangeRateModel, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.ExchangeRateModel), args.Error(1)
+}
+
+func (m *MockExchangeRatesRepository) GetByID(id string) (*model.ExchangeRateModel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ExchangeRateModel), args.Error(1)
+}
+
+func (m *MockExchangeRatesRepository) Update(id string, exchangeRate *model.ExchangeRateModel) (*model.ExchangeRateModel, error) {
+	args := m.Called(id, exchangeRate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ExchangeRateModel), args.Error(1)
+}
+
+func (m *MockExchangeRatesRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRatesRepository) EnsureIndexes() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func exchangeRateModel(base, quote, rate string) *model.ExchangeRateModel {
+	decimalRate, _ := primitive.ParseDecimal128(rate)
+	return &model.ExchangeRateModel{
+		ID:            primitive.NewObjectID(),
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          decimalRate,
+	}
+}
+
+func TestExchangeRatesServiceCreateExchangeRateSuccess(t *testing.T) {
+	mockRepo := new(MockExchangeRatesRepository)
+	service := NewExchangeRatesService(mockRepo)
+
+	now := time.Now()
+	created := exchangeRateModel("USD", "BRL", "5.4321")
+	created.CreatedAt = now
+	created.UpdatedAt = now
+
+	mockRepo.On("Create", mock.MatchedBy(func(exchangeRate *model.ExchangeRateModel) bool {
+		return exchangeRate.BaseCurrency == "USD" && exchangeRate.QuoteCurrency == "BRL" && exchangeRate.Rate.String() == "5.4321"
+	})).Return(created, nil)
+
+	result, err := service.CreateExchangeRate(dtos.CreateExchangeRateDTO{
+		BaseCurrency:  "usd",
+		QuoteCurrency: "brl",
+		Rate:          json.Number("5.4321"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID.Hex(), result.ID)
+	assert.Equal(t, json.Number("5.4321"), result.Rate)
+	assert.Equal(t, now.UTC().Format(time.RFC3339), result.CreatedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExchangeRatesServiceCreateExchangeRateAlreadyExists(t *testing.T) {
+	mockRepo := new(MockExchangeRatesRepository)
+	service := NewExchangeRatesService(mockRepo)
+
+	mockRepo.On("Create", mock.AnythingOfType("*model.ExchangeRateModel")).Return(nil, ErrExchangeRateAlreadyExists)
+
+	result, err := service.CreateExchangeRate(dtos.CreateExchangeRateDTO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BRL",
+		Rate:          json.Number("5.25"),
+	})
+
+	assert.ErrorIs(t, err, ErrExchangeRateAlreadyExists)
+	assert.Equal(t, dtos.ExchangeRateResponseDTO{}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExchangeRatesServiceCreateExchangeRateInvalidRate(t *testing.T) {
+	mockRepo := new(MockExchangeRatesRepository)
+	service := NewExchangeRatesService(mockRepo)
+
+	_, err := service.CreateExchangeRate(dtos.CreateExchangeRateDTO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BRL",
+		Rate:          json.Number("-1"),
+	})
+
+	assert.ErrorIs(t, err, money.ErrInvalidRate)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestExchangeRatesServiceUpdateExchangeRateSuccess(t *testing.T) {
+	mockRepo := new(MockExchangeRatesRepository)
+	service := NewExchangeRatesService(mockRepo)
+
+	existing := exchangeRateModel("USD", "BRL", "5.25")
+	updated := exchangeRateModel("USD", "BRL", "5.30")
+	updated.ID = existing.ID
+
+	mockRepo.On("GetByID", existing.ID.Hex()).Return(existing, nil)
+	mockRepo.On("Update", existing.ID.Hex(), mock.AnythingOfType("*model.ExchangeRateModel")).Return(updated, nil)
+
+	result, err := service.UpdateExchangeRate(existing.ID.Hex(), dtos.UpdateExchangeRateDTO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BRL",
+		Rate:          json.Number("5.30"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("5.30"), result.Rate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExchangeRatesServiceDeleteExchangeRateRepositoryError(t *testing.T) {
+	mockRepo := new(MockExchangeRatesRepository)
+	service := NewExchangeRatesService(mockRepo)
+
+	expectedError := errors.New("database error")
+	mockRepo.On("Delete", "123").Return(expectedError)
+
+	err := service.DeleteExchangeRate("123")
+
+	assert.Equal(t, expectedError, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExchangeRateTableRate(t *testing.T) {
+	table, err := newExchangeRateTable([]*model.ExchangeRateModel{
+		exchangeRateModel("USD", "BRL", "5"),
+	})
+	assert.NoError(t, err)
+
+	rate, err := table.rate("usd", "BRL")
+	assert.NoError(t, err)
+	assert.Equal(t, "5", rate.RatString())
+
+	rate, err = table.rate("BRL", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, "1/5", rate.RatString())
+
+	rate, err = table.rate("EUR", "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", rate.RatString())
+
+	_, err = table.rate("EUR", "BRL")
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+)
+
+var (
+	ErrIdempotencyKeyReused     = domain.NewError(domain.ErrValidation, i18n.IdempotencyKeyReused)
+	ErrIdempotencyKeyInProgress = domain.NewError(domain.ErrConflict, i18n.IdempotencyKeyInProgress)
+)
+
+type IdempotencyService interface {
+	CreateTransactionsEntry(key string, entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, bool, error)
+}
+
+type idempotencyService struct {
+	idempotencyKeysRepo repository.IdempotencyKeysRepository
+	transactionsService TransactionsService
+}
+
+func NewIdempotencyService(idempotencyKeysRepo repository.IdempotencyKeysRepository, transactionsService TransactionsService) IdempotencyService {
+	return &idempotencyService{
+		idempotencyKeysRepo: idempotencyKeysRepo,
+		transactionsService: transactionsService,
+	}
+}
+
+// CreateTransactionsEntry creates the entry at most once per key. The boolean
+// result reports whether the response was replayed from an earlier request.
+// Responses are remembered with dates in DateFormat, so a retry can ask for
+// them in another layout.
+func (s *idempotencyService) CreateTransactionsEntry(key string, entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, bool, error) {
+	hash, err := requestHash(entry)
+	if err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, false, err
+	}
+
+	reserved, err := s.idempotencyKeysRepo.Reserve(&model.IdempotencyKeyModel{Key: key, RequestHash: hash})
+	if err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, false, err
+	}
+
+	if !reserved {
+		response, err := s.replay(key, hash)
+		if err != nil {
+			return dtos.TransactionsEntryResponseDTO{}, false, err
+		}
+
+		response.Date = dates.Format(response.Date, layout)
+		return response, true, nil
+	}
+
+	response, err := s.transactionsService.CreateTransactionsEntry(entry, DateFormat)
+	if err != nil {
+		// Failed requests are not remembered so the client can retry with the same key.
+		if releaseErr := s.idempotencyKeysRepo.Delete(key); releaseErr != nil {
+			return dtos.TransactionsEntryResponseDTO{}, false, errors.Join(err, releaseErr)
+		}
+		return dtos.TransactionsEntryResponseDTO{}, false, err
+	}
+
+	body, err := json.Marshal(response)
+	if err == nil {
+		err = s.idempotencyKeysRepo.Complete(key, body)
+	}
+	if err != nil {
+		log.Printf("⚠️  Falha ao salvar a resposta da chave de idempotência %q: %v", key, err)
+	}
+
+	response.Date = dates.Format(response.Date, layout)
+	return response, false, nil
+}
+
+func (s *idempotencyService) replay(key, hash string) (dtos.TransactionsEntryResponseDTO, error) {
+	record, err := s.idempotencyKeysRepo.GetByKey(key)
+	if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+		// The request holding the key failed and released it after Reserve
+		// saw it, so the client can simply retry.
+		return dtos.TransactionsEntryResponseDTO{}, ErrIdempotencyKeyInProgress
+	}
+
+	if err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, err
+	}
+
+	if record.RequestHash != hash {
+		return dtos.TransactionsEntryResponseDTO{}, ErrIdempotencyKeyReused
+	}
+
+	if len(record.Response) == 0 {
+		return dtos.TransactionsEntryResponseDTO{}, ErrIdempotencyKeyInProgress
+	}
+
+	var response dtos.TransactionsEntryResponseDTO
+	if err := json.Unmarshal(record.Response, &response); err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, err
+	}
+
+	return response, nil
+}
+
+// requestHash fingerprints the bound request rather than the raw body, so
+// retries that only differ in formatting or key order still match.
+func requestHash(request interface{}) (string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockIdempotencyKeysRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyKeysRepository) Reserve(record *model.IdempotencyKeyModel) (bool, error) {
+	args := m.Called(record)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockIdempotencyKeysRepository) GetByKey(key string) (*model.IdempotencyKeyModel, error) {
+	args := m.Called(key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.IdempotencyKeyModel), args.Error(1)
+}
+
+func (m *MockIdempotencyKeysRepository) Complete(key string, response []byte) error {
+	args := m.Called(key, response)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyKeysRepository) Delete(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyKeysRepository) EnsureIndexes() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func TestIdempotencyServiceCreateTransactionsEntry(t *testing.T) {
+	entry := dtos.CreateTransactionsEntryDTO{
+		Amount:        json.Number("10.00"),
+		Title:         "Coffee",
+		Currency:      "BRL",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "pix",
+		Date:          "01/09/2025",
+	}
+	hash, _ := requestHash(entry)
+	created := dtos.TransactionsEntryResponseDTO{ID: "507f1f77bcf86cd799439011", Amount: json.Number("10.00"), Title: "Coffee"}
+	createdBody, _ := json.Marshal(created)
+
+	t.Run("first_request_creates_and_stores_response", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", &model.IdempotencyKeyModel{Key: "retry-123", RequestHash: hash}).Return(true, nil)
+		mockTransactions.On("CreateTransactionsEntry", entry, DateFormat).Return(created, nil)
+		mockRepo.On("Complete", "retry-123", createdBody).Return(nil)
+
+		response, replayed, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, created, response)
+		mockRepo.AssertExpectations(t)
+		mockTransactions.AssertExpectations(t)
+	})
+
+	t.Run("retry_replays_stored_response", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", mock.Anything).Return(false, nil)
+		mockRepo.On("GetByKey", "retry-123").Return(&model.IdempotencyKeyModel{Key: "retry-123", RequestHash: hash, Response: createdBody}, nil)
+
+		response, replayed, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Equal(t, created, response)
+		mockTransactions.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("replay_uses_the_requested_layout", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		stored, _ := json.Marshal(dtos.TransactionsEntryResponseDTO{ID: created.ID, Date: "01/09/2025"})
+		mockRepo.On("Reserve", mock.Anything).Return(false, nil)
+		mockRepo.On("GetByKey", "retry-123").Return(&model.IdempotencyKeyModel{Key: "retry-123", RequestHash: hash, Response: stored}, nil)
+
+		response, replayed, err := service.CreateTransactionsEntry("retry-123", entry, dates.ISOLayout)
+
+		assert.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Equal(t, "2025-09-01", response.Date)
+	})
+
+	t.Run("different_body_is_rejected", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", mock.Anything).Return(false, nil)
+		mockRepo.On("GetByKey", "retry-123").Return(&model.IdempotencyKeyModel{Key: "retry-123", RequestHash: "other", Response: createdBody}, nil)
+
+		_, replayed, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+		assert.False(t, replayed)
+		mockTransactions.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("request_in_progress", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", mock.Anything).Return(false, nil)
+		mockRepo.On("GetByKey", "retry-123").Return(&model.IdempotencyKeyModel{Key: "retry-123", RequestHash: hash}, nil)
+
+		_, _, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInProgress)
+		mockTransactions.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("key_released_while_replaying", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", mock.Anything).Return(false, nil)
+		mockRepo.On("GetByKey", "retry-123").Return(nil, repository.ErrIdempotencyKeyNotFound)
+
+		_, _, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInProgress)
+		mockTransactions.AssertNotCalled(t, "CreateTransactionsEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed_create_releases_key", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		expectedError := errors.New("database connection failed")
+		mockRepo.On("Reserve", mock.Anything).Return(true, nil)
+		mockTransactions.On("CreateTransactionsEntry", entry, DateFormat).Return(dtos.TransactionsEntryResponseDTO{}, expectedError)
+		mockRepo.On("Delete", "retry-123").Return(nil)
+
+		_, _, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.Equal(t, expectedError, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("store_failure_still_returns_created_entry", func(t *testing.T) {
+		mockRepo := new(MockIdempotencyKeysRepository)
+		mockTransactions := new(MockTransactionsService)
+		service := NewIdempotencyService(mockRepo, mockTransactions)
+
+		mockRepo.On("Reserve", mock.Anything).Return(true, nil)
+		mockTransactions.On("CreateTransactionsEntry", entry, DateFormat).Return(created, nil)
+		mockRepo.On("Complete", "retry-123", createdBody).Return(errors.New("database connection failed"))
+
+		response, replayed, err := service.CreateTransactionsEntry("retry-123", entry, DateFormat)
+
+		assert.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, created, response)
+	})
+}
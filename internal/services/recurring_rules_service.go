@@ -0,0 +1,309 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	frequencyDaily   = "daily"
+	frequencyWeekly  = "weekly"
+	frequencyMonthly = "monthly"
+	frequencyYearly  = "yearly"
+)
+
+var ErrRecurringEndBeforeStart = domain.NewError(domain.ErrValidation, i18n.RecurringEndBeforeStart)
+
+type RecurringRulesService interface {
+	CreateRecurringRule(rule dtos.CreateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error)
+	GetAllRecurringRules(layout string) ([]dtos.RecurringRuleResponseDTO, error)
+	GetRecurringRuleByID(id, layout string) (dtos.RecurringRuleResponseDTO, error)
+	UpdateRecurringRule(id string, rule dtos.UpdateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error)
+	DeleteRecurringRule(id string) error
+	GenerateDueOccurrences(now time.Time) (int, error)
+}
+
+type recurringRulesService struct {
+	recurringRepo    repository.RecurringRulesRepository
+	transactionsRepo repository.TransactionsEntryRepository
+	accountsRepo     repository.AccountsRepository
+}
+
+func NewRecurringRulesService(recurringRepo repository.RecurringRulesRepository, transactionsRepo repository.TransactionsEntryRepository, accountsRepo repository.AccountsRepository) RecurringRulesService {
+	return &recurringRulesService{
+		recurringRepo:    recurringRepo,
+		transactionsRepo: transactionsRepo,
+		accountsRepo:     accountsRepo,
+	}
+}
+
+func (s *recurringRulesService) CreateRecurringRule(rule dtos.CreateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	ruleModel, err := s.buildRecurringRule(dtos.UpdateRecurringRuleDTO(rule))
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	scheduleNextRun(ruleModel)
+
+	createdRule, err := s.recurringRepo.Create(ruleModel)
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	return toRecurringRuleResponseDTO(createdRule, layout), nil
+}
+
+func (s *recurringRulesService) GetAllRecurringRules(layout string) ([]dtos.RecurringRuleResponseDTO, error) {
+	rules, err := s.recurringRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dtos.RecurringRuleResponseDTO, 0, len(rules))
+	for _, rule := range rules {
+		response = append(response, toRecurringRuleResponseDTO(rule, layout))
+	}
+
+	return response, nil
+}
+
+func (s *recurringRulesService) GetRecurringRuleByID(id, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	rule, err := s.recurringRepo.GetByID(id)
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	return toRecurringRuleResponseDTO(rule, layout), nil
+}
+
+func (s *recurringRulesService) UpdateRecurringRule(id string, rule dtos.UpdateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	existingRule, err := s.recurringRepo.GetByID(id)
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	ruleModel, err := s.buildRecurringRule(rule)
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	ruleModel.OccurrencesGenerated = existingRule.OccurrencesGenerated
+	ruleModel.CreatedAt = existingRule.CreatedAt
+	scheduleNextRun(ruleModel)
+
+	updatedRule, err := s.recurringRepo.Update(id, ruleModel)
+	if err != nil {
+		return dtos.RecurringRuleResponseDTO{}, err
+	}
+
+	return toRecurringRuleResponseDTO(updatedRule, layout), nil
+}
+
+func (s *recurringRulesService) DeleteRecurringRule(id string) error {
+	return s.recurringRepo.Delete(id)
+}
+
+func (s *recurringRulesService) GenerateDueOccurrences(now time.Time) (int, error) {
+	rules, err := s.recurringRepo.GetDue(now)
+	if err != nil {
+		return 0, err
+	}
+
+	var generated int
+	var errs []error
+
+	for _, rule := range rules {
+		count, err := s.materializeRule(rule, now)
+		generated += count
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return generated, errors.Join(errs...)
+}
+
+func (s *recurringRulesService) materializeRule(rule *model.RecurringRuleModel, now time.Time) (int, error) {
+	var generated int
+
+	for rule.Active && !rule.NextRunAt.After(now) {
+		occurrence := rule.OccurrencesGenerated + 1
+
+		created, err := s.transactionsRepo.CreateRecurringOccurrence(&model.TransactionsEntryModel{
+			Amount:              rule.Amount,
+			Title:               rule.Title,
+			Currency:            rule.Currency,
+			Type:                rule.Type,
+			Category:            rule.Category,
+			PaymentMethod:       rule.PaymentMethod,
+			Description:         rule.Description,
+			AccountID:           rule.AccountID,
+			Date:                rule.NextRunAt,
+			RecurringRuleID:     rule.ID,
+			RecurringOccurrence: occurrence,
+		})
+		if err != nil {
+			return generated, err
+		}
+
+		if created {
+			generated++
+		}
+
+		previousOccurrences := rule.OccurrencesGenerated
+		rule.OccurrencesGenerated = occurrence
+		scheduleNextRun(rule)
+
+		advanced, err := s.recurringRepo.Advance(rule, previousOccurrences)
+		if err != nil {
+			return generated, err
+		}
+
+		if !advanced {
+			break
+		}
+	}
+
+	return generated, nil
+}
+
+func (s *recurringRulesService) buildRecurringRule(rule dtos.UpdateRecurringRuleDTO) (*model.RecurringRuleModel, error) {
+	amount, err := parseAmount(rule.Amount, rule.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, err := dates.Parse(rule.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var endDate *time.Time
+	if rule.EndDate != "" {
+		parsedEndDate, err := dates.Parse(rule.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		if parsedEndDate.Before(startDate) {
+			return nil, ErrRecurringEndBeforeStart
+		}
+
+		endDate = &parsedEndDate
+	}
+
+	accountID := primitive.NilObjectID
+	if rule.AccountID != "" {
+		account, err := s.accountsRepo.GetByID(rule.AccountID)
+		if err != nil {
+			return nil, referencedAccountError(err)
+		}
+
+		if !strings.EqualFold(account.Currency, rule.Currency) {
+			return nil, ErrAccountCurrencyMismatch
+		}
+		accountID = account.ID
+	}
+
+	interval := rule.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	return &model.RecurringRuleModel{
+		Amount:         amount,
+		Title:          rule.Title,
+		Currency:       strings.ToUpper(rule.Currency),
+		Type:           rule.Type,
+		Category:       rule.Category,
+		PaymentMethod:  rule.PaymentMethod,
+		Description:    rule.Description,
+		AccountID:      accountID,
+		Frequency:      rule.Frequency,
+		Interval:       interval,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		MaxOccurrences: rule.MaxOccurrences,
+	}, nil
+}
+
+func scheduleNextRun(rule *model.RecurringRuleModel) {
+	rule.NextRunAt = recurringOccurrenceDate(rule, rule.OccurrencesGenerated)
+
+	exhausted := rule.MaxOccurrences > 0 && rule.OccurrencesGenerated >= rule.MaxOccurrences
+	expired := rule.EndDate != nil && rule.NextRunAt.After(*rule.EndDate)
+
+	rule.Active = !exhausted && !expired
+}
+
+func recurringOccurrenceDate(rule *model.RecurringRuleModel, index int) time.Time {
+	steps := index * rule.Interval
+
+	switch rule.Frequency {
+	case frequencyDaily:
+		return rule.StartDate.AddDate(0, 0, steps)
+	case frequencyWeekly:
+		return rule.StartDate.AddDate(0, 0, 7*steps)
+	case frequencyYearly:
+		return addMonthsClamped(rule.StartDate, 12*steps)
+	default:
+		return addMonthsClamped(rule.StartDate, steps)
+	}
+}
+
+func addMonthsClamped(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, date.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(months), day, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
+
+func toRecurringRuleResponseDTO(rule *model.RecurringRuleModel, layout string) dtos.RecurringRuleResponseDTO {
+	response := dtos.RecurringRuleResponseDTO{
+		ID:                   rule.ID.Hex(),
+		Amount:               money.ToNumber(rule.Amount, rule.Currency),
+		Title:                rule.Title,
+		Currency:             rule.Currency,
+		Type:                 rule.Type,
+		Category:             rule.Category,
+		PaymentMethod:        rule.PaymentMethod,
+		Description:          rule.Description,
+		Frequency:            rule.Frequency,
+		Interval:             rule.Interval,
+		StartDate:            rule.StartDate.Format(layout),
+		MaxOccurrences:       rule.MaxOccurrences,
+		OccurrencesGenerated: rule.OccurrencesGenerated,
+		Active:               rule.Active,
+		CreatedAt:            rule.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:            rule.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if !rule.AccountID.IsZero() {
+		response.AccountID = rule.AccountID.Hex()
+	}
+
+	if rule.EndDate != nil {
+		response.EndDate = rule.EndDate.Format(layout)
+	}
+
+	if rule.Active {
+		response.NextRunAt = rule.NextRunAt.Format(layout)
+	}
+
+	return response
+}
@@ -0,0 +1,390 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MockRecurringRulesRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurringRulesRepository) Create(rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error) {
+	args := m.Called(rule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RecurringRuleModel), args.Error(1)
+}
+
+func (m *MockRecurringRulesRepository) GetAll() ([]*model.RecurringRuleModel, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.RecurringRuleModel), args.Error(1)
+}
+
+func (m *MockRecurringRulesRepository) GetByID(id string) (*model.RecurringRuleModel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RecurringRuleModel), args.Error(1)
+}
+
+func (m *MockRecurringRulesRepository) Update(id string, rule *model.RecurringRuleModel) (*model.RecurringRuleModel, error) {
+	args := m.Called(id, rule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RecurringRuleModel), args.Error(1)
+}
+
+func (m *MockRecurringRulesRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRecurringRulesRepository) GetDue(now time.Time) ([]*model.RecurringRuleModel, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.RecurringRuleModel), args.Error(1)
+}
+
+func (m *MockRecurringRulesRepository) Advance(rule *model.RecurringRuleModel, previousOccurrences int) (bool, error) {
+	args := m.Called(rule, previousOccurrences)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestRecurringOccurrenceDate(t *testing.T) {
+	startDate := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		frequency string
+		interval  int
+		index     int
+		expected  time.Time
+	}{
+		{name: "first_occurrence_is_start_date", frequency: "monthly", interval: 1, index: 0, expected: startDate},
+		{name: "monthly_clamps_to_leap_february", frequency: "monthly", interval: 1, index: 1, expected: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "monthly_returns_to_day_31", frequency: "monthly", interval: 1, index: 2, expected: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "monthly_clamps_to_april_30", frequency: "monthly", interval: 1, index: 3, expected: time.Date(2024, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{name: "bimonthly", frequency: "monthly", interval: 2, index: 1, expected: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "daily", frequency: "daily", interval: 1, index: 3, expected: time.Date(2024, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "biweekly", frequency: "weekly", interval: 2, index: 1, expected: time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		{name: "yearly", frequency: "yearly", interval: 1, index: 1, expected: time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &model.RecurringRuleModel{Frequency: tt.frequency, Interval: tt.interval, StartDate: startDate}
+
+			assert.Equal(t, tt.expected, recurringOccurrenceDate(rule, tt.index))
+		})
+	}
+
+	t.Run("yearly_from_leap_day", func(t *testing.T) {
+		rule := &model.RecurringRuleModel{Frequency: "yearly", Interval: 1, StartDate: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)}
+
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), recurringOccurrenceDate(rule, 1))
+		assert.Equal(t, time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC), recurringOccurrenceDate(rule, 4))
+	})
+}
+
+func TestRecurringRulesServiceCreateRecurringRule(t *testing.T) {
+	t.Run("schedules_first_run_on_start_date", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, new(MockTransactionsRepository), new(MockAccountsRepository))
+
+		objectID := primitive.NewObjectID()
+		expectedStart := time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+		mockRecurringRepo.On("Create", mock.MatchedBy(func(rule *model.RecurringRuleModel) bool {
+			return rule.Interval == 1 && rule.Active && rule.NextRunAt.Equal(expectedStart) && rule.Currency == "BRL"
+		})).Return(&model.RecurringRuleModel{
+			ID:        objectID,
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: expectedStart,
+			NextRunAt: expectedStart,
+			Active:    true,
+		}, nil)
+
+		result, err := service.CreateRecurringRule(dtos.CreateRecurringRuleDTO{
+			Amount:        json.Number("1500.00"),
+			Title:         "Rent",
+			Currency:      "brl",
+			Type:          "expense",
+			Category:      "Housing",
+			PaymentMethod: "pix",
+			Frequency:     "monthly",
+			StartDate:     "05/01/2025",
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, objectID.Hex(), result.ID)
+		assert.Equal(t, "05/01/2025", result.NextRunAt)
+		assert.True(t, result.Active)
+		mockRecurringRepo.AssertExpectations(t)
+	})
+
+	t.Run("end_date_before_start_date", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, new(MockTransactionsRepository), new(MockAccountsRepository))
+
+		result, err := service.CreateRecurringRule(dtos.CreateRecurringRuleDTO{
+			Amount:    json.Number("1500.00"),
+			Frequency: "monthly",
+			StartDate: "05/01/2025",
+			EndDate:   "01/01/2025",
+		}, DateFormat)
+
+		assert.ErrorIs(t, err, ErrRecurringEndBeforeStart)
+		assert.Equal(t, dtos.RecurringRuleResponseDTO{}, result)
+		mockRecurringRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestRecurringRulesServiceUpdateRecurringRuleKeepsProgress(t *testing.T) {
+	mockRecurringRepo := new(MockRecurringRulesRepository)
+	service := NewRecurringRulesService(mockRecurringRepo, new(MockTransactionsRepository), new(MockAccountsRepository))
+
+	objectID := primitive.NewObjectID()
+	existing := &model.RecurringRuleModel{
+		ID:                   objectID,
+		Frequency:            "monthly",
+		Interval:             1,
+		StartDate:            time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+		OccurrencesGenerated: 3,
+		Active:               true,
+	}
+
+	mockRecurringRepo.On("GetByID", objectID.Hex()).Return(existing, nil)
+	mockRecurringRepo.On("Update", objectID.Hex(), mock.MatchedBy(func(rule *model.RecurringRuleModel) bool {
+		return rule.OccurrencesGenerated == 3 &&
+			rule.NextRunAt.Equal(time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC)) &&
+			!rule.Active
+	})).Return(existing, nil)
+
+	_, err := service.UpdateRecurringRule(objectID.Hex(), dtos.UpdateRecurringRuleDTO{
+		Amount:         json.Number("1600.00"),
+		Frequency:      "monthly",
+		StartDate:      "10/01/2025",
+		MaxOccurrences: 3,
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	mockRecurringRepo.AssertExpectations(t)
+}
+
+func TestRecurringRulesServiceGenerateDueOccurrences(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("catches_up_missed_occurrences", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		ruleID := primitive.NewObjectID()
+		rule := &model.RecurringRuleModel{
+			ID:        ruleID,
+			Amount:    150000,
+			Title:     "Rent",
+			Type:      "expense",
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+			Active:    true,
+		}
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{rule}, nil)
+
+		var dates []time.Time
+		var occurrences []int
+		mockRepo.On("CreateRecurringOccurrence", mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+			return entry.RecurringRuleID == ruleID && entry.Title == "Rent"
+		})).Run(func(args mock.Arguments) {
+			entry := args.Get(0).(*model.TransactionsEntryModel)
+			dates = append(dates, entry.Date)
+			occurrences = append(occurrences, entry.RecurringOccurrence)
+		}).Return(true, nil)
+
+		mockRecurringRepo.On("Advance", rule, mock.AnythingOfType("int")).Return(true, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, generated)
+		assert.Equal(t, []time.Time{
+			time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.February, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC),
+		}, dates)
+		assert.Equal(t, []int{1, 2, 3}, occurrences)
+		assert.Equal(t, 3, rule.OccurrencesGenerated)
+		assert.Equal(t, time.Date(2025, time.April, 5, 0, 0, 0, 0, time.UTC), rule.NextRunAt)
+		assert.True(t, rule.Active)
+		mockRecurringRepo.AssertNumberOfCalls(t, "Advance", 3)
+	})
+
+	t.Run("stops_after_max_occurrences", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		rule := &model.RecurringRuleModel{
+			ID:             primitive.NewObjectID(),
+			Frequency:      "weekly",
+			Interval:       1,
+			StartDate:      time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			NextRunAt:      time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			MaxOccurrences: 2,
+			Active:         true,
+		}
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{rule}, nil)
+		mockRepo.On("CreateRecurringOccurrence", mock.Anything).Return(true, nil)
+		mockRecurringRepo.On("Advance", rule, mock.AnythingOfType("int")).Return(true, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, generated)
+		assert.False(t, rule.Active)
+		mockRepo.AssertNumberOfCalls(t, "CreateRecurringOccurrence", 2)
+	})
+
+	t.Run("stops_after_end_date", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		endDate := time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC)
+		rule := &model.RecurringRuleModel{
+			ID:        primitive.NewObjectID(),
+			Frequency: "daily",
+			Interval:  1,
+			StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   &endDate,
+			Active:    true,
+		}
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{rule}, nil)
+		mockRepo.On("CreateRecurringOccurrence", mock.Anything).Return(true, nil)
+		mockRecurringRepo.On("Advance", rule, mock.AnythingOfType("int")).Return(true, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, generated)
+		assert.False(t, rule.Active)
+	})
+
+	t.Run("already_materialized_occurrence_is_not_counted", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		rule := &model.RecurringRuleModel{
+			ID:        primitive.NewObjectID(),
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Active:    true,
+		}
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{rule}, nil)
+		mockRepo.On("CreateRecurringOccurrence", mock.Anything).Return(false, nil)
+		mockRecurringRepo.On("Advance", rule, 0).Return(true, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, generated)
+		assert.Equal(t, 1, rule.OccurrencesGenerated)
+		mockRecurringRepo.AssertExpectations(t)
+	})
+
+	t.Run("stops_when_rule_was_advanced_elsewhere", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		rule := &model.RecurringRuleModel{
+			ID:        primitive.NewObjectID(),
+			Frequency: "daily",
+			Interval:  1,
+			StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Active:    true,
+		}
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{rule}, nil)
+		mockRepo.On("CreateRecurringOccurrence", mock.Anything).Return(true, nil)
+		mockRecurringRepo.On("Advance", rule, 0).Return(false, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, generated)
+		mockRepo.AssertNumberOfCalls(t, "CreateRecurringOccurrence", 1)
+	})
+
+	t.Run("error_on_one_rule_does_not_block_others", func(t *testing.T) {
+		mockRecurringRepo := new(MockRecurringRulesRepository)
+		mockRepo := new(MockTransactionsRepository)
+		service := NewRecurringRulesService(mockRecurringRepo, mockRepo, new(MockAccountsRepository))
+
+		failingRule := &model.RecurringRuleModel{
+			ID:        primitive.NewObjectID(),
+			Title:     "Failing",
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Active:    true,
+		}
+		workingRule := &model.RecurringRuleModel{
+			ID:        primitive.NewObjectID(),
+			Title:     "Working",
+			Frequency: "monthly",
+			Interval:  1,
+			StartDate: time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+			NextRunAt: time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+			Active:    true,
+		}
+		expectedError := errors.New("insert failed")
+
+		mockRecurringRepo.On("GetDue", now).Return([]*model.RecurringRuleModel{failingRule, workingRule}, nil)
+		mockRepo.On("CreateRecurringOccurrence", mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+			return entry.Title == "Failing"
+		})).Return(false, expectedError)
+		mockRepo.On("CreateRecurringOccurrence", mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+			return entry.Title == "Working"
+		})).Return(true, nil)
+		mockRecurringRepo.On("Advance", workingRule, 0).Return(true, nil)
+
+		generated, err := service.GenerateDueOccurrences(now)
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Equal(t, 1, generated)
+		assert.Equal(t, 0, failingRule.OccurrencesGenerated)
+		mockRecurringRepo.AssertExpectations(t)
+	})
+}
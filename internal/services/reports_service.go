@@ -0,0 +1,332 @@
+package services
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
+	"myfin-api/internal/repository/types"
+)
+
+const (
+	otherCategory         = "other"
+	uncategorizedCategory = "uncategorized"
+)
+
+const (
+	intervalDay   = "day"
+	intervalWeek  = "week"
+	intervalMonth = "month"
+)
+
+const maxCashflowBuckets = 1000
+
+var ErrTooManyBuckets = domain.NewError(domain.ErrValidation, i18n.TooManyBuckets)
+
+type ReportsService interface {
+	GetCategoryReport(query dtos.CategoryReportQueryDTO, layout string) (dtos.CategoryReportResponseDTO, error)
+	GetCashflowReport(query dtos.CashflowReportQueryDTO, layout string) (dtos.CashflowReportResponseDTO, error)
+}
+
+type reportsService struct {
+	transactionsRepo repository.TransactionsEntryRepository
+	now              func() time.Time
+}
+
+func NewReportsService(transactionsRepo repository.TransactionsEntryRepository) ReportsService {
+	return &reportsService{
+		transactionsRepo: transactionsRepo,
+		now:              time.Now,
+	}
+}
+
+func (s *reportsService) GetCategoryReport(query dtos.CategoryReportQueryDTO, layout string) (dtos.CategoryReportResponseDTO, error) {
+	dateRange, err := parseDateRange(query.From, query.To)
+	if err != nil {
+		return dtos.CategoryReportResponseDTO{}, err
+	}
+
+	transactionType := query.Type
+	if transactionType == "" {
+		transactionType = "expense"
+	}
+
+	expression, err := compileFilter(query.Filter)
+	if err != nil {
+		return dtos.CategoryReportResponseDTO{}, err
+	}
+
+	categoryTotals, err := s.transactionsRepo.GetCategoryTotals(transactionType, dateRange, expression)
+	if err != nil {
+		return dtos.CategoryReportResponseDTO{}, err
+	}
+
+	response := dtos.CategoryReportResponseDTO{
+		Type:       transactionType,
+		Filter:     query.Filter,
+		Currencies: make([]dtos.CategoryReportCurrencyDTO, 0),
+	}
+	response.From, response.To = formatDateRange(dateRange, layout)
+
+	for _, currencyTotals := range groupCategoryTotalsByCurrency(categoryTotals) {
+		section, err := buildCategoryReportSection(currencyTotals, query.Top)
+		if err != nil {
+			return dtos.CategoryReportResponseDTO{}, err
+		}
+		response.Currencies = append(response.Currencies, section)
+	}
+
+	return response, nil
+}
+
+func groupCategoryTotalsByCurrency(categoryTotals []*types.CategoryTotal) [][]*types.CategoryTotal {
+	totalsByCurrency := make(map[string][]*types.CategoryTotal)
+	currencies := make([]string, 0)
+
+	for _, categoryTotal := range categoryTotals {
+		if _, ok := totalsByCurrency[categoryTotal.Currency]; !ok {
+			currencies = append(currencies, categoryTotal.Currency)
+		}
+		totalsByCurrency[categoryTotal.Currency] = append(totalsByCurrency[categoryTotal.Currency], categoryTotal)
+	}
+
+	sort.Strings(currencies)
+
+	grouped := make([][]*types.CategoryTotal, 0, len(currencies))
+	for _, currency := range currencies {
+		grouped = append(grouped, totalsByCurrency[currency])
+	}
+
+	return grouped
+}
+
+func buildCategoryReportSection(categoryTotals []*types.CategoryTotal, top int) (dtos.CategoryReportCurrencyDTO, error) {
+	currency := categoryTotals[0].Currency
+
+	var total, count int64
+	for _, categoryTotal := range categoryTotals {
+		total += categoryTotal.Total
+		count += categoryTotal.Count
+	}
+
+	if top > 0 && len(categoryTotals) > top {
+		other := &types.CategoryTotal{Category: otherCategory, Currency: currency}
+		for _, categoryTotal := range categoryTotals[top:] {
+			other.Total += categoryTotal.Total
+			other.Count += categoryTotal.Count
+		}
+
+		categoryTotals = append(categoryTotals[:top:top], other)
+	}
+
+	categories := make([]dtos.CategoryReportItemDTO, 0, len(categoryTotals))
+	for _, categoryTotal := range categoryTotals {
+		averageTicket, err := money.Round(big.NewRat(categoryTotal.Total, max(categoryTotal.Count, 1)))
+		if err != nil {
+			return dtos.CategoryReportCurrencyDTO{}, err
+		}
+
+		var share float64
+		if total != 0 {
+			share = float64(categoryTotal.Total) / float64(total) * 100
+		}
+
+		category := categoryTotal.Category
+		if category == "" {
+			category = uncategorizedCategory
+		}
+
+		categories = append(categories, dtos.CategoryReportItemDTO{
+			Category:      category,
+			Total:         money.ToNumber(categoryTotal.Total, currency),
+			Count:         categoryTotal.Count,
+			Share:         math.Round(share*100) / 100,
+			AverageTicket: money.ToNumber(averageTicket, currency),
+		})
+	}
+
+	return dtos.CategoryReportCurrencyDTO{
+		Currency:   currency,
+		Total:      money.ToNumber(total, currency),
+		Count:      count,
+		Categories: categories,
+	}, nil
+}
+
+func (s *reportsService) GetCashflowReport(query dtos.CashflowReportQueryDTO, layout string) (dtos.CashflowReportResponseDTO, error) {
+	dateRange, err := parseDateRange(query.From, query.To)
+	if err != nil {
+		return dtos.CashflowReportResponseDTO{}, err
+	}
+
+	interval := query.Interval
+	if interval == "" {
+		interval = intervalMonth
+	}
+
+	expression, err := compileFilter(query.Filter)
+	if err != nil {
+		return dtos.CashflowReportResponseDTO{}, err
+	}
+
+	cashflowTotals, err := s.transactionsRepo.GetCashflowTotals(interval, dateRange, expression)
+	if err != nil {
+		return dtos.CashflowReportResponseDTO{}, err
+	}
+
+	openingBalances := make(map[string]int64)
+	if !dateRange.From.IsZero() {
+		openingTotals, err := s.transactionsRepo.GetTotalsByCurrency(types.DateRange{To: dateRange.From}, expression)
+		if err != nil {
+			return dtos.CashflowReportResponseDTO{}, err
+		}
+
+		for _, total := range summarizeByCurrency(openingTotals) {
+			openingBalances[total.currency] = total.income - total.expense
+		}
+	}
+
+	periods, err := s.cashflowPeriods(interval, dateRange, cashflowTotals)
+	if err != nil {
+		return dtos.CashflowReportResponseDTO{}, err
+	}
+
+	response := dtos.CashflowReportResponseDTO{
+		Interval:   interval,
+		Filter:     query.Filter,
+		Currencies: make([]dtos.CashflowReportCurrencyDTO, 0),
+	}
+	response.From, response.To = formatDateRange(dateRange, layout)
+
+	if len(periods) == 0 {
+		return response, nil
+	}
+
+	incomeByPeriod := make(map[string]int64)
+	expenseByPeriod := make(map[string]int64)
+	currencySet := make(map[string]bool)
+
+	for _, total := range cashflowTotals {
+		key := cashflowKey(truncateToInterval(total.Period, interval), total.Currency)
+		currencySet[total.Currency] = true
+
+		switch total.Type {
+		case "income":
+			incomeByPeriod[key] += total.Total
+		case "expense":
+			expenseByPeriod[key] += total.Total
+		}
+	}
+
+	for currency, balance := range openingBalances {
+		if balance != 0 {
+			currencySet[currency] = true
+		}
+	}
+
+	currencies := make([]string, 0, len(currencySet))
+	for currency := range currencySet {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	for _, currency := range currencies {
+		cumulative := openingBalances[currency]
+
+		buckets := make([]dtos.CashflowBucketDTO, 0, len(periods))
+		for _, period := range periods {
+			key := cashflowKey(period, currency)
+			income := incomeByPeriod[key]
+			expense := expenseByPeriod[key]
+			cumulative += income - expense
+
+			buckets = append(buckets, dtos.CashflowBucketDTO{
+				Start:             period.Format(layout),
+				End:               nextInterval(period, interval).AddDate(0, 0, -1).Format(layout),
+				IncomeAmount:      money.ToNumber(income, currency),
+				ExpenseAmount:     money.ToNumber(expense, currency),
+				NetAmount:         money.ToNumber(income-expense, currency),
+				CumulativeBalance: money.ToNumber(cumulative, currency),
+			})
+		}
+
+		response.Currencies = append(response.Currencies, dtos.CashflowReportCurrencyDTO{
+			Currency:       currency,
+			OpeningBalance: money.ToNumber(openingBalances[currency], currency),
+			Buckets:        buckets,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *reportsService) cashflowPeriods(interval string, dateRange types.DateRange, cashflowTotals []*types.CashflowTotal) ([]time.Time, error) {
+	var first, last time.Time
+
+	switch {
+	case !dateRange.From.IsZero():
+		first = truncateToInterval(dateRange.From, interval)
+	case len(cashflowTotals) > 0:
+		first = truncateToInterval(cashflowTotals[0].Period, interval)
+	default:
+		return nil, nil
+	}
+
+	// Without an end date the report runs to today, or to the latest period
+	// with entries when some are dated in the future, so none are left out.
+	if !dateRange.To.IsZero() {
+		last = truncateToInterval(dateRange.To.AddDate(0, 0, -1), interval)
+	} else {
+		last = truncateToInterval(s.now(), interval)
+		if len(cashflowTotals) > 0 {
+			if latest := truncateToInterval(cashflowTotals[len(cashflowTotals)-1].Period, interval); latest.After(last) {
+				last = latest
+			}
+		}
+	}
+
+	periods := make([]time.Time, 0)
+	for period := first; !period.After(last); period = nextInterval(period, interval) {
+		if len(periods) == maxCashflowBuckets {
+			return nil, ErrTooManyBuckets
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, nil
+}
+
+func truncateToInterval(date time.Time, interval string) time.Time {
+	date = date.UTC()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case intervalWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case intervalMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+func nextInterval(period time.Time, interval string) time.Time {
+	switch interval {
+	case intervalWeek:
+		return period.AddDate(0, 0, 7)
+	case intervalMonth:
+		return period.AddDate(0, 1, 0)
+	default:
+		return period.AddDate(0, 0, 1)
+	}
+}
+
+func cashflowKey(period time.Time, currency string) string {
+	return period.Format(time.DateOnly) + "|" + currency
+}
@@ -0,0 +1,354 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/repository/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestReportsServiceGetCategoryReport(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo.On("GetCategoryTotals", "expense", expectedRange, bson.M(nil)).Return([]*types.CategoryTotal{
+		{Category: "food", Currency: "BRL", Total: 60000, Count: 3},
+		{Category: "transport", Currency: "BRL", Total: 30000, Count: 4},
+		{Category: "", Currency: "BRL", Total: 10000, Count: 1},
+	}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{From: "01/09/2025", To: "30/09/2025"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "01/09/2025", result.From)
+	assert.Equal(t, "30/09/2025", result.To)
+	assert.Equal(t, "expense", result.Type)
+	assert.Equal(t, []dtos.CategoryReportCurrencyDTO{
+		{
+			Currency: "BRL",
+			Total:    json.Number("1000.00"),
+			Count:    8,
+			Categories: []dtos.CategoryReportItemDTO{
+				{Category: "food", Total: json.Number("600.00"), Count: 3, Share: 60, AverageTicket: json.Number("200.00")},
+				{Category: "transport", Total: json.Number("300.00"), Count: 4, Share: 30, AverageTicket: json.Number("75.00")},
+				{Category: "uncategorized", Total: json.Number("100.00"), Count: 1, Share: 10, AverageTicket: json.Number("100.00")},
+			},
+		},
+	}, result.Currencies)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCategoryReportISODates(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo.On("GetCategoryTotals", "expense", expectedRange, bson.M(nil)).Return([]*types.CategoryTotal{}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{From: "2025-09-01", To: "2025-09-30T18:00:00-03:00"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "01/09/2025", result.From)
+	assert.Equal(t, "30/09/2025", result.To)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCategoryReportFoldsIntoOther(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCategoryTotals", "income", types.DateRange{}, bson.M(nil)).Return([]*types.CategoryTotal{
+		{Category: "salary", Currency: "BRL", Total: 900000, Count: 1},
+		{Category: "freelance", Currency: "BRL", Total: 60000, Count: 2},
+		{Category: "refunds", Currency: "BRL", Total: 30000, Count: 3},
+		{Category: "interest", Currency: "BRL", Total: 10000, Count: 3},
+	}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{Type: "income", Top: 2}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Currencies, 1)
+
+	categories := result.Currencies[0].Categories
+	assert.Len(t, categories, 3)
+	assert.Equal(t, "salary", categories[0].Category)
+	assert.Equal(t, "freelance", categories[1].Category)
+	assert.Equal(t, dtos.CategoryReportItemDTO{
+		Category:      "other",
+		Total:         json.Number("400.00"),
+		Count:         6,
+		Share:         4,
+		AverageTicket: json.Number("66.67"),
+	}, categories[2])
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCategoryReportSeparatesCurrencies(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCategoryTotals", "expense", types.DateRange{}, bson.M(nil)).Return([]*types.CategoryTotal{
+		{Category: "travel", Currency: "USD", Total: 50000, Count: 1},
+		{Category: "food", Currency: "BRL", Total: 20000, Count: 2},
+		{Category: "food", Currency: "USD", Total: 1000, Count: 3},
+	}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Currencies, 2)
+	assert.Equal(t, "BRL", result.Currencies[0].Currency)
+	assert.Equal(t, float64(100), result.Currencies[0].Categories[0].Share)
+	assert.Equal(t, "USD", result.Currencies[1].Currency)
+	assert.Equal(t, json.Number("510.00"), result.Currencies[1].Total)
+	assert.Equal(t, 98.04, result.Currencies[1].Categories[0].Share)
+	assert.Equal(t, json.Number("3.33"), result.Currencies[1].Categories[1].AverageTicket)
+}
+
+func TestReportsServiceGetCategoryReportEmpty(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCategoryTotals", "expense", types.DateRange{}, bson.M(nil)).Return([]*types.CategoryTotal{}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Currencies)
+	assert.Empty(t, result.Currencies)
+}
+
+func TestReportsServiceGetCategoryReportInvalidDateRange(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{From: "30/09/2025", To: "01/09/2025"}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	assert.Equal(t, dtos.CategoryReportResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "GetCategoryTotals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReportsServiceGetCategoryReportWithFilter(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCategoryTotals", "expense", types.DateRange{}, mock.MatchedBy(func(expression bson.M) bool {
+		_, ok := expression["category"]
+		return ok
+	})).Return([]*types.CategoryTotal{}, nil)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{Filter: "category != rent"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "category != rent", result.Filter)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCategoryReportInvalidFilter(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{Filter: "secret = 1"}, DateFormat)
+
+	assert.ErrorIs(t, err, filterql.ErrInvalidExpression)
+	assert.Equal(t, dtos.CategoryReportResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "GetCategoryTotals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReportsServiceGetCategoryReportRepositoryError(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	expectedError := errors.New("database connection error")
+	mockRepo.On("GetCategoryTotals", "expense", types.DateRange{}, bson.M(nil)).Return(nil, expectedError)
+
+	result, err := service.GetCategoryReport(dtos.CategoryReportQueryDTO{}, DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.CategoryReportResponseDTO{}, result)
+}
+
+func newCashflowReportsService(transactionsRepo *MockTransactionsRepository, now time.Time) ReportsService {
+	service := NewReportsService(transactionsRepo).(*reportsService)
+	service.now = func() time.Time { return now }
+	return service
+}
+
+func TestReportsServiceGetCashflowReportFillsEmptyBuckets(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo.On("GetCashflowTotals", "month", expectedRange, bson.M(nil)).Return([]*types.CashflowTotal{
+		{Period: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "income", Total: 500000},
+		{Period: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "expense", Total: 120000},
+		{Period: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "expense", Total: 80000},
+	}, nil)
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{To: expectedRange.From}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 100000},
+		{Currency: "BRL", Type: "expense", Total: 40000},
+	}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{From: "01/01/2025", To: "31/03/2025"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "month", result.Interval)
+	assert.Equal(t, []dtos.CashflowReportCurrencyDTO{
+		{
+			Currency:       "BRL",
+			OpeningBalance: json.Number("600.00"),
+			Buckets: []dtos.CashflowBucketDTO{
+				{Start: "01/01/2025", End: "31/01/2025", IncomeAmount: json.Number("5000.00"), ExpenseAmount: json.Number("1200.00"), NetAmount: json.Number("3800.00"), CumulativeBalance: json.Number("4400.00")},
+				{Start: "01/02/2025", End: "28/02/2025", IncomeAmount: json.Number("0.00"), ExpenseAmount: json.Number("0.00"), NetAmount: json.Number("0.00"), CumulativeBalance: json.Number("4400.00")},
+				{Start: "01/03/2025", End: "31/03/2025", IncomeAmount: json.Number("0.00"), ExpenseAmount: json.Number("800.00"), NetAmount: json.Number("-800.00"), CumulativeBalance: json.Number("3600.00")},
+			},
+		},
+	}, result.Currencies)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCashflowReportWeeklyBucketsStartOnMonday(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCashflowTotals", "week", mock.Anything, mock.Anything).Return([]*types.CashflowTotal{
+		{Period: time.Date(2025, time.September, 8, 0, 0, 0, 0, time.UTC), Currency: "USD", Type: "income", Total: 1000},
+	}, nil)
+	mockRepo.On("GetTotalsByCurrency", mock.Anything, mock.Anything).Return([]*types.CurrencyTotal{}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{From: "03/09/2025", To: "10/09/2025", Interval: "week"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Currencies, 1)
+
+	buckets := result.Currencies[0].Buckets
+	assert.Len(t, buckets, 2)
+	assert.Equal(t, "01/09/2025", buckets[0].Start)
+	assert.Equal(t, "07/09/2025", buckets[0].End)
+	assert.Equal(t, "08/09/2025", buckets[1].Start)
+	assert.Equal(t, json.Number("10.00"), buckets[1].CumulativeBalance)
+}
+
+func TestReportsServiceGetCashflowReportOpenEndedRunsUntilToday(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := newCashflowReportsService(mockRepo, time.Date(2025, time.September, 3, 18, 0, 0, 0, time.UTC))
+
+	mockRepo.On("GetCashflowTotals", "day", types.DateRange{}, bson.M(nil)).Return([]*types.CashflowTotal{
+		{Period: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "expense", Total: 2500},
+	}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{Interval: "day"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Currencies, 1)
+	assert.Equal(t, json.Number("0.00"), result.Currencies[0].OpeningBalance)
+
+	buckets := result.Currencies[0].Buckets
+	assert.Len(t, buckets, 3)
+	assert.Equal(t, "01/09/2025", buckets[0].Start)
+	assert.Equal(t, "03/09/2025", buckets[2].Start)
+	assert.Equal(t, json.Number("-25.00"), buckets[2].CumulativeBalance)
+
+	mockRepo.AssertNotCalled(t, "GetTotalsByCurrency", mock.Anything, mock.Anything)
+}
+
+func TestReportsServiceGetCashflowReportOpenEndedKeepsFutureEntries(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := newCashflowReportsService(mockRepo, time.Date(2025, time.September, 3, 18, 0, 0, 0, time.UTC))
+
+	mockRepo.On("GetCashflowTotals", "month", types.DateRange{}, bson.M(nil)).Return([]*types.CashflowTotal{
+		{Period: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "income", Total: 10000},
+		{Period: time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC), Currency: "BRL", Type: "expense", Total: 4000},
+	}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Currencies, 1)
+
+	buckets := result.Currencies[0].Buckets
+	assert.Len(t, buckets, 3)
+	assert.Equal(t, "01/11/2025", buckets[2].Start)
+	assert.Equal(t, json.Number("40.00"), buckets[2].ExpenseAmount)
+	assert.Equal(t, json.Number("60.00"), buckets[2].CumulativeBalance)
+}
+
+func TestReportsServiceGetCashflowReportWithoutData(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCashflowTotals", "month", types.DateRange{}, bson.M(nil)).Return([]*types.CashflowTotal{}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Currencies)
+	assert.Empty(t, result.Currencies)
+}
+
+func TestReportsServiceGetCashflowReportTooManyBuckets(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	mockRepo.On("GetCashflowTotals", "day", mock.Anything, mock.Anything).Return([]*types.CashflowTotal{}, nil)
+	mockRepo.On("GetTotalsByCurrency", mock.Anything, mock.Anything).Return([]*types.CurrencyTotal{}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{From: "01/01/2000", To: "31/12/2025", Interval: "day"}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrTooManyBuckets)
+	assert.Equal(t, dtos.CashflowReportResponseDTO{}, result)
+}
+
+func TestReportsServiceGetCashflowReportFilterAppliesToOpeningBalance(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	hasExpression := mock.MatchedBy(func(expression bson.M) bool { return expression != nil })
+
+	mockRepo.On("GetCashflowTotals", "month", mock.Anything, hasExpression).Return([]*types.CashflowTotal{}, nil)
+	mockRepo.On("GetTotalsByCurrency", mock.Anything, hasExpression).Return([]*types.CurrencyTotal{}, nil)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{From: "01/01/2025", To: "31/03/2025", Filter: "amount > 100"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "amount > 100", result.Filter)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReportsServiceGetCashflowReportRepositoryError(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewReportsService(mockRepo)
+
+	expectedError := errors.New("database connection error")
+	mockRepo.On("GetCashflowTotals", "month", types.DateRange{}, bson.M(nil)).Return(nil, expectedError)
+
+	result, err := service.GetCashflowReport(dtos.CashflowReportQueryDTO{}, DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.CashflowReportResponseDTO{}, result)
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+)
+
+const defaultSavedViewLimit = 10
+
+type SavedViewsService interface {
+	CreateSavedView(view dtos.CreateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error)
+	GetAllSavedViews(layout string) ([]dtos.SavedViewResponseDTO, error)
+	GetSavedViewByID(id, layout string) (dtos.SavedViewResponseDTO, error)
+	UpdateSavedView(id string, view dtos.UpdateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error)
+	DeleteSavedView(id string) error
+	GetSavedViewTransactions(id, cursor, layout string) (dtos.SavedViewTransactionsDTO, error)
+}
+
+type savedViewsService struct {
+	savedViewsRepo      repository.SavedViewsRepository
+	transactionsService TransactionsService
+}
+
+func NewSavedViewsService(savedViewsRepo repository.SavedViewsRepository, transactionsService TransactionsService) SavedViewsService {
+	return &savedViewsService{
+		savedViewsRepo:      savedViewsRepo,
+		transactionsService: transactionsService,
+	}
+}
+
+func (s *savedViewsService) CreateSavedView(view dtos.CreateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error) {
+	if err := validateSavedViewFilter(view.Filters); err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	viewModel := &model.SavedViewModel{
+		Name:    strings.TrimSpace(view.Name),
+		Filters: toSavedViewFiltersModel(view.Filters),
+		Limit:   savedViewLimit(view.Limit),
+		Skip:    view.Skip,
+	}
+
+	createdView, err := s.savedViewsRepo.Create(viewModel)
+	if err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	return toSavedViewResponseDTO(createdView, layout), nil
+}
+
+func (s *savedViewsService) GetAllSavedViews(layout string) ([]dtos.SavedViewResponseDTO, error) {
+	views, err := s.savedViewsRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dtos.SavedViewResponseDTO, 0, len(views))
+	for _, view := range views {
+		response = append(response, toSavedViewResponseDTO(view, layout))
+	}
+
+	return response, nil
+}
+
+func (s *savedViewsService) GetSavedViewByID(id, layout string) (dtos.SavedViewResponseDTO, error) {
+	view, err := s.savedViewsRepo.GetByID(id)
+	if err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	return toSavedViewResponseDTO(view, layout), nil
+}
+
+func (s *savedViewsService) UpdateSavedView(id string, view dtos.UpdateSavedViewDTO, layout string) (dtos.SavedViewResponseDTO, error) {
+	existingView, err := s.savedViewsRepo.GetByID(id)
+	if err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	if err := validateSavedViewFilter(view.Filters); err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	viewModel := &model.SavedViewModel{
+		Name:      strings.TrimSpace(view.Name),
+		Filters:   toSavedViewFiltersModel(view.Filters),
+		Limit:     savedViewLimit(view.Limit),
+		Skip:      view.Skip,
+		CreatedAt: existingView.CreatedAt,
+	}
+
+	updatedView, err := s.savedViewsRepo.Update(id, viewModel)
+	if err != nil {
+		return dtos.SavedViewResponseDTO{}, err
+	}
+
+	return toSavedViewResponseDTO(updatedView, layout), nil
+}
+
+func (s *savedViewsService) DeleteSavedView(id string) error {
+	return s.savedViewsRepo.Delete(id)
+}
+
+func (s *savedViewsService) GetSavedViewTransactions(id, cursor, layout string) (dtos.SavedViewTransactionsDTO, error) {
+	view, err := s.savedViewsRepo.GetByID(id)
+	if err != nil {
+		return dtos.SavedViewTransactionsDTO{}, err
+	}
+
+	filter := toTransactionsFilterDTO(view.Filters)
+	skip := view.Skip
+
+	if cursor != "" {
+		if filter.Sort != "" || filter.Q != "" {
+			return dtos.SavedViewTransactionsDTO{}, ErrInvalidCursor
+		}
+
+		filter.Cursor = cursor
+		skip = 0
+	}
+
+	page, err := s.transactionsService.GetAllTransactionsEntries(view.Limit, skip, filter, layout)
+	if err != nil {
+		return dtos.SavedViewTransactionsDTO{}, err
+	}
+
+	return dtos.SavedViewTransactionsDTO{
+		View: toSavedViewResponseDTO(view, layout),
+		Page: page,
+	}, nil
+}
+
+// validateSavedViewFilter runs the checks listing a view's transactions would,
+// so a view that could never be listed is rejected when it is saved rather
+// than on every later GET /views/:id/transactions.
+func validateSavedViewFilter(filters dtos.TransactionsFilterDTO) error {
+	if _, err := buildFilterOptions(filters); err != nil {
+		return err
+	}
+
+	return validateTransactionsSort(filters.Sort)
+}
+
+// validateTransactionsSort rejects the unknown and repeated fields that
+// parseTransactionsSort would otherwise silently drop.
+func validateTransactionsSort(sort string) error {
+	if sort == "" {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimPrefix(strings.TrimSpace(field), "-")
+
+		if _, ok := dtos.TransactionSortFields[field]; !ok || seen[field] {
+			return ErrInvalidSort
+		}
+		seen[field] = true
+	}
+
+	return nil
+}
+
+func savedViewLimit(limit int) int {
+	if limit == 0 {
+		return defaultSavedViewLimit
+	}
+
+	return limit
+}
+
+func toSavedViewFiltersModel(filter dtos.TransactionsFilterDTO) model.SavedViewFiltersModel {
+	return model.SavedViewFiltersModel{
+		Q:             strings.TrimSpace(filter.Q),
+		Filter:        filter.Filter,
+		Title:         filter.Title,
+		Category:      filter.Category,
+		Type:          filter.Type,
+		Currency:      filter.Currency,
+		PaymentMethod: filter.PaymentMethod,
+		From:          filter.From,
+		To:            filter.To,
+		MinAmount:     filter.MinAmount.String(),
+		MaxAmount:     filter.MaxAmount.String(),
+		Sort:          filter.Sort,
+	}
+}
+
+func toTransactionsFilterDTO(filters model.SavedViewFiltersModel) dtos.TransactionsFilterDTO {
+	return dtos.TransactionsFilterDTO{
+		Q:             filters.Q,
+		Filter:        filters.Filter,
+		Title:         filters.Title,
+		Category:      filters.Category,
+		Type:          filters.Type,
+		Currency:      filters.Currency,
+		PaymentMethod: filters.PaymentMethod,
+		From:          filters.From,
+		To:            filters.To,
+		MinAmount:     json.Number(filters.MinAmount),
+		MaxAmount:     json.Number(filters.MaxAmount),
+		Sort:          filters.Sort,
+	}
+}
+
+func toSavedViewResponseDTO(view *model.SavedViewModel, layout string) dtos.SavedViewResponseDTO {
+	// Views keep from and to as the client sent them, in any format dates.Parse
+	// accepts, and show them in the layout of the request.
+	filters := toTransactionsFilterDTO(view.Filters)
+	filters.From = dates.Format(filters.From, layout)
+	filters.To = dates.Format(filters.To, layout)
+
+	return dtos.SavedViewResponseDTO{
+		ID:        view.ID.Hex(),
+		Name:      view.Name,
+		Filters:   filters,
+		Limit:     view.Limit,
+		Skip:      view.Skip,
+		CreatedAt: view.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: view.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dates"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MockSavedViewsRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavedViewsRepository) Create(view *model.SavedViewModel) (*model.SavedViewModel, error) {
+	args := m.Called(view)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SavedViewModel), args.Error(1)
+}
+
+func (m *MockSavedViewsRepository) GetAll() ([]*model.SavedViewModel, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.SavedViewModel), args.Error(1)
+}
+
+func (m *MockSavedViewsRepository) GetByID(id string) (*model.SavedViewModel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SavedViewModel), args.Error(1)
+}
+
+func (m *MockSavedViewsRepository) Update(id string, view *model.SavedViewModel) (*model.SavedViewModel, error) {
+	args := m.Called(id, view)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SavedViewModel), args.Error(1)
+}
+
+func (m *MockSavedViewsRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+type MockTransactionsService struct {
+	mock.Mock
+}
+
+func (m *MockTransactionsService) CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(entry, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) GetAllTransactionsEntries(limit, skip int, filter dtos.TransactionsFilterDTO, layout string) (dtos.TransactionsPageDTO, error) {
+	args := m.Called(limit, skip, filter, layout)
+	return args.Get(0).(dtos.TransactionsPageDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) DeleteTransactionsEntry(id string, ifMatch dtos.IfMatchDTO) error {
+	args := m.Called(id, ifMatch)
+	return args.Error(0)
+}
+
+func (m *MockTransactionsService) UpdateTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, entry dtos.UpdateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, ifMatch, entry, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) PatchTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, patch dtos.PatchTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, ifMatch, patch, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) BatchTransactionsEntries(batch dtos.BatchTransactionsDTO, layout string) (dtos.BatchTransactionsResponseDTO, error) {
+	args := m.Called(batch, layout)
+	return args.Get(0).(dtos.BatchTransactionsResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) GetTransactionsEntryByID(id string, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.TransactionsEntryResponseDTO), args.Error(1)
+}
+
+func (m *MockTransactionsService) GetTransactionDashboardData(query dtos.TransactionDashboardQueryDTO, layout string) (dtos.TransactionDashboardResponseDTO, error) {
+	args := m.Called(query, layout)
+	return args.Get(0).(dtos.TransactionDashboardResponseDTO), args.Error(1)
+}
+
+func TestSavedViewsServiceCreateSavedViewSuccess(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	objectID := primitive.NewObjectID()
+	now := time.Now()
+
+	mockRepo.On("Create", mock.MatchedBy(func(view *model.SavedViewModel) bool {
+		return view.Name == "Food" && view.Limit == 10 && view.Filters.Category == "food" && view.Filters.MinAmount == "10.50"
+	})).Return(&model.SavedViewModel{
+		ID:        objectID,
+		Name:      "Food",
+		Filters:   model.SavedViewFiltersModel{Category: "food", MinAmount: "10.50"},
+		Limit:     10,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil)
+
+	result, err := service.CreateSavedView(dtos.CreateSavedViewDTO{
+		Name:    " Food ",
+		Filters: dtos.TransactionsFilterDTO{Category: "food", MinAmount: json.Number("10.50")},
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, objectID.Hex(), result.ID)
+	assert.Equal(t, 10, result.Limit)
+	assert.Equal(t, json.Number("10.50"), result.Filters.MinAmount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSavedViewsServiceGetSavedViewByIDFormatsDates(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{
+		ID:      objectID,
+		Name:    "September",
+		Filters: model.SavedViewFiltersModel{From: "01/09/2025", To: "2025-09-30"},
+	}, nil)
+
+	result, err := service.GetSavedViewByID(objectID.Hex(), dates.ISOLayout)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-09-01", result.Filters.From)
+	assert.Equal(t, "2025-09-30", result.Filters.To)
+}
+
+func TestSavedViewsServiceCreateSavedViewInvalidFilter(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	result, err := service.CreateSavedView(dtos.CreateSavedViewDTO{
+		Name:    "Food",
+		Filters: dtos.TransactionsFilterDTO{Filter: "password = x"},
+	}, DateFormat)
+
+	assert.ErrorIs(t, err, filterql.ErrInvalidExpression)
+	assert.Equal(t, dtos.SavedViewResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestSavedViewsServiceCreateSavedViewInvalidFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters dtos.TransactionsFilterDTO
+		err     error
+	}{
+		{name: "from_after_to", filters: dtos.TransactionsFilterDTO{From: "2025-09-10", To: "2025-09-01"}, err: ErrInvalidDateRange},
+		{name: "min_above_max", filters: dtos.TransactionsFilterDTO{Currency: "BRL", MinAmount: "50", MaxAmount: "10"}, err: ErrInvalidAmountRange},
+		{name: "unknown_sort_field", filters: dtos.TransactionsFilterDTO{Sort: "-password"}, err: ErrInvalidSort},
+		{name: "repeated_sort_field", filters: dtos.TransactionsFilterDTO{Sort: "amount,-amount"}, err: ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSavedViewsRepository)
+			service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+			_, err := service.CreateSavedView(dtos.CreateSavedViewDTO{Name: "Food", Filters: tt.filters}, DateFormat)
+
+			assert.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestSavedViewsServiceUpdateSavedViewKeepsOwnName(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	objectID := primitive.NewObjectID()
+	createdAt := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	existing := &model.SavedViewModel{ID: objectID, Name: "Food", Limit: 10, CreatedAt: createdAt}
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(existing, nil)
+	mockRepo.On("Update", objectID.Hex(), mock.MatchedBy(func(view *model.SavedViewModel) bool {
+		return view.Name == "Food" && view.Limit == 50 && view.Filters.Sort == "-amount" && view.CreatedAt.Equal(createdAt)
+	})).Return(&model.SavedViewModel{ID: objectID, Name: "Food", Limit: 50, Filters: model.SavedViewFiltersModel{Sort: "-amount"}}, nil)
+
+	result, err := service.UpdateSavedView(objectID.Hex(), dtos.UpdateSavedViewDTO{
+		Name:    "Food",
+		Filters: dtos.TransactionsFilterDTO{Sort: "-amount"},
+		Limit:   50,
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50, result.Limit)
+	assert.Equal(t, "-amount", result.Filters.Sort)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSavedViewsServiceUpdateSavedViewInvalidFilter(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{ID: objectID, Name: "Food"}, nil)
+
+	_, err := service.UpdateSavedView(objectID.Hex(), dtos.UpdateSavedViewDTO{
+		Name:    "Food",
+		Filters: dtos.TransactionsFilterDTO{Filter: "amount >"},
+	}, DateFormat)
+
+	assert.ErrorIs(t, err, filterql.ErrInvalidExpression)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSavedViewsServiceUpdateSavedViewInvalidDateRange(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{ID: objectID, Name: "Food"}, nil)
+
+	_, err := service.UpdateSavedView(objectID.Hex(), dtos.UpdateSavedViewDTO{
+		Name:    "Food",
+		Filters: dtos.TransactionsFilterDTO{From: "2025-09-10", To: "2025-09-01", Sort: "date"},
+	}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSavedViewsServiceGetAllSavedViewsRepositoryError(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	service := NewSavedViewsService(mockRepo, new(MockTransactionsService))
+
+	expectedError := errors.New("database connection error")
+	mockRepo.On("GetAll").Return(nil, expectedError)
+
+	result, err := service.GetAllSavedViews(DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	assert.Nil(t, result)
+}
+
+func TestSavedViewsServiceGetSavedViewTransactions(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	mockTransactionsService := new(MockTransactionsService)
+	service := NewSavedViewsService(mockRepo, mockTransactionsService)
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{
+		ID:      objectID,
+		Name:    "Food",
+		Filters: model.SavedViewFiltersModel{Category: "food,bars", MaxAmount: "200"},
+		Limit:   20,
+		Skip:    40,
+	}, nil)
+
+	expectedPage := dtos.TransactionsPageDTO{Entries: []dtos.TransactionsEntryResponseDTO{{ID: "1"}}, Total: 41}
+	mockTransactionsService.On("GetAllTransactionsEntries", 20, 40, dtos.TransactionsFilterDTO{
+		Category:  "food,bars",
+		MaxAmount: json.Number("200"),
+	}, DateFormat).Return(expectedPage, nil)
+
+	result, err := service.GetSavedViewTransactions(objectID.Hex(), "", DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Food", result.View.Name)
+	assert.Equal(t, expectedPage, result.Page)
+	mockTransactionsService.AssertExpectations(t)
+}
+
+func TestSavedViewsServiceGetSavedViewTransactionsWithCursor(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	mockTransactionsService := new(MockTransactionsService)
+	service := NewSavedViewsService(mockRepo, mockTransactionsService)
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{ID: objectID, Name: "Food", Limit: 20, Skip: 40}, nil)
+	mockTransactionsService.On("GetAllTransactionsEntries", 20, 0, dtos.TransactionsFilterDTO{Cursor: "abc"}, DateFormat).Return(dtos.TransactionsPageDTO{}, nil)
+
+	_, err := service.GetSavedViewTransactions(objectID.Hex(), "abc", DateFormat)
+
+	assert.NoError(t, err)
+	mockTransactionsService.AssertExpectations(t)
+}
+
+func TestSavedViewsServiceGetSavedViewTransactionsCursorWithSort(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	mockTransactionsService := new(MockTransactionsService)
+	service := NewSavedViewsService(mockRepo, mockTransactionsService)
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.SavedViewModel{
+		ID:      objectID,
+		Filters: model.SavedViewFiltersModel{Sort: "-amount"},
+		Limit:   20,
+	}, nil)
+
+	_, err := service.GetSavedViewTransactions(objectID.Hex(), "abc", DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	mockTransactionsService.AssertNotCalled(t, "GetAllTransactionsEntries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSavedViewsServiceGetSavedViewTransactionsNotFound(t *testing.T) {
+	mockRepo := new(MockSavedViewsRepository)
+	mockTransactionsService := new(MockTransactionsService)
+	service := NewSavedViewsService(mockRepo, mockTransactionsService)
+
+	expectedError := repository.ErrSavedViewNotFound
+	mockRepo.On("GetByID", "missing").Return(nil, expectedError)
+
+	_, err := service.GetSavedViewTransactions("missing", "", DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	mockTransactionsService.AssertNotCalled(t, "GetAllTransactionsEntries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,77 +1,90 @@
 package services
 
 import (
-	"math"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
 	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
+	"myfin-api/internal/i18n"
 	"myfin-api/internal/model"
+	"myfin-api/internal/money"
 	"myfin-api/internal/repository"
 	"myfin-api/internal/repository/types"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-const DateFormat = "02/01/2006"
+const DateFormat = dates.DefaultLayout
+
+const (
+	periodThisMonth  = "this-month"
+	periodLastMonth  = "last-month"
+	periodYearToDate = "ytd"
+)
+
+var (
+	ErrInvalidDateRange   = domain.NewError(domain.ErrValidation, i18n.InvalidDateRange)
+	ErrInvalidAmountRange = domain.NewError(domain.ErrValidation, i18n.InvalidAmountRange)
+	ErrInvalidSort        = domain.NewError(domain.ErrValidation, i18n.InvalidSort)
+	ErrInvalidCursor      = domain.NewError(domain.ErrValidation, i18n.InvalidCursor)
+	ErrAmountRequired     = domain.NewError(domain.ErrValidation, i18n.AmountRequired)
+	ErrInvalidOperation   = domain.NewError(domain.ErrValidation, i18n.InvalidBatchOperation)
+	ErrBatchAborted       = domain.NewError(domain.ErrConflict, i18n.BatchAborted)
+	ErrVersionMismatch    = repository.ErrVersionMismatch
+)
 
 type TransactionsService interface {
-	CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error)
-	GetAllTransactionsEntries(limit, skip int, titleFilter, categoryFilter string) ([]dtos.TransactionsEntryResponseDTO, error)
-	DeleteTransactionsEntry(id string) error
-	UpdateTransactionsEntry(id string, entry dtos.UpdateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error)
-	GetTransactionsEntryByID(id string) (dtos.TransactionsEntryResponseDTO, error)
-	GetTransactionDashboardData() (dtos.TransactionDashboardResponseDTO, error)
+	CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error)
+	GetAllTransactionsEntries(limit, skip int, filter dtos.TransactionsFilterDTO, layout string) (dtos.TransactionsPageDTO, error)
+	DeleteTransactionsEntry(id string, ifMatch dtos.IfMatchDTO) error
+	UpdateTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, entry dtos.UpdateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error)
+	PatchTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, patch dtos.PatchTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error)
+	BatchTransactionsEntries(batch dtos.BatchTransactionsDTO, layout string) (dtos.BatchTransactionsResponseDTO, error)
+	GetTransactionsEntryByID(id, layout string) (dtos.TransactionsEntryResponseDTO, error)
+	GetTransactionDashboardData(query dtos.TransactionDashboardQueryDTO, layout string) (dtos.TransactionDashboardResponseDTO, error)
 }
 
 type transactionsService struct {
-	transactionsRepo repository.TransactionsEntryRepository
+	transactionsRepo  repository.TransactionsEntryRepository
+	accountsRepo      repository.AccountsRepository
+	exchangeRatesRepo repository.ExchangeRatesRepository
+	now               func() time.Time
 }
 
-func NewTransactionsService(transactionsRepo repository.TransactionsEntryRepository) TransactionsService {
+func NewTransactionsService(transactionsRepo repository.TransactionsEntryRepository, accountsRepo repository.AccountsRepository, exchangeRatesRepo repository.ExchangeRatesRepository) TransactionsService {
 	return &transactionsService{
-		transactionsRepo: transactionsRepo,
+		transactionsRepo:  transactionsRepo,
+		accountsRepo:      accountsRepo,
+		exchangeRatesRepo: exchangeRatesRepo,
+		now:               time.Now,
 	}
 }
 
-func (s *transactionsService) CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error) {
-	parsedDate, err := time.Parse(DateFormat, entry.Date)
+func (s *transactionsService) CreateTransactionsEntry(entry dtos.CreateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	transactionsEntry, err := s.newTransactionsEntry(entry)
 	if err != nil {
 		return dtos.TransactionsEntryResponseDTO{}, err
 	}
 
-	transactionsEntry := &model.TransactionsEntryModel{
-		Amount:        entry.Amount,
-		Title:         entry.Title,
-		Currency:      entry.Currency,
-		Type:          entry.Type,
-		Category:      entry.Category,
-		PaymentMethod: entry.PaymentMethod,
-		Description:   entry.Description,
-		Date:          parsedDate,
-	}
-
 	createdEntry, err := s.transactionsRepo.Create(transactionsEntry)
 	if err != nil {
 		return dtos.TransactionsEntryResponseDTO{}, err
 	}
 
-	response := dtos.TransactionsEntryResponseDTO{
-		ID:            createdEntry.ID.Hex(),
-		Amount:        createdEntry.Amount,
-		Title:         createdEntry.Title,
-		Currency:      createdEntry.Currency,
-		Type:          createdEntry.Type,
-		Category:      createdEntry.Category,
-		PaymentMethod: createdEntry.PaymentMethod,
-		Description:   createdEntry.Description,
-		Date:          createdEntry.Date.Format(DateFormat),
-		Timestamp:     createdEntry.Timestamp,
-		CreatedAt:     createdEntry.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:     createdEntry.UpdatedAt.UTC().Format(time.RFC3339),
-	}
-
-	return response, nil
+	return toTransactionsEntryResponseDTO(createdEntry, layout), nil
 }
 
-func (s *transactionsService) GetAllTransactionsEntries(limit, skip int, titleFilter, categoryFilter string) ([]dtos.TransactionsEntryResponseDTO, error) {
+func (s *transactionsService) GetAllTransactionsEntries(limit, skip int, filter dtos.TransactionsFilterDTO, layout string) (dtos.TransactionsPageDTO, error) {
 	if limit < 0 {
 		limit = 10
 	}
@@ -84,61 +97,404 @@ func (s *transactionsService) GetAllTransactionsEntries(limit, skip int, titleFi
 		skip = 0
 	}
 
-	var entries []*model.TransactionsEntryModel
-	var err error
+	filterOptions, err := buildFilterOptions(filter)
+	if err != nil {
+		return dtos.TransactionsPageDTO{}, err
+	}
 
-	if titleFilter != "" || categoryFilter != "" {
-		filter := types.FilterOptions{
-			Title:    titleFilter,
-			Category: categoryFilter,
+	if filter.Cursor != "" {
+		filterOptions.Cursor, err = decodeCursor(filter.Cursor)
+		if err != nil {
+			return dtos.TransactionsPageDTO{}, err
 		}
-		entries, err = s.transactionsRepo.GetAllWithFilter(limit, skip, filter)
+	}
+
+	fetchLimit := limit
+	if limit > 0 {
+		fetchLimit = limit + 1
+	}
+
+	var entries []*model.TransactionsEntryModel
+
+	if hasFilters(filterOptions) || len(filterOptions.Sort) > 0 || filterOptions.Cursor != nil {
+		entries, err = s.transactionsRepo.GetAllWithFilter(fetchLimit, skip, filterOptions)
 	} else {
-		entries, err = s.transactionsRepo.GetAll(limit, skip)
+		entries, err = s.transactionsRepo.GetAll(fetchLimit, skip)
 	}
 
 	if err != nil {
-		return nil, err
+		return dtos.TransactionsPageDTO{}, err
+	}
+
+	backward := filterOptions.Cursor != nil && filterOptions.Cursor.Backward
+	hasMore := limit > 0 && len(entries) > limit
+
+	if hasMore && backward {
+		entries = entries[len(entries)-limit:]
+	} else if hasMore {
+		entries = entries[:limit]
+	}
+
+	total, err := s.transactionsRepo.Count(filterOptions)
+	if err != nil {
+		return dtos.TransactionsPageDTO{}, err
+	}
+
+	page := dtos.TransactionsPageDTO{
+		Entries: make([]dtos.TransactionsEntryResponseDTO, 0, len(entries)),
+		Total:   total,
 	}
 
-	response := make([]dtos.TransactionsEntryResponseDTO, 0, len(entries))
 	for _, entry := range entries {
-		response = append(response, dtos.TransactionsEntryResponseDTO{
-			ID:            entry.ID.Hex(),
-			Amount:        entry.Amount,
-			Title:         entry.Title,
-			Currency:      entry.Currency,
-			Type:          entry.Type,
-			Category:      entry.Category,
-			PaymentMethod: entry.PaymentMethod,
-			Description:   entry.Description,
-			Date:          entry.Date.Format(DateFormat),
-			Timestamp:     entry.Timestamp,
-			CreatedAt:     entry.CreatedAt.UTC().Format(time.RFC3339),
-			UpdatedAt:     entry.UpdatedAt.UTC().Format(time.RFC3339),
-		})
+		page.Entries = append(page.Entries, toTransactionsEntryResponseDTO(entry, layout))
 	}
 
-	return response, nil
+	if len(entries) == 0 || len(filterOptions.Sort) > 0 || filterOptions.Search != "" {
+		return page, nil
+	}
+
+	first, last := entries[0], entries[len(entries)-1]
+
+	if (backward && hasMore) || (!backward && (filterOptions.Cursor != nil || skip > 0)) {
+		page.Prev = encodeCursor(types.Cursor{Date: first.Date, ID: first.ID, Backward: true})
+	}
+
+	if backward || hasMore {
+		page.Next = encodeCursor(types.Cursor{Date: last.Date, ID: last.ID})
+	}
+
+	return page, nil
 }
 
-func (s *transactionsService) DeleteTransactionsEntry(id string) error {
-	return s.transactionsRepo.Delete(id)
+func (s *transactionsService) DeleteTransactionsEntry(id string, ifMatch dtos.IfMatchDTO) error {
+	entry, err := s.transactionsRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if !ifMatch.Matches(entry.Version) {
+		return ErrVersionMismatch
+	}
+
+	if entry.Type == transferType {
+		linked, err := s.transactionsRepo.GetByID(entry.LinkedTransactionID.Hex())
+		if err != nil {
+			return err
+		}
+
+		return s.transactionsRepo.DeleteTransfer(entry, linked)
+	}
+
+	return s.transactionsRepo.Delete(id, entry.Version)
 }
 
-func (s *transactionsService) UpdateTransactionsEntry(id string, entry dtos.UpdateTransactionsEntryDTO) (dtos.TransactionsEntryResponseDTO, error) {
-	parsedDate, err := time.Parse(DateFormat, entry.Date)
+func (s *transactionsService) UpdateTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, entry dtos.UpdateTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	transactionsEntry, err := s.updatedTransactionsEntry(id, ifMatch, entry)
+	if err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, err
+	}
+
+	updatedEntry, err := s.transactionsRepo.Update(id, transactionsEntry)
 	if err != nil {
 		return dtos.TransactionsEntryResponseDTO{}, err
 	}
 
+	return toTransactionsEntryResponseDTO(updatedEntry, layout), nil
+}
+
+func (s *transactionsService) PatchTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, patch dtos.PatchTransactionsEntryDTO, layout string) (dtos.TransactionsEntryResponseDTO, error) {
 	existingEntry, err := s.transactionsRepo.GetByID(id)
 	if err != nil {
 		return dtos.TransactionsEntryResponseDTO{}, err
 	}
 
-	transactionsEntry := &model.TransactionsEntryModel{
-		Amount:        entry.Amount,
+	if existingEntry.Type == transferType {
+		return dtos.TransactionsEntryResponseDTO{}, ErrTransferLeg
+	}
+
+	if !ifMatch.Matches(existingEntry.Version) {
+		return dtos.TransactionsEntryResponseDTO{}, ErrVersionMismatch
+	}
+
+	entryPatch := types.TransactionPatch{
+		Version:       existingEntry.Version,
+		Title:         patch.Title,
+		Currency:      patch.Currency,
+		Type:          patch.Type,
+		Category:      patch.Category,
+		PaymentMethod: patch.PaymentMethod,
+		Description:   patch.Description,
+	}
+
+	currency := existingEntry.Currency
+	if patch.Currency != nil {
+		currency = *patch.Currency
+	}
+
+	if patch.Amount != nil {
+		amount, err := parseAmount(*patch.Amount, currency)
+		if err != nil {
+			return dtos.TransactionsEntryResponseDTO{}, err
+		}
+		entryPatch.Amount = &amount
+	} else if money.Decimals(currency) != money.Decimals(existingEntry.Currency) {
+		return dtos.TransactionsEntryResponseDTO{}, ErrAmountRequired
+	}
+
+	if patch.Date != nil {
+		parsedDate, err := dates.Parse(*patch.Date)
+		if err != nil {
+			return dtos.TransactionsEntryResponseDTO{}, err
+		}
+		entryPatch.Date = &parsedDate
+	}
+
+	if patch.AccountID != nil {
+		accountID, err := s.resolveAccountID(*patch.AccountID, currency)
+		if err != nil {
+			return dtos.TransactionsEntryResponseDTO{}, err
+		}
+		entryPatch.AccountID = &accountID
+	} else if patch.Currency != nil && !existingEntry.AccountID.IsZero() && !slices.Contains(patch.Remove, "accountId") {
+		if _, err := s.resolveAccountID(existingEntry.AccountID.Hex(), currency); err != nil {
+			return dtos.TransactionsEntryResponseDTO{}, err
+		}
+	}
+
+	for _, field := range patch.Remove {
+		switch field {
+		case "description":
+			entryPatch.RemoveDescription = true
+		case "accountId":
+			entryPatch.RemoveAccountID = true
+		}
+	}
+
+	patchedEntry, err := s.transactionsRepo.Patch(id, entryPatch)
+	if err != nil {
+		return dtos.TransactionsEntryResponseDTO{}, err
+	}
+
+	return toTransactionsEntryResponseDTO(patchedEntry, layout), nil
+}
+
+func (s *transactionsService) BatchTransactionsEntries(batch dtos.BatchTransactionsDTO, layout string) (dtos.BatchTransactionsResponseDTO, error) {
+	response := dtos.BatchTransactionsResponseDTO{
+		Atomic:  batch.Atomic,
+		Results: make([]dtos.BatchTransactionResultDTO, len(batch.Operations)),
+	}
+
+	for i, operation := range batch.Operations {
+		response.Results[i] = dtos.BatchTransactionResultDTO{Index: i, Op: operation.Op, ID: operation.ID}
+		if operation.ValidationErrors != nil {
+			response.Results[i].Err = ErrInvalidOperation
+			response.Results[i].ValidationErrors = operation.ValidationErrors
+		}
+	}
+
+	if batch.Atomic {
+		if err := s.applyAtomicBatch(batch.Operations, response.Results, layout); err != nil {
+			return dtos.BatchTransactionsResponseDTO{}, err
+		}
+	} else {
+		for i, operation := range batch.Operations {
+			if response.Results[i].Err == nil {
+				s.applyBatchOperation(operation, &response.Results[i], layout)
+			}
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Err != nil {
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+	}
+
+	return response, nil
+}
+
+func (s *transactionsService) applyBatchOperation(operation dtos.BatchTransactionOperationDTO, result *dtos.BatchTransactionResultDTO, layout string) {
+	var entry dtos.TransactionsEntryResponseDTO
+	var err error
+
+	switch operation.Op {
+	case types.TransactionWriteCreate:
+		entry, err = s.CreateTransactionsEntry(*operation.Create, layout)
+	case types.TransactionWriteUpdate:
+		entry, err = s.UpdateTransactionsEntry(operation.ID, dtos.IfMatchVersion(operation.Version), *operation.Update, layout)
+	case types.TransactionWriteDelete:
+		result.Err = s.DeleteTransactionsEntry(operation.ID, dtos.IfMatchVersion(operation.Version))
+		return
+	}
+
+	if err != nil {
+		result.Err = err
+		return
+	}
+
+	result.ID = entry.ID
+	result.Data = &entry
+}
+
+// applyAtomicBatch resolves every operation before writing anything, so a bad
+// item aborts the batch early, and then applies all writes in a single Mongo
+// transaction. An error is returned only when the failure cannot
+// be attributed to an operation.
+func (s *transactionsService) applyAtomicBatch(operations []dtos.BatchTransactionOperationDTO, results []dtos.BatchTransactionResultDTO, layout string) error {
+	if abortBatch(results) {
+		return nil
+	}
+
+	writes := make([]types.TransactionWrite, len(operations))
+	for i, operation := range operations {
+		write, err := s.batchOperationWrite(operation)
+		if err != nil {
+			results[i].Err = err
+			abortBatch(results)
+			return nil
+		}
+
+		writes[i] = write
+	}
+
+	if err := s.transactionsRepo.ApplyWrites(writes); err != nil {
+		var writeErr *types.TransactionWriteError
+		if !errors.As(err, &writeErr) || writeErr.Index >= len(results) {
+			return err
+		}
+
+		results[writeErr.Index].Err = writeErr.Err
+		abortBatch(results)
+		return nil
+	}
+
+	for i, write := range writes {
+		results[i].ID = write.Entry.ID.Hex()
+		if write.Op != types.TransactionWriteDelete {
+			entry := toTransactionsEntryResponseDTO(write.Entry, layout)
+			results[i].Data = &entry
+		}
+	}
+
+	return nil
+}
+
+func (s *transactionsService) batchOperationWrite(operation dtos.BatchTransactionOperationDTO) (types.TransactionWrite, error) {
+	switch operation.Op {
+	case types.TransactionWriteCreate:
+		entry, err := s.newTransactionsEntry(*operation.Create)
+		return types.TransactionWrite{Op: operation.Op, Entry: entry}, err
+	case types.TransactionWriteUpdate:
+		entry, err := s.updatedTransactionsEntry(operation.ID, dtos.IfMatchVersion(operation.Version), *operation.Update)
+		if err != nil {
+			return types.TransactionWrite{}, err
+		}
+
+		entry.ID, err = primitive.ObjectIDFromHex(operation.ID)
+		return types.TransactionWrite{Op: operation.Op, Entry: entry}, err
+	default:
+		entry, err := s.transactionsRepo.GetByID(operation.ID)
+		if err != nil {
+			return types.TransactionWrite{}, err
+		}
+
+		if entry.Version != operation.Version {
+			return types.TransactionWrite{}, ErrVersionMismatch
+		}
+
+		write := types.TransactionWrite{Op: operation.Op, Entry: entry}
+		if entry.Type == transferType {
+			write.Linked, err = s.transactionsRepo.GetByID(entry.LinkedTransactionID.Hex())
+		}
+
+		return write, err
+	}
+}
+
+// abortBatch marks every operation that has not failed on its own as aborted
+// and reports whether any operation had failed.
+func abortBatch(results []dtos.BatchTransactionResultDTO) bool {
+	failed := false
+	for _, result := range results {
+		if result.Err != nil {
+			failed = true
+			break
+		}
+	}
+
+	if failed {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = ErrBatchAborted
+			}
+		}
+	}
+
+	return failed
+}
+
+func (s *transactionsService) newTransactionsEntry(entry dtos.CreateTransactionsEntryDTO) (*model.TransactionsEntryModel, error) {
+	parsedDate, err := dates.Parse(entry.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseAmount(entry.Amount, entry.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := s.resolveAccountID(entry.AccountID, entry.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TransactionsEntryModel{
+		Amount:        amount,
+		Title:         entry.Title,
+		Currency:      entry.Currency,
+		Type:          entry.Type,
+		Category:      entry.Category,
+		PaymentMethod: entry.PaymentMethod,
+		Description:   entry.Description,
+		Date:          parsedDate,
+		AccountID:     accountID,
+	}, nil
+}
+
+func (s *transactionsService) updatedTransactionsEntry(id string, ifMatch dtos.IfMatchDTO, entry dtos.UpdateTransactionsEntryDTO) (*model.TransactionsEntryModel, error) {
+	parsedDate, err := dates.Parse(entry.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	existingEntry, err := s.transactionsRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingEntry.Type == transferType {
+		return nil, ErrTransferLeg
+	}
+
+	if !ifMatch.Matches(existingEntry.Version) {
+		return nil, ErrVersionMismatch
+	}
+
+	amount, err := parseAmount(entry.Amount, entry.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := s.resolveAccountID(entry.AccountID, entry.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TransactionsEntryModel{
+		Amount:        amount,
 		Title:         entry.Title,
 		Currency:      entry.Currency,
 		Type:          entry.Type,
@@ -146,82 +502,435 @@ func (s *transactionsService) UpdateTransactionsEntry(id string, entry dtos.Upda
 		PaymentMethod: entry.PaymentMethod,
 		Description:   entry.Description,
 		Date:          parsedDate,
+		AccountID:     accountID,
 		Timestamp:     existingEntry.Timestamp,
 		CreatedAt:     existingEntry.CreatedAt,
-	}
+		Version:       existingEntry.Version,
+	}, nil
+}
 
-	updatedEntry, err := s.transactionsRepo.Update(id, transactionsEntry)
+func (s *transactionsService) GetTransactionsEntryByID(id, layout string) (dtos.TransactionsEntryResponseDTO, error) {
+	entry, err := s.transactionsRepo.GetByID(id)
 	if err != nil {
 		return dtos.TransactionsEntryResponseDTO{}, err
 	}
 
-	response := dtos.TransactionsEntryResponseDTO{
-		ID:            updatedEntry.ID.Hex(),
-		Amount:        updatedEntry.Amount,
-		Title:         updatedEntry.Title,
-		Currency:      updatedEntry.Currency,
-		Type:          updatedEntry.Type,
-		Category:      updatedEntry.Category,
-		PaymentMethod: updatedEntry.PaymentMethod,
-		Description:   updatedEntry.Description,
-		Date:          updatedEntry.Date.Format(DateFormat),
-		Timestamp:     updatedEntry.Timestamp,
-		CreatedAt:     updatedEntry.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:     updatedEntry.UpdatedAt.UTC().Format(time.RFC3339),
+	return toTransactionsEntryResponseDTO(entry, layout), nil
+}
+
+func (s *transactionsService) GetTransactionDashboardData(query dtos.TransactionDashboardQueryDTO, layout string) (dtos.TransactionDashboardResponseDTO, error) {
+	dateRange, err := resolveDashboardRange(query, s.now())
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	currencyTotals, err := s.transactionsRepo.GetTotalsByCurrency(dateRange, nil)
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	totals := summarizeByCurrency(currencyTotals)
+
+	accountTotals, err := s.transactionsRepo.GetAccountTotals(dateRange.To)
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	accounts, err := s.accountsRepo.GetAll()
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	accountBalances := make([]dtos.AccountBalanceResponseDTO, 0, len(accounts))
+	for _, account := range accounts {
+		accountBalances = append(accountBalances, calculateAccountBalance(account, accountTotals))
+	}
+
+	response := dtos.TransactionDashboardResponseDTO{
+		Totals:   make([]dtos.DashboardCurrencyTotalDTO, 0, len(totals)),
+		Accounts: accountBalances,
+	}
+
+	response.From, response.To = formatDateRange(dateRange, layout)
+
+	for _, total := range totals {
+		response.Totals = append(response.Totals, dtos.DashboardCurrencyTotalDTO{
+			Currency:      total.currency,
+			IncomeAmount:  money.ToNumber(total.income, total.currency),
+			ExpenseAmount: money.ToNumber(total.expense, total.currency),
+			TotalAmount:   money.ToNumber(total.income-total.expense, total.currency),
+		})
+	}
+
+	if query.Currency == "" {
+		return response, nil
+	}
+
+	exchangeRates, err := s.exchangeRatesRepo.GetAll()
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	rates, err := newExchangeRateTable(exchangeRates)
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	currency := strings.ToUpper(query.Currency)
+	convertedIncome, convertedExpense := new(big.Rat), new(big.Rat)
+
+	for _, total := range totals {
+		rate, err := rates.rate(total.currency, currency)
+		if err != nil {
+			return dtos.TransactionDashboardResponseDTO{}, err
+		}
+
+		convertedIncome.Add(convertedIncome, money.Convert(total.income, total.currency, currency, rate))
+		convertedExpense.Add(convertedExpense, money.Convert(total.expense, total.currency, currency, rate))
+	}
+
+	incomeTotal, err := money.Round(convertedIncome)
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
+	}
+
+	expenseTotal, err := money.Round(convertedExpense)
+	if err != nil {
+		return dtos.TransactionDashboardResponseDTO{}, err
 	}
 
+	response.Currency = currency
+	response.IncomeAmount = money.ToNumber(incomeTotal, currency)
+	response.ExpenseAmount = money.ToNumber(expenseTotal, currency)
+	response.TotalAmount = money.ToNumber(incomeTotal-expenseTotal, currency)
+
 	return response, nil
 }
 
-func (s *transactionsService) GetTransactionsEntryByID(id string) (dtos.TransactionsEntryResponseDTO, error) {
-	entry, err := s.transactionsRepo.GetByID(id)
+type currencyTotal struct {
+	currency string
+	income   int64
+	expense  int64
+}
+
+func summarizeByCurrency(currencyTotals []*types.CurrencyTotal) []*currencyTotal {
+	totalsByCurrency := make(map[string]*currencyTotal)
+
+	for _, row := range currencyTotals {
+		currency := strings.ToUpper(row.Currency)
+
+		total, ok := totalsByCurrency[currency]
+		if !ok {
+			total = &currencyTotal{currency: currency}
+			totalsByCurrency[currency] = total
+		}
+
+		switch row.Type {
+		case "income":
+			total.income += row.Total
+		case "expense":
+			total.expense += row.Total
+		}
+	}
+
+	totals := make([]*currencyTotal, 0, len(totalsByCurrency))
+	for _, total := range totalsByCurrency {
+		totals = append(totals, total)
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].currency < totals[j].currency
+	})
+
+	return totals
+}
+
+func buildFilterOptions(filter dtos.TransactionsFilterDTO) (types.FilterOptions, error) {
+	dateRange, err := parseDateRange(filter.From, filter.To)
 	if err != nil {
-		return dtos.TransactionsEntryResponseDTO{}, err
+		return types.FilterOptions{}, err
+	}
+
+	expression, err := compileFilter(filter.Filter)
+	if err != nil {
+		return types.FilterOptions{}, err
+	}
+
+	filterOptions := types.FilterOptions{
+		Expression:    expression,
+		Search:        strings.TrimSpace(filter.Q),
+		Title:         filter.Title,
+		Type:          filter.Type,
+		Currency:      filter.Currency,
+		PaymentMethod: filter.PaymentMethod,
+		DateRange:     dateRange,
+	}
+
+	for _, category := range strings.Split(filter.Category, ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			filterOptions.Categories = append(filterOptions.Categories, category)
+		}
+	}
+
+	if err := applyAmountBounds(&filterOptions, filter); err != nil {
+		return types.FilterOptions{}, err
+	}
+
+	filterOptions.Sort = parseTransactionsSort(filter.Sort)
+
+	return filterOptions, nil
+}
+
+// applyAmountBounds filters by minAmount and maxAmount. With a currency the
+// bounds are that currency's minor units; without one they are compared with
+// each currency's own minor units, the way amount comparisons in filter are.
+func applyAmountBounds(filterOptions *types.FilterOptions, filter dtos.TransactionsFilterDTO) error {
+	if filter.MinAmount == "" && filter.MaxAmount == "" {
+		return nil
+	}
+
+	decimals := money.MaxDecimals
+	if filter.Currency != "" {
+		decimals = money.Decimals(filter.Currency)
+	}
+
+	minAmount, err := parseAmountBound(filter.MinAmount, decimals)
+	if err != nil {
+		return err
+	}
+
+	maxAmount, err := parseAmountBound(filter.MaxAmount, decimals)
+	if err != nil {
+		return err
+	}
+
+	if minAmount != nil && maxAmount != nil && *minAmount > *maxAmount {
+		return ErrInvalidAmountRange
+	}
+
+	if filter.Currency != "" {
+		filterOptions.MinAmount, filterOptions.MaxAmount = minAmount, maxAmount
+		return nil
+	}
+
+	amountRange, err := filterql.CompileMoneyRange(repository.TransactionFilterFields["amount"], filter.MinAmount.String(), filter.MaxAmount.String())
+	if err != nil {
+		return err
+	}
+
+	if filterOptions.Expression == nil {
+		filterOptions.Expression = amountRange
+	} else {
+		filterOptions.Expression = bson.M{"$and": bson.A{filterOptions.Expression, amountRange}}
+	}
+
+	return nil
+}
+
+func parseAmountBound(value json.Number, decimals int) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := money.ParseWithDecimals(value.String(), decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &amount, nil
+}
+
+func parseTransactionsSort(sort string) []types.SortField {
+	var sortFields []types.SortField
+
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimSpace(field)
+		descending := strings.HasPrefix(field, "-")
+
+		if column, ok := dtos.TransactionSortFields[strings.TrimPrefix(field, "-")]; ok {
+			sortFields = append(sortFields, types.SortField{Field: column, Descending: descending})
+		}
+	}
+
+	return sortFields
+}
+
+type cursorToken struct {
+	Date     time.Time `json:"d"`
+	ID       string    `json:"id"`
+	Backward bool      `json:"b,omitempty"`
+}
+
+func encodeCursor(cursor types.Cursor) string {
+	token, _ := json.Marshal(cursorToken{
+		Date:     cursor.Date.UTC(),
+		ID:       cursor.ID.Hex(),
+		Backward: cursor.Backward,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodeCursor(value string) (*types.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var token cursorToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Date.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+
+	id, err := primitive.ObjectIDFromHex(token.ID)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &types.Cursor{Date: token.Date, ID: id, Backward: token.Backward}, nil
+}
+
+func compileFilter(filter string) (bson.M, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	return filterql.Compile(filter, repository.TransactionFilterFields)
+}
+
+func hasFilters(filter types.FilterOptions) bool {
+	return filter.Expression != nil ||
+		filter.Search != "" ||
+		filter.Title != "" ||
+		len(filter.Categories) > 0 ||
+		filter.Type != "" ||
+		filter.Currency != "" ||
+		filter.PaymentMethod != "" ||
+		!filter.DateRange.From.IsZero() ||
+		!filter.DateRange.To.IsZero() ||
+		filter.MinAmount != nil ||
+		filter.MaxAmount != nil
+}
+
+func resolveDashboardRange(query dtos.TransactionDashboardQueryDTO, now time.Time) (types.DateRange, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startOfMonth := today.AddDate(0, 0, 1-today.Day())
+
+	switch query.Period {
+	case periodThisMonth:
+		return types.DateRange{From: startOfMonth, To: startOfMonth.AddDate(0, 1, 0)}, nil
+	case periodLastMonth:
+		return types.DateRange{From: startOfMonth.AddDate(0, -1, 0), To: startOfMonth}, nil
+	case periodYearToDate:
+		return types.DateRange{From: time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), To: today.AddDate(0, 0, 1)}, nil
+	}
+
+	return parseDateRange(query.From, query.To)
+}
+
+func parseDateRange(fromDate, toDate string) (types.DateRange, error) {
+	var dateRange types.DateRange
+
+	if fromDate != "" {
+		from, err := dates.Parse(fromDate)
+		if err != nil {
+			return types.DateRange{}, err
+		}
+		dateRange.From = from
+	}
+
+	if toDate != "" {
+		to, err := dates.Parse(toDate)
+		if err != nil {
+			return types.DateRange{}, err
+		}
+		dateRange.To = to.AddDate(0, 0, 1)
+	}
+
+	if !dateRange.From.IsZero() && !dateRange.To.IsZero() && !dateRange.From.Before(dateRange.To) {
+		return types.DateRange{}, ErrInvalidDateRange
+	}
+
+	return dateRange, nil
+}
+
+// formatDateRange renders a range built by parseDateRange back in layout,
+// with the inclusive end date, so responses echo dates in a single format
+// whichever one the client sent.
+func formatDateRange(dateRange types.DateRange, layout string) (string, string) {
+	var from, to string
+
+	if !dateRange.From.IsZero() {
+		from = dateRange.From.Format(layout)
+	}
+
+	if !dateRange.To.IsZero() {
+		to = dateRange.To.AddDate(0, 0, -1).Format(layout)
+	}
+
+	return from, to
+}
+
+// resolveAccountID checks that the account a transaction in currency refers
+// to exists and holds that currency, since balances add amounts up as they are.
+func (s *transactionsService) resolveAccountID(id, currency string) (primitive.ObjectID, error) {
+	if id == "" {
+		return primitive.NilObjectID, nil
+	}
+
+	account, err := s.accountsRepo.GetByID(id)
+	if err != nil {
+		return primitive.NilObjectID, referencedAccountError(err)
+	}
+
+	if !strings.EqualFold(account.Currency, currency) {
+		return primitive.NilObjectID, ErrAccountCurrencyMismatch
+	}
+
+	return account.ID, nil
+}
+
+func parseAmount(amount json.Number, currency string) (int64, error) {
+	return money.Parse(amount.String(), currency)
+}
+
+func parseOptionalAmount(amount json.Number, currency string) (int64, error) {
+	if amount == "" {
+		return 0, nil
 	}
 
+	return parseAmount(amount, currency)
+}
+
+func toTransactionsEntryResponseDTO(entry *model.TransactionsEntryModel, layout string) dtos.TransactionsEntryResponseDTO {
 	response := dtos.TransactionsEntryResponseDTO{
 		ID:            entry.ID.Hex(),
-		Amount:        entry.Amount,
+		Amount:        money.ToNumber(entry.Amount, entry.Currency),
 		Title:         entry.Title,
 		Currency:      entry.Currency,
 		Type:          entry.Type,
 		Category:      entry.Category,
 		PaymentMethod: entry.PaymentMethod,
 		Description:   entry.Description,
-		Date:          entry.Date.Format(DateFormat),
+		Date:          entry.Date.Format(layout),
 		Timestamp:     entry.Timestamp,
 		CreatedAt:     entry.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:     entry.UpdatedAt.UTC().Format(time.RFC3339),
+		Version:       entry.Version,
 	}
 
-	return response, nil
-}
-
-func (s *transactionsService) GetTransactionDashboardData() (dtos.TransactionDashboardResponseDTO, error) {
-	entries, err := s.transactionsRepo.GetTransactions()
-
-	if err != nil {
-		return dtos.TransactionDashboardResponseDTO{}, err
+	if !entry.AccountID.IsZero() {
+		response.AccountID = entry.AccountID.Hex()
 	}
 
-	var incomeTotal, expenseTotal float64
-	for _, entry := range entries {
-		switch entry.Type {
-		case "income":
-			incomeTotal += entry.Amount
-		case "expense":
-			expenseTotal += entry.Amount
-		}
+	if !entry.LinkedTransactionID.IsZero() {
+		response.TransferDirection = entry.TransferDirection
+		response.LinkedTransactionID = entry.LinkedTransactionID.Hex()
 	}
 
-	roundedIncomeAmount := math.Ceil(incomeTotal*100) / 100
-	roundedExpenseAmount := math.Ceil(expenseTotal*100) / 100
-	totalAmount := incomeTotal - expenseTotal
-	roundedTotalAmount := math.Ceil(totalAmount*100) / 100
+	if !entry.RecurringRuleID.IsZero() {
+		response.RecurringRuleID = entry.RecurringRuleID.Hex()
+	}
 
-	return dtos.TransactionDashboardResponseDTO{
-		IncomeAmount:  roundedIncomeAmount,
-		ExpenseAmount: roundedExpenseAmount,
-		TotalAmount:   roundedTotalAmount,
-	}, nil
+	return response
 }
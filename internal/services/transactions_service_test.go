@@ -1,16 +1,24 @@
 package services
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
 	"myfin-api/internal/dtos"
+	"myfin-api/internal/filterql"
 	"myfin-api/internal/model"
+	"myfin-api/internal/money"
+	"myfin-api/internal/repository"
 	"myfin-api/internal/repository/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -18,12 +26,83 @@ type MockTransactionsRepository struct {
 	mock.Mock
 }
 
-func (m *MockTransactionsRepository) GetTransactions() ([]*model.TransactionsEntryModel, error) {
-	args := m.Called()
+func (m *MockTransactionsRepository) GetTotalsByCurrency(dateRange types.DateRange, expression bson.M) ([]*types.CurrencyTotal, error) {
+	args := m.Called(dateRange, expression)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*model.TransactionsEntryModel), args.Error(1)
+	return args.Get(0).([]*types.CurrencyTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) GetAccountTotals(until time.Time) ([]*types.AccountTotal, error) {
+	args := m.Called(until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.AccountTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) GetAccountTotalsByID(accountID string) ([]*types.AccountTotal, error) {
+	args := m.Called(accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.AccountTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) GetExpensesByCategory(from, to time.Time) ([]*types.CategoryTotal, error) {
+	args := m.Called(from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.CategoryTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) GetCategoryTotals(transactionType string, dateRange types.DateRange, expression bson.M) ([]*types.CategoryTotal, error) {
+	args := m.Called(transactionType, dateRange, expression)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.CategoryTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) GetCashflowTotals(interval string, dateRange types.DateRange, expression bson.M) ([]*types.CashflowTotal, error) {
+	args := m.Called(interval, dateRange, expression)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.CashflowTotal), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) CreateRecurringOccurrence(entry *model.TransactionsEntryModel) (bool, error) {
+	args := m.Called(entry)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) EnsureIndexes() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockTransactionsRepository) CreateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error) {
+	args := m.Called(outgoing, incoming)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*model.TransactionsEntryModel), args.Get(1).(*model.TransactionsEntryModel), args.Error(2)
+}
+
+func (m *MockTransactionsRepository) UpdateTransfer(outgoing, incoming *model.TransactionsEntryModel) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error) {
+	args := m.Called(outgoing, incoming)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*model.TransactionsEntryModel), args.Get(1).(*model.TransactionsEntryModel), args.Error(2)
+}
+
+func (m *MockTransactionsRepository) DeleteTransfer(entry, linked *model.TransactionsEntryModel) error {
+	args := m.Called(entry, linked)
+	return args.Error(0)
 }
 
 func (m *MockTransactionsRepository) Create(entry *model.TransactionsEntryModel) (*model.TransactionsEntryModel, error) {
@@ -50,8 +129,13 @@ func (m *MockTransactionsRepository) GetAllWithFilter(limit, skip int, filter ty
 	return args.Get(0).([]*model.TransactionsEntryModel), args.Error(1)
 }
 
-func (m *MockTransactionsRepository) Delete(id string) error {
-	args := m.Called(id)
+func (m *MockTransactionsRepository) Count(filter types.FilterOptions) (int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) Delete(id string, version int64) error {
+	args := m.Called(id, version)
 	return args.Error(0)
 }
 
@@ -71,15 +155,29 @@ func (m *MockTransactionsRepository) Update(id string, entry *model.Transactions
 	return args.Get(0).(*model.TransactionsEntryModel), args.Error(1)
 }
 
+func (m *MockTransactionsRepository) Patch(id string, patch types.TransactionPatch) (*model.TransactionsEntryModel, error) {
+	args := m.Called(id, patch)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TransactionsEntryModel), args.Error(1)
+}
+
+func (m *MockTransactionsRepository) ApplyWrites(writes []types.TransactionWrite) error {
+	args := m.Called(writes)
+	return args.Error(0)
+}
+
 func TestTransactionsServiceDeleteTransactionsEntrySuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 
-	mockRepo.On("Delete", objectID.Hex()).Return(nil)
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense", Version: 1}, nil)
+	mockRepo.On("Delete", objectID.Hex(), int64(1)).Return(nil)
 
-	err := service.DeleteTransactionsEntry(objectID.Hex())
+	err := service.DeleteTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1))
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -87,26 +185,68 @@ func TestTransactionsServiceDeleteTransactionsEntrySuccess(t *testing.T) {
 
 func TestTransactionsServiceDeleteTransactionsEntryRepositoryError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 
 	expectedError := errors.New("database error")
-	mockRepo.On("Delete", objectID.Hex()).Return(expectedError)
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense", Version: 1}, nil)
+	mockRepo.On("Delete", objectID.Hex(), int64(1)).Return(expectedError)
 
-	err := service.DeleteTransactionsEntry(objectID.Hex())
+	err := service.DeleteTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1))
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestTransactionsServiceDeleteTransactionsEntryVersionMismatch(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense", Version: 3}, nil)
+
+	err := service.DeleteTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(2))
+
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceDeleteTransactionsEntryMatchesAnyListedVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch dtos.IfMatchDTO
+	}{
+		{name: "Listed version", ifMatch: dtos.IfMatchDTO{Versions: []int64{2, 3}}},
+		{name: "Wildcard", ifMatch: dtos.IfMatchDTO{Any: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTransactionsRepository)
+			service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+			objectID := primitive.NewObjectID()
+
+			mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense", Version: 3}, nil)
+			mockRepo.On("Delete", objectID.Hex(), int64(3)).Return(nil)
+
+			err := service.DeleteTransactionsEntry(objectID.Hex(), tt.ifMatch)
+
+			assert.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestTransactionsServiceCreateTransactionsEntrySuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	inputDTO := dtos.CreateTransactionsEntryDTO{
-		Amount:        150.75,
+		Amount:        json.Number("150.75"),
 		Title:         "Lunch at restaurant",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -122,7 +262,7 @@ func TestTransactionsServiceCreateTransactionsEntrySuccess(t *testing.T) {
 
 	expectedModel := &model.TransactionsEntryModel{
 		ID:            objectID,
-		Amount:        150.75,
+		Amount:        15075,
 		Title:         "Lunch at restaurant",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -133,15 +273,16 @@ func TestTransactionsServiceCreateTransactionsEntrySuccess(t *testing.T) {
 		Timestamp:     createdTime.Unix(),
 		CreatedAt:     createdTime,
 		UpdatedAt:     createdTime,
+		Version:       1,
 	}
 
 	mockRepo.On("Create", mock.AnythingOfType("*model.TransactionsEntryModel")).Return(expectedModel, nil)
 
-	result, err := service.CreateTransactionsEntry(inputDTO)
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
 
 	assert.NoError(t, err)
 	assert.Equal(t, objectID.Hex(), result.ID)
-	assert.Equal(t, float64(150.75), result.Amount)
+	assert.Equal(t, json.Number("150.75"), result.Amount)
 	assert.Equal(t, "BRL", result.Currency)
 	assert.Equal(t, "expense", result.Type)
 	assert.Equal(t, "food", result.Category)
@@ -158,10 +299,10 @@ func TestTransactionsServiceCreateTransactionsEntrySuccess(t *testing.T) {
 
 func TestTransactionsServiceCreateTransactionsEntryInvalidDate(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	inputDTO := dtos.CreateTransactionsEntryDTO{
-		Amount:        150.75,
+		Amount:        json.Number("150.75"),
 		Title:         "Lunch at restaurant",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -171,7 +312,7 @@ func TestTransactionsServiceCreateTransactionsEntryInvalidDate(t *testing.T) {
 		Date:          "invalid-date",
 	}
 
-	result, err := service.CreateTransactionsEntry(inputDTO)
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
@@ -179,12 +320,34 @@ func TestTransactionsServiceCreateTransactionsEntryInvalidDate(t *testing.T) {
 	mockRepo.AssertNotCalled(t, "Create")
 }
 
+func TestTransactionsServiceCreateTransactionsEntryTooManyDecimals(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	inputDTO := dtos.CreateTransactionsEntryDTO{
+		Amount:        json.Number("1200.5"),
+		Title:         "Ramen",
+		Currency:      "JPY",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "cash",
+		Date:          "06/09/2025",
+	}
+
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
+
+	assert.ErrorIs(t, err, money.ErrTooManyDecimals)
+	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
 func TestTransactionsServiceCreateTransactionsEntryRepositoryError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	inputDTO := dtos.CreateTransactionsEntryDTO{
-		Amount:        150.75,
+		Amount:        json.Number("150.75"),
 		Title:         "Lunch at restaurant",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -197,7 +360,7 @@ func TestTransactionsServiceCreateTransactionsEntryRepositoryError(t *testing.T)
 	expectedError := errors.New("database connection failed")
 	mockRepo.On("Create", mock.AnythingOfType("*model.TransactionsEntryModel")).Return(nil, expectedError)
 
-	result, err := service.CreateTransactionsEntry(inputDTO)
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -208,7 +371,7 @@ func TestTransactionsServiceCreateTransactionsEntryRepositoryError(t *testing.T)
 
 func TestTransactionsServiceGetAllTransactionsEntriesSuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID1 := primitive.NewObjectID()
 	objectID2 := primitive.NewObjectID()
@@ -218,7 +381,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesSuccess(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID1,
-			Amount:        150.75,
+			Amount:        15075,
 			Title:         "Lunch at restaurant",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -232,7 +395,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesSuccess(t *testing.T) {
 		},
 		{
 			ID:            objectID2,
-			Amount:        2500.0,
+			Amount:        250000,
 			Title:         "Lunch at restaurant",
 			Currency:      "BRL",
 			Type:          "income",
@@ -246,56 +409,58 @@ func TestTransactionsServiceGetAllTransactionsEntriesSuccess(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("GetAll", 10, 0).Return(mockEntries, nil)
+	mockRepo.On("GetAll", 11, 0).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "", "")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 2)
-
-	assert.Equal(t, objectID1.Hex(), result[0].ID)
-	assert.Equal(t, float64(150.75), result[0].Amount)
-	assert.Equal(t, "BRL", result[0].Currency)
-	assert.Equal(t, "expense", result[0].Type)
-	assert.Equal(t, "food", result[0].Category)
-	assert.Equal(t, "creditcard", result[0].PaymentMethod)
-	assert.Equal(t, "Lunch at restaurant", result[0].Title)
-	assert.Equal(t, "Lunch at restaurant", result[0].Description)
-	assert.Equal(t, createdTime1.Format("02/01/2006"), result[0].Date)
-	assert.Equal(t, createdTime1.Unix(), result[0].Timestamp)
-	assert.Equal(t, createdTime1.UTC().Format(time.RFC3339), result[0].CreatedAt)
-	assert.Equal(t, createdTime1.UTC().Format(time.RFC3339), result[0].UpdatedAt)
-
-	assert.Equal(t, objectID2.Hex(), result[1].ID)
-	assert.Equal(t, float64(2500.0), result[1].Amount)
-	assert.Equal(t, "income", result[1].Type)
-	assert.Equal(t, "salary", result[1].Category)
+	assert.Len(t, page.Entries, 2)
+
+	assert.Equal(t, objectID1.Hex(), page.Entries[0].ID)
+	assert.Equal(t, json.Number("150.75"), page.Entries[0].Amount)
+	assert.Equal(t, "BRL", page.Entries[0].Currency)
+	assert.Equal(t, "expense", page.Entries[0].Type)
+	assert.Equal(t, "food", page.Entries[0].Category)
+	assert.Equal(t, "creditcard", page.Entries[0].PaymentMethod)
+	assert.Equal(t, "Lunch at restaurant", page.Entries[0].Title)
+	assert.Equal(t, "Lunch at restaurant", page.Entries[0].Description)
+	assert.Equal(t, createdTime1.Format("02/01/2006"), page.Entries[0].Date)
+	assert.Equal(t, createdTime1.Unix(), page.Entries[0].Timestamp)
+	assert.Equal(t, createdTime1.UTC().Format(time.RFC3339), page.Entries[0].CreatedAt)
+	assert.Equal(t, createdTime1.UTC().Format(time.RFC3339), page.Entries[0].UpdatedAt)
+
+	assert.Equal(t, objectID2.Hex(), page.Entries[1].ID)
+	assert.Equal(t, json.Number("2500.00"), page.Entries[1].Amount)
+	assert.Equal(t, "income", page.Entries[1].Type)
+	assert.Equal(t, "salary", page.Entries[1].Category)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllTransactionsEntriesEmptyResult(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
-	mockRepo.On("GetAll", 10, 0).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("GetAll", 11, 0).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "", "")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Empty(t, result)
+	assert.Empty(t, page.Entries)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllTransactionsEntriesRepositoryError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	expectedError := errors.New("database connection failed")
-	mockRepo.On("GetAll", 5, 10).Return(nil, expectedError)
+	mockRepo.On("GetAll", 6, 10).Return(nil, expectedError)
 
-	_, err := service.GetAllTransactionsEntries(5, 10, "", "")
+	_, err := service.GetAllTransactionsEntries(5, 10, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -305,7 +470,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesRepositoryError(t *testing.
 
 func TestTransactionsServiceGetAllTransactionsEntriesWithPagination(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 8, 28, 20, 15, 0, 0, time.UTC)
@@ -313,7 +478,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesWithPagination(t *testing.T
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        89.99,
+			Amount:        8999,
 			Currency:      "BRL",
 			Type:          "expense",
 			Category:      "entertainment",
@@ -326,22 +491,23 @@ func TestTransactionsServiceGetAllTransactionsEntriesWithPagination(t *testing.T
 		},
 	}
 
-	mockRepo.On("GetAll", 1, 5).Return(mockEntries, nil)
+	mockRepo.On("GetAll", 2, 5).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(1, 5, "", "")
+	page, err := service.GetAllTransactionsEntries(1, 5, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, objectID.Hex(), result[0].ID)
-	assert.Equal(t, float64(89.99), result[0].Amount)
-	assert.Equal(t, "entertainment", result[0].Category)
+	assert.Len(t, page.Entries, 1)
+	assert.Equal(t, objectID.Hex(), page.Entries[0].ID)
+	assert.Equal(t, json.Number("89.99"), page.Entries[0].Amount)
+	assert.Equal(t, "entertainment", page.Entries[0].Category)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllTransactionsEntriesNoPagination(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 8, 27, 14, 22, 0, 0, time.UTC)
@@ -349,7 +515,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesNoPagination(t *testing.T)
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        320.50,
+			Amount:        32050,
 			Title:         "Electric bill",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -364,20 +530,21 @@ func TestTransactionsServiceGetAllTransactionsEntriesNoPagination(t *testing.T)
 	}
 
 	mockRepo.On("GetAll", 0, 0).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(0, 0, "", "")
+	page, err := service.GetAllTransactionsEntries(0, 0, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, float64(320.50), result[0].Amount)
-	assert.Equal(t, "utilities", result[0].Category)
+	assert.Len(t, page.Entries, 1)
+	assert.Equal(t, json.Number("320.50"), page.Entries[0].Amount)
+	assert.Equal(t, "utilities", page.Entries[0].Category)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllTransactionsEntriesDateFormatting(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 
@@ -386,7 +553,7 @@ func TestTransactionsServiceGetAllTransactionsEntriesDateFormatting(t *testing.T
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        100.0,
+			Amount:        10000,
 			Title:         "Electric bill",
 			Currency:      "USD",
 			Type:          "income",
@@ -400,31 +567,33 @@ func TestTransactionsServiceGetAllTransactionsEntriesDateFormatting(t *testing.T
 		},
 	}
 
-	mockRepo.On("GetAll", 10, 0).Return(mockEntries, nil)
+	mockRepo.On("GetAll", 11, 0).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "", "")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, page.Entries, 1)
 
-	assert.Equal(t, "15/03/2025", result[0].Date)
+	assert.Equal(t, "15/03/2025", page.Entries[0].Date)
 
-	assert.Equal(t, testDate.UTC().Format(time.RFC3339), result[0].CreatedAt)
-	assert.Equal(t, testDate.UTC().Format(time.RFC3339), result[0].UpdatedAt)
+	assert.Equal(t, testDate.UTC().Format(time.RFC3339), page.Entries[0].CreatedAt)
+	assert.Equal(t, testDate.UTC().Format(time.RFC3339), page.Entries[0].UpdatedAt)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllTransactionsEntriesNilEntries(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
-	mockRepo.On("GetAll", 10, 0).Return(nil, nil)
+	mockRepo.On("GetAll", 11, 0).Return(nil, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "", "")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Empty(t, result)
+	assert.Empty(t, page.Entries)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -499,7 +668,7 @@ func TestTransactionsServiceGetAllParameterValidation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepo := new(MockTransactionsRepository)
-			service := NewTransactionsService(mockRepo)
+			service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 			objectID := primitive.NewObjectID()
 			createdTime := time.Date(2025, 9, 7, 10, 0, 0, 0, time.UTC)
@@ -507,7 +676,7 @@ func TestTransactionsServiceGetAllParameterValidation(t *testing.T) {
 			mockEntries := []*model.TransactionsEntryModel{
 				{
 					ID:            objectID,
-					Amount:        100.0,
+					Amount:        10000,
 					Title:         "Test Entry",
 					Currency:      "BRL",
 					Type:          "expense",
@@ -521,13 +690,19 @@ func TestTransactionsServiceGetAllParameterValidation(t *testing.T) {
 				},
 			}
 
-			mockRepo.On("GetAll", tc.expectedLimit, tc.expectedSkip).Return(mockEntries, nil)
+			fetchLimit := tc.expectedLimit
+			if fetchLimit > 0 {
+				fetchLimit++
+			}
+
+			mockRepo.On("GetAll", fetchLimit, tc.expectedSkip).Return(mockEntries, nil)
+			mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-			result, err := service.GetAllTransactionsEntries(tc.inputLimit, tc.inputSkip, "", "")
+			page, err := service.GetAllTransactionsEntries(tc.inputLimit, tc.inputSkip, dtos.TransactionsFilterDTO{}, DateFormat)
 
 			assert.NoError(t, err, tc.description)
-			assert.Len(t, result, 1, tc.description)
-			assert.Equal(t, objectID.Hex(), result[0].ID, tc.description)
+			assert.Len(t, page.Entries, 1, tc.description)
+			assert.Equal(t, objectID.Hex(), page.Entries[0].ID, tc.description)
 
 			mockRepo.AssertExpectations(t)
 		})
@@ -536,7 +711,7 @@ func TestTransactionsServiceGetAllParameterValidation(t *testing.T) {
 
 func TestTransactionsServiceGetAllBoundaryValues(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Now().UTC()
@@ -544,7 +719,7 @@ func TestTransactionsServiceGetAllBoundaryValues(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        50.0,
+			Amount:        5000,
 			Title:         "Boundary Test",
 			Currency:      "USD",
 			Type:          "income",
@@ -573,12 +748,13 @@ func TestTransactionsServiceGetAllBoundaryValues(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockRepo.On("GetAll", tc.expectedLimit, tc.expectedSkip).Return(mockEntries, nil).Once()
+			mockRepo.On("GetAll", tc.expectedLimit+1, tc.expectedSkip).Return(mockEntries, nil).Once()
+			mockRepo.On("Count", mock.Anything).Return(int64(1), nil).Once()
 
-			result, err := service.GetAllTransactionsEntries(tc.limit, tc.skip, "", "")
+			page, err := service.GetAllTransactionsEntries(tc.limit, tc.skip, dtos.TransactionsFilterDTO{}, DateFormat)
 
 			assert.NoError(t, err)
-			assert.Len(t, result, 1)
+			assert.Len(t, page.Entries, 1)
 		})
 	}
 
@@ -587,24 +763,24 @@ func TestTransactionsServiceGetAllBoundaryValues(t *testing.T) {
 
 func TestTransactionsServiceParameterValidationWithError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	expectedError := errors.New("repository error after parameter validation")
 
-	mockRepo.On("GetAll", 10, 0).Return(nil, expectedError)
+	mockRepo.On("GetAll", 11, 0).Return(nil, expectedError)
 
-	result, err := service.GetAllTransactionsEntries(-10, -5, "", "")
+	page, err := service.GetAllTransactionsEntries(-10, -5, dtos.TransactionsFilterDTO{}, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
-	assert.Nil(t, result)
+	assert.Nil(t, page.Entries)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllWithFilter(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID1 := primitive.NewObjectID()
 	objectID2 := primitive.NewObjectID()
@@ -613,7 +789,7 @@ func TestTransactionsServiceGetAllWithFilter(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID1,
-			Amount:        150.75,
+			Amount:        15075,
 			Title:         "Lunch Restaurant",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -627,7 +803,7 @@ func TestTransactionsServiceGetAllWithFilter(t *testing.T) {
 		},
 		{
 			ID:            objectID2,
-			Amount:        25.50,
+			Amount:        2550,
 			Title:         "Fast food lunch",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -642,31 +818,32 @@ func TestTransactionsServiceGetAllWithFilter(t *testing.T) {
 	}
 
 	expectedFilter := types.FilterOptions{
-		Title:    "lunch",
-		Category: "food",
+		Title:      "lunch",
+		Categories: []string{"food"},
 	}
 
-	mockRepo.On("GetAllWithFilter", 10, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "lunch", "food")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Title: "lunch", Category: "food"}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 2)
+	assert.Len(t, page.Entries, 2)
 
-	assert.Equal(t, objectID1.Hex(), result[0].ID)
-	assert.Equal(t, "Lunch Restaurant", result[0].Title)
-	assert.Equal(t, "food", result[0].Category)
+	assert.Equal(t, objectID1.Hex(), page.Entries[0].ID)
+	assert.Equal(t, "Lunch Restaurant", page.Entries[0].Title)
+	assert.Equal(t, "food", page.Entries[0].Category)
 
-	assert.Equal(t, objectID2.Hex(), result[1].ID)
-	assert.Equal(t, "Fast food lunch", result[1].Title)
-	assert.Equal(t, "food", result[1].Category)
+	assert.Equal(t, objectID2.Hex(), page.Entries[1].ID)
+	assert.Equal(t, "Fast food lunch", page.Entries[1].Title)
+	assert.Equal(t, "food", page.Entries[1].Category)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllWithFilterTitleOnly(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 7, 10, 0, 0, 0, time.UTC)
@@ -674,7 +851,7 @@ func TestTransactionsServiceGetAllWithFilterTitleOnly(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        100.0,
+			Amount:        10000,
 			Title:         "Coffee Shop",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -689,24 +866,359 @@ func TestTransactionsServiceGetAllWithFilterTitleOnly(t *testing.T) {
 	}
 
 	expectedFilter := types.FilterOptions{
-		Title:    "coffee",
-		Category: "",
+		Title: "coffee",
+	}
+
+	mockRepo.On("GetAllWithFilter", 6, 2, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
+
+	page, err := service.GetAllTransactionsEntries(5, 2, dtos.TransactionsFilterDTO{Title: "coffee"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 1)
+	assert.Equal(t, "Coffee Shop", page.Entries[0].Title)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllWithRichFilters(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	minAmount := int64(1000)
+	maxAmount := int64(25000)
+
+	expectedFilter := types.FilterOptions{
+		Categories:    []string{"food", "transport"},
+		Type:          "expense",
+		Currency:      "JPY",
+		PaymentMethod: "pix",
+		DateRange: types.DateRange{
+			From: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+		},
+		MinAmount: &minAmount,
+		MaxAmount: &maxAmount,
+	}
+
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
+
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{
+		Category:      "food, transport,",
+		Type:          "expense",
+		Currency:      "JPY",
+		PaymentMethod: "pix",
+		From:          "01/09/2025",
+		To:            "30/09/2025",
+		MinAmount:     json.Number("1000"),
+		MaxAmount:     json.Number("25000"),
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Entries)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllWithSort(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	expectedFilter := types.FilterOptions{
+		Sort: []types.SortField{
+			{Field: "amount"},
+			{Field: "date", Descending: true},
+			{Field: "created_at"},
+		},
+	}
+
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
+
+	_, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Sort: "amount,-date,createdAt"}, DateFormat)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+}
+
+func cursorTestEntries(count int) []*model.TransactionsEntryModel {
+	date := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	entries := make([]*model.TransactionsEntryModel, 0, count)
+	for i := 0; i < count; i++ {
+		entries = append(entries, &model.TransactionsEntryModel{
+			ID:       primitive.NewObjectID(),
+			Amount:   int64(1000 * (i + 1)),
+			Currency: "BRL",
+			Type:     "expense",
+			Date:     date.AddDate(0, 0, -i),
+		})
+	}
+
+	return entries
+}
+
+func TestTransactionsServiceGetAllFirstPageCursor(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	entries := cursorTestEntries(3)
+
+	mockRepo.On("GetAll", 3, 0).Return(entries, nil)
+	mockRepo.On("Count", types.FilterOptions{}).Return(int64(7), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 0, dtos.TransactionsFilterDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Equal(t, int64(7), page.Total)
+	assert.Empty(t, page.Prev)
+
+	next, err := decodeCursor(page.Next)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[1].ID, next.ID)
+	assert.True(t, entries[1].Date.Equal(next.Date))
+	assert.False(t, next.Backward)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllWithForwardCursor(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	entries := cursorTestEntries(2)
+	cursor := types.Cursor{Date: time.Date(2025, 9, 11, 0, 0, 0, 0, time.UTC), ID: primitive.NewObjectID()}
+
+	expectedFilter := types.FilterOptions{Type: "expense", Cursor: &cursor}
+
+	mockRepo.On("GetAllWithFilter", 3, 0, expectedFilter).Return(entries, nil)
+	mockRepo.On("Count", expectedFilter).Return(int64(4), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 0, dtos.TransactionsFilterDTO{Type: "expense", Cursor: encodeCursor(cursor)}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Empty(t, page.Next)
+
+	prev, err := decodeCursor(page.Prev)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[0].ID, prev.ID)
+	assert.True(t, prev.Backward)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllWithBackwardCursor(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	entries := cursorTestEntries(3)
+	cursor := types.Cursor{Date: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), ID: primitive.NewObjectID(), Backward: true}
+
+	mockRepo.On("GetAllWithFilter", 3, 0, types.FilterOptions{Cursor: &cursor}).Return(entries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(10), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 0, dtos.TransactionsFilterDTO{Cursor: encodeCursor(cursor)}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Equal(t, entries[1].ID.Hex(), page.Entries[0].ID)
+	assert.Equal(t, entries[2].ID.Hex(), page.Entries[1].ID)
+
+	prev, err := decodeCursor(page.Prev)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[1].ID, prev.ID)
+
+	next, err := decodeCursor(page.Next)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[2].ID, next.ID)
+	assert.False(t, next.Backward)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllSkipFallbackHasPrevCursor(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	entries := cursorTestEntries(1)
+
+	mockRepo.On("GetAll", 3, 4).Return(entries, nil)
+	mockRepo.On("Count", types.FilterOptions{}).Return(int64(5), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 4, dtos.TransactionsFilterDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 1)
+	assert.Empty(t, page.Next)
+	assert.NotEmpty(t, page.Prev)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllCustomSortHasNoCursors(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetAllWithFilter", 3, 0, mock.Anything).Return(cursorTestEntries(3), nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(3), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 0, dtos.TransactionsFilterDTO{Sort: "amount"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Empty(t, page.Next)
+	assert.Empty(t, page.Prev)
+}
+
+func TestTransactionsServiceGetAllWithSearch(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	expectedFilter := types.FilterOptions{Search: "sao joao"}
+
+	mockRepo.On("GetAllWithFilter", 3, 0, expectedFilter).Return(cursorTestEntries(3), nil)
+	mockRepo.On("Count", expectedFilter).Return(int64(3), nil)
+
+	page, err := service.GetAllTransactionsEntries(2, 0, dtos.TransactionsFilterDTO{Q: "  sao joao "}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Empty(t, page.Next)
+	assert.Empty(t, page.Prev)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllInvalidCursor(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	for _, cursor := range []string{"not-base64!", "bm90LWpzb24", "eyJkIjoiMjAyNS0wOS0xMFQwMDowMDowMFoiLCJpZCI6Inh5eiJ9"} {
+		_, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Cursor: cursor}, DateFormat)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
 	}
 
-	mockRepo.On("GetAllWithFilter", 5, 2, expectedFilter).Return(mockEntries, nil)
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "GetAllWithFilter", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllWithFilterExpression(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetAllWithFilter", 11, 0, mock.MatchedBy(func(filter types.FilterOptions) bool {
+		_, ok := filter.Expression["$and"]
+		return ok
+	})).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
+
+	_, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Filter: "category in (food, bars) and date >= 2025-01-01"}, DateFormat)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllWithInvalidFilterExpression(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Filter: "amount >"}, DateFormat)
+
+	assert.ErrorIs(t, err, filterql.ErrInvalidExpression)
+	assert.EqualError(t, err, "unexpected end of input, expected value at position 9")
+	assert.Nil(t, page.Entries)
+	mockRepo.AssertNotCalled(t, "GetAllWithFilter", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllWithFilterAmountInMinorUnits(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	minAmount := int64(1050)
+	maxAmount := int64(20500)
+
+	mockRepo.On("GetAllWithFilter", 11, 0, types.FilterOptions{Currency: "KWD", MinAmount: &minAmount, MaxAmount: &maxAmount}).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(5, 2, "coffee", "")
+	_, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Currency: "KWD", MinAmount: json.Number("1.05"), MaxAmount: json.Number("20.5")}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, "Coffee Shop", result[0].Title)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetAllWithFilterAmountWithoutCurrency(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	var filterOptions types.FilterOptions
+	mockRepo.On("GetAllWithFilter", 11, 0, mock.Anything).Run(func(args mock.Arguments) {
+		filterOptions = args.Get(2).(types.FilterOptions)
+	}).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
+
+	_, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{MinAmount: json.Number("10.5"), MaxAmount: json.Number("20")}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Nil(t, filterOptions.MinAmount)
+	assert.Nil(t, filterOptions.MaxAmount)
+
+	// Each currency compares the bounds in its own minor units: a JPY row of
+	// 10 yen and a KWD row of 10.499 dinars both fall below 10.5.
+	amounts := map[string]bson.M{}
+	for _, branch := range filterOptions.Expression["$or"].(bson.A) {
+		condition := branch.(bson.M)
+		if pattern, ok := condition["currency"].(primitive.Regex); ok {
+			amounts[pattern.Pattern] = condition["amount"].(bson.M)
+		} else {
+			amounts["default"] = condition["amount"].(bson.M)
+		}
+	}
 
+	for pattern, amount := range amounts {
+		switch {
+		case strings.Contains(pattern, "JPY"):
+			assert.Equal(t, bson.M{"$gte": int64(11), "$lte": int64(20)}, amount)
+		case strings.Contains(pattern, "KWD"):
+			assert.Equal(t, bson.M{"$gte": int64(10500), "$lte": int64(20000)}, amount)
+		default:
+			assert.Equal(t, bson.M{"$gte": int64(1050), "$lte": int64(2000)}, amount)
+		}
+	}
+	assert.Len(t, amounts, 3)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestTransactionsServiceGetAllWithFilterInvalidAmountRange(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{MinAmount: json.Number("100"), MaxAmount: json.Number("10")}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidAmountRange)
+	assert.Nil(t, page.Entries)
+	mockRepo.AssertNotCalled(t, "GetAllWithFilter", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetAllWithFilterInvalidDateRange(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{From: "30/09/2025", To: "01/09/2025"}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	assert.Nil(t, page.Entries)
+}
+
 func TestTransactionsServiceGetAllWithFilterCategoryOnly(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 7, 10, 0, 0, 0, time.UTC)
@@ -714,7 +1226,7 @@ func TestTransactionsServiceGetAllWithFilterCategoryOnly(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        50.0,
+			Amount:        5000,
 			Title:         "Bus Ticket",
 			Currency:      "BRL",
 			Type:          "expense",
@@ -729,24 +1241,25 @@ func TestTransactionsServiceGetAllWithFilterCategoryOnly(t *testing.T) {
 	}
 
 	expectedFilter := types.FilterOptions{
-		Title:    "",
-		Category: "transport",
+		Title:      "",
+		Categories: []string{"transport"},
 	}
 
-	mockRepo.On("GetAllWithFilter", 10, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "", "transport")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Category: "transport"}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, "transport", result[0].Category)
+	assert.Len(t, page.Entries, 1)
+	assert.Equal(t, "transport", page.Entries[0].Category)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllWithFilterParameterValidation(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 7, 10, 0, 0, 0, time.UTC)
@@ -754,7 +1267,7 @@ func TestTransactionsServiceGetAllWithFilterParameterValidation(t *testing.T) {
 	mockEntries := []*model.TransactionsEntryModel{
 		{
 			ID:            objectID,
-			Amount:        200.0,
+			Amount:        20000,
 			Title:         "Test Entry",
 			Currency:      "USD",
 			Type:          "income",
@@ -769,64 +1282,66 @@ func TestTransactionsServiceGetAllWithFilterParameterValidation(t *testing.T) {
 	}
 
 	expectedFilter := types.FilterOptions{
-		Title:    "test",
-		Category: "salary",
+		Title:      "test",
+		Categories: []string{"salary"},
 	}
 
-	mockRepo.On("GetAllWithFilter", 10, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return(mockEntries, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(-5, -3, "test", "salary")
+	page, err := service.GetAllTransactionsEntries(-5, -3, dtos.TransactionsFilterDTO{Title: "test", Category: "salary"}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, page.Entries, 1)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllWithFilterRepositoryError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	expectedError := errors.New("database filter query failed")
 	expectedFilter := types.FilterOptions{
-		Title:    "error",
-		Category: "test",
+		Title:      "error",
+		Categories: []string{"test"},
 	}
 
-	mockRepo.On("GetAllWithFilter", 10, 0, expectedFilter).Return(nil, expectedError)
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return(nil, expectedError)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "error", "test")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Title: "error", Category: "test"}, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
-	assert.Nil(t, result)
+	assert.Nil(t, page.Entries)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetAllWithFilterEmptyResult(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	expectedFilter := types.FilterOptions{
-		Title:    "nonexistent",
-		Category: "unknown",
+		Title:      "nonexistent",
+		Categories: []string{"unknown"},
 	}
 
-	mockRepo.On("GetAllWithFilter", 10, 0, expectedFilter).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("GetAllWithFilter", 11, 0, expectedFilter).Return([]*model.TransactionsEntryModel{}, nil)
+	mockRepo.On("Count", mock.Anything).Return(int64(0), nil)
 
-	result, err := service.GetAllTransactionsEntries(10, 0, "nonexistent", "unknown")
+	page, err := service.GetAllTransactionsEntries(10, 0, dtos.TransactionsFilterDTO{Title: "nonexistent", Category: "unknown"}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Empty(t, result)
-	assert.NotNil(t, result)
+	assert.Empty(t, page.Entries)
+	assert.NotNil(t, page.Entries)
 
 	mockRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)
@@ -834,7 +1349,7 @@ func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 
 	existingEntry := &model.TransactionsEntryModel{
 		ID:            objectID,
-		Amount:        150.75,
+		Amount:        15075,
 		Title:         "Old Title",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -845,11 +1360,12 @@ func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 		Timestamp:     createdTime.Unix(),
 		CreatedAt:     createdTime,
 		UpdatedAt:     createdTime,
+		Version:       1,
 	}
 
 	updatedEntry := &model.TransactionsEntryModel{
 		ID:            objectID,
-		Amount:        200.50,
+		Amount:        20050,
 		Title:         "Updated Title",
 		Currency:      "USD",
 		Type:          "expense",
@@ -863,7 +1379,7 @@ func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 	}
 
 	updateDTO := dtos.UpdateTransactionsEntryDTO{
-		Amount:        200.50,
+		Amount:        json.Number("200.50"),
 		Title:         "Updated Title",
 		Currency:      "USD",
 		Type:          "expense",
@@ -876,11 +1392,11 @@ func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 	mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
 	mockRepo.On("Update", objectID.Hex(), mock.AnythingOfType("*model.TransactionsEntryModel")).Return(updatedEntry, nil)
 
-	result, err := service.UpdateTransactionsEntry(objectID.Hex(), updateDTO)
+	result, err := service.UpdateTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), updateDTO, DateFormat)
 
 	assert.NoError(t, err)
 	assert.Equal(t, objectID.Hex(), result.ID)
-	assert.Equal(t, float64(200.50), result.Amount)
+	assert.Equal(t, json.Number("200.50"), result.Amount)
 	assert.Equal(t, "Updated Title", result.Title)
 	assert.Equal(t, "USD", result.Currency)
 	assert.Equal(t, "expense", result.Type)
@@ -897,12 +1413,12 @@ func TestTransactionsServiceUpdateTransactionsEntrySuccess(t *testing.T) {
 
 func TestTransactionsServiceUpdateTransactionsEntryInvalidDate(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 
 	updateDTO := dtos.UpdateTransactionsEntryDTO{
-		Amount:        200.50,
+		Amount:        json.Number("200.50"),
 		Title:         "Updated Title",
 		Currency:      "USD",
 		Type:          "expense",
@@ -912,7 +1428,7 @@ func TestTransactionsServiceUpdateTransactionsEntryInvalidDate(t *testing.T) {
 		Date:          "invalid-date",
 	}
 
-	result, err := service.UpdateTransactionsEntry(objectID.Hex(), updateDTO)
+	result, err := service.UpdateTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), updateDTO, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
@@ -923,13 +1439,13 @@ func TestTransactionsServiceUpdateTransactionsEntryInvalidDate(t *testing.T) {
 
 func TestTransactionsServiceUpdateTransactionsEntryGetByIDError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	expectedError := errors.New("entry not found")
 
 	updateDTO := dtos.UpdateTransactionsEntryDTO{
-		Amount:        200.50,
+		Amount:        json.Number("200.50"),
 		Title:         "Updated Title",
 		Currency:      "USD",
 		Type:          "expense",
@@ -941,7 +1457,7 @@ func TestTransactionsServiceUpdateTransactionsEntryGetByIDError(t *testing.T) {
 
 	mockRepo.On("GetByID", objectID.Hex()).Return(nil, expectedError)
 
-	result, err := service.UpdateTransactionsEntry(objectID.Hex(), updateDTO)
+	result, err := service.UpdateTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), updateDTO, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -952,7 +1468,7 @@ func TestTransactionsServiceUpdateTransactionsEntryGetByIDError(t *testing.T) {
 
 func TestTransactionsServiceUpdateTransactionsEntryUpdateError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)
@@ -960,7 +1476,7 @@ func TestTransactionsServiceUpdateTransactionsEntryUpdateError(t *testing.T) {
 
 	existingEntry := &model.TransactionsEntryModel{
 		ID:            objectID,
-		Amount:        150.75,
+		Amount:        15075,
 		Title:         "Old Title",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -971,10 +1487,11 @@ func TestTransactionsServiceUpdateTransactionsEntryUpdateError(t *testing.T) {
 		Timestamp:     createdTime.Unix(),
 		CreatedAt:     createdTime,
 		UpdatedAt:     createdTime,
+		Version:       1,
 	}
 
 	updateDTO := dtos.UpdateTransactionsEntryDTO{
-		Amount:        200.50,
+		Amount:        json.Number("200.50"),
 		Title:         "Updated Title",
 		Currency:      "USD",
 		Type:          "expense",
@@ -987,7 +1504,7 @@ func TestTransactionsServiceUpdateTransactionsEntryUpdateError(t *testing.T) {
 	mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
 	mockRepo.On("Update", objectID.Hex(), mock.AnythingOfType("*model.TransactionsEntryModel")).Return(nil, expectedError)
 
-	result, err := service.UpdateTransactionsEntry(objectID.Hex(), updateDTO)
+	result, err := service.UpdateTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), updateDTO, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -998,14 +1515,14 @@ func TestTransactionsServiceUpdateTransactionsEntryUpdateError(t *testing.T) {
 
 func TestTransactionsServiceGetTransactionsEntryByIDSuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	createdTime := time.Date(2025, 9, 6, 14, 30, 0, 0, time.UTC)
 
 	mockEntry := &model.TransactionsEntryModel{
 		ID:            objectID,
-		Amount:        150.75,
+		Amount:        15075,
 		Title:         "Lunch at restaurant",
 		Currency:      "BRL",
 		Type:          "expense",
@@ -1016,15 +1533,16 @@ func TestTransactionsServiceGetTransactionsEntryByIDSuccess(t *testing.T) {
 		Timestamp:     createdTime.Unix(),
 		CreatedAt:     createdTime,
 		UpdatedAt:     createdTime,
+		Version:       1,
 	}
 
 	mockRepo.On("GetByID", objectID.Hex()).Return(mockEntry, nil)
 
-	result, err := service.GetTransactionsEntryByID(objectID.Hex())
+	result, err := service.GetTransactionsEntryByID(objectID.Hex(), DateFormat)
 
 	assert.NoError(t, err)
 	assert.Equal(t, objectID.Hex(), result.ID)
-	assert.Equal(t, float64(150.75), result.Amount)
+	assert.Equal(t, json.Number("150.75"), result.Amount)
 	assert.Equal(t, "Lunch at restaurant", result.Title)
 	assert.Equal(t, "BRL", result.Currency)
 	assert.Equal(t, "expense", result.Type)
@@ -1039,16 +1557,31 @@ func TestTransactionsServiceGetTransactionsEntryByIDSuccess(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestTransactionsServiceGetTransactionsEntryByIDISOLayout(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	date := time.Date(2025, 9, 6, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Currency: "BRL", Date: date, CreatedAt: date, UpdatedAt: date}, nil)
+
+	result, err := service.GetTransactionsEntryByID(objectID.Hex(), dates.ISOLayout)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-09-06", result.Date)
+	assert.Equal(t, "2025-09-06T00:00:00Z", result.CreatedAt)
+}
+
 func TestTransactionsServiceGetTransactionsEntryByIDNotFound(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	objectID := primitive.NewObjectID()
 	expectedError := errors.New("entry not found")
 
 	mockRepo.On("GetByID", objectID.Hex()).Return(nil, expectedError)
 
-	result, err := service.GetTransactionsEntryByID(objectID.Hex())
+	result, err := service.GetTransactionsEntryByID(objectID.Hex(), DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -1059,14 +1592,14 @@ func TestTransactionsServiceGetTransactionsEntryByIDNotFound(t *testing.T) {
 
 func TestTransactionsServiceGetTransactionsEntryByIDInvalidID(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	invalidID := "invalid-id"
 	expectedError := errors.New("invalid ID format")
 
 	mockRepo.On("GetByID", invalidID).Return(nil, expectedError)
 
-	result, err := service.GetTransactionsEntryByID(invalidID)
+	result, err := service.GetTransactionsEntryByID(invalidID, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
@@ -1075,239 +1608,1036 @@ func TestTransactionsServiceGetTransactionsEntryByIDInvalidID(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func newDashboardService(transactionsRepo *MockTransactionsRepository, accountsRepo *MockAccountsRepository, exchangeRatesRepo *MockExchangeRatesRepository, now time.Time) TransactionsService {
+	service := NewTransactionsService(transactionsRepo, accountsRepo, exchangeRatesRepo).(*transactionsService)
+	service.now = func() time.Time { return now }
+	return service
+}
+
 func TestTransactionsServiceGetTransactionDashboardDataSuccess(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 1000.50,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 2500.75,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 500.25,
-			Type:   "expense",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 300.00,
-			Type:   "expense",
-		},
-	}
-
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 350125},
+		{Currency: "BRL", Type: "expense", Total: 80025},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 3501.25, result.IncomeAmount)
-	assert.Equal(t, 800.25, result.ExpenseAmount)
-	assert.Equal(t, 2701.00, result.TotalAmount)
+	assert.Empty(t, result.From)
+	assert.Empty(t, result.To)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("3501.25"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("800.25"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("2701.00"), result.Totals[0].TotalAmount)
 
 	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetTransactionDashboardDataOnlyIncome(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 1500.00,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 750.50,
-			Type:   "income",
-		},
-	}
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 225050},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
-
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
 
 	assert.NoError(t, err)
 
-	assert.Equal(t, 2250.50, result.IncomeAmount)
-	assert.Equal(t, 0.0, result.ExpenseAmount)
-	assert.Equal(t, 2250.50, result.TotalAmount)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("2250.50"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("0.00"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("2250.50"), result.Totals[0].TotalAmount)
 
 	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetTransactionDashboardDataOnlyExpenses(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 200.00,
-			Type:   "expense",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 150.75,
-			Type:   "expense",
-		},
-	}
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "expense", Total: 35075},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
-
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
 
 	assert.NoError(t, err)
 
-	assert.Equal(t, 0.0, result.IncomeAmount)
-	assert.Equal(t, 350.75, result.ExpenseAmount)
-	assert.Equal(t, -350.75, result.TotalAmount)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("0.00"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("350.75"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("-350.75"), result.Totals[0].TotalAmount)
 
 	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetTransactionDashboardDataEmptyTransactions(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
-
-	testTransactions := []*model.TransactionsEntryModel{}
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 0.0, result.IncomeAmount)
-	assert.Equal(t, 0.0, result.ExpenseAmount)
-	assert.Equal(t, 0.0, result.TotalAmount)
+	assert.Empty(t, result.Totals)
 
 	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
 }
 
 func TestTransactionsServiceGetTransactionDashboardDataRepositoryError(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
 
 	expectedError := errors.New("database connection error")
-	mockRepo.On("GetTransactions").Return(nil, expectedError)
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return(nil, expectedError)
 
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, dtos.TransactionDashboardResponseDTO{}, result)
 
 	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAccountTotals", mock.Anything)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataAccountTotalsError(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	expectedError := errors.New("database connection error")
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return(nil, expectedError)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, dtos.TransactionDashboardResponseDTO{}, result)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertNotCalled(t, "GetAll")
 }
 
 func TestTransactionsServiceGetTransactionDashboardDataWithUnknownType(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 1000.00,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 500.00,
-			Type:   "expense",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 250.00,
-			Type:   "unknown",
-		},
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 100000},
+		{Currency: "BRL", Type: "expense", Total: 50000},
+		{Currency: "BRL", Type: "unknown", Total: 25000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("1000.00"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("500.00"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("500.00"), result.Totals[0].TotalAmount)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataWithThreeDecimalCurrency(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "KWD", Type: "income", Total: 3500875},
+		{Currency: "KWD", Type: "expense", Total: 800240},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, "KWD", result.Totals[0].Currency)
+	assert.Equal(t, json.Number("3500.875"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("800.240"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("2700.635"), result.Totals[0].TotalAmount)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataWithExactCents(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 30},
+		{Currency: "BRL", Type: "expense", Total: 30},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("0.30"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("0.30"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("0.00"), result.Totals[0].TotalAmount)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataGroupsByCurrency(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "USD", Type: "expense", Total: 5000},
+		{Currency: "BRL", Type: "income", Total: 100000},
+		{Currency: "JPY", Type: "income", Total: 1200},
+		{Currency: "BRL", Type: "expense", Total: 25000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Currency)
+	assert.Empty(t, result.TotalAmount)
+	assert.Equal(t, []dtos.DashboardCurrencyTotalDTO{
+		{Currency: "BRL", IncomeAmount: json.Number("1000.00"), ExpenseAmount: json.Number("250.00"), TotalAmount: json.Number("750.00")},
+		{Currency: "JPY", IncomeAmount: json.Number("1200"), ExpenseAmount: json.Number("0"), TotalAmount: json.Number("1200")},
+		{Currency: "USD", IncomeAmount: json.Number("0.00"), ExpenseAmount: json.Number("50.00"), TotalAmount: json.Number("-50.00")},
+	}, result.Totals)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataConvertsToCurrency(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockExchangeRatesRepo := new(MockExchangeRatesRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, mockExchangeRatesRepo)
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 100000},
+		{Currency: "USD", Type: "expense", Total: 5000},
+		{Currency: "JPY", Type: "expense", Total: 1000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+	mockExchangeRatesRepo.On("GetAll").Return([]*model.ExchangeRateModel{
+		exchangeRateModel("USD", "BRL", "5.25"),
+		exchangeRateModel("BRL", "JPY", "30"),
+	}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{Currency: "brl"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BRL", result.Currency)
+	assert.Equal(t, json.Number("1000.00"), result.IncomeAmount)
+	assert.Equal(t, json.Number("295.83"), result.ExpenseAmount)
+	assert.Equal(t, json.Number("704.17"), result.TotalAmount)
+	assert.Len(t, result.Totals, 3)
+
+	mockExchangeRatesRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataMissingExchangeRate(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	mockExchangeRatesRepo := new(MockExchangeRatesRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, mockExchangeRatesRepo)
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "EUR", Type: "expense", Total: 5000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+	mockExchangeRatesRepo.On("GetAll").Return([]*model.ExchangeRateModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{Currency: "BRL"}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+	assert.Equal(t, dtos.TransactionDashboardResponseDTO{}, result)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataThisMonth(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := newDashboardService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository), time.Date(2025, time.September, 17, 15, 30, 0, 0, time.UTC))
+
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
 	}
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
+	mockRepo.On("GetTotalsByCurrency", expectedRange, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 100000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", expectedRange.To).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{Period: "this-month"}, DateFormat)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 1000.00, result.IncomeAmount)
-	assert.Equal(t, 500.00, result.ExpenseAmount)
-	assert.Equal(t, 500.00, result.TotalAmount)
+	assert.Equal(t, "01/09/2025", result.From)
+	assert.Equal(t, "30/09/2025", result.To)
+	assert.Equal(t, json.Number("1000.00"), result.Totals[0].IncomeAmount)
 
 	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
 }
 
-func TestTransactionsServiceGetTransactionDashboardDataWithRoundingUp(t *testing.T) {
+func TestTransactionsServiceGetTransactionDashboardDataLastMonthAcrossYears(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := newDashboardService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository), time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 1000.111,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 2500.764,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 500.231,
-			Type:   "expense",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 300.009,
-			Type:   "expense",
-		},
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
 	}
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
+	mockRepo.On("GetTotalsByCurrency", expectedRange, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", expectedRange.To).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
 
-	result, err := service.GetTransactionDashboardData()
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{Period: "last-month"}, DateFormat)
 
 	assert.NoError(t, err)
+	assert.Equal(t, "01/12/2025", result.From)
+	assert.Equal(t, "31/12/2025", result.To)
+
+	mockRepo.AssertExpectations(t)
+}
 
-	assert.Equal(t, 3500.88, result.IncomeAmount)
-	assert.Equal(t, 800.24, result.ExpenseAmount)
-	assert.Equal(t, 2700.64, result.TotalAmount)
+func TestTransactionsServiceGetTransactionDashboardDataYearToDate(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := newDashboardService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository), time.Date(2025, time.September, 17, 23, 59, 0, 0, time.UTC))
+
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.September, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo.On("GetTotalsByCurrency", expectedRange, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", expectedRange.To).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{Period: "ytd"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "01/01/2025", result.From)
+	assert.Equal(t, "17/09/2025", result.To)
 
 	mockRepo.AssertExpectations(t)
 }
 
-func TestTransactionsServiceGetTransactionDashboardDataWithExactRounding(t *testing.T) {
+func TestTransactionsServiceGetTransactionDashboardDataDateRange(t *testing.T) {
 	mockRepo := new(MockTransactionsRepository)
-	service := NewTransactionsService(mockRepo)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
 
-	testTransactions := []*model.TransactionsEntryModel{
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 100.001,
-			Type:   "income",
-		},
-		{
-			ID:     primitive.NewObjectID(),
-			Amount: 50.009,
-			Type:   "expense",
-		},
+	expectedRange := types.DateRange{
+		From: time.Date(2025, time.September, 5, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.September, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo.On("GetTotalsByCurrency", expectedRange, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", expectedRange.To).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{From: "05/09/2025", To: "10/09/2025"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "05/09/2025", result.From)
+	assert.Equal(t, "10/09/2025", result.To)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataISOLayout(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	mockRepo.On("GetTotalsByCurrency", mock.Anything, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", mock.Anything).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{From: "05/09/2025", To: "2025-09-10"}, dates.ISOLayout)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-09-05", result.From)
+	assert.Equal(t, "2025-09-10", result.To)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataOpenEndedRange(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	expectedRange := types.DateRange{From: time.Date(2025, time.September, 5, 0, 0, 0, 0, time.UTC)}
+
+	mockRepo.On("GetTotalsByCurrency", expectedRange, bson.M(nil)).Return([]*types.CurrencyTotal{}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{}, nil)
+	mockAccountsRepo.On("GetAll").Return([]*model.AccountModel{}, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{From: "05/09/2025"}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "05/09/2025", result.From)
+	assert.Empty(t, result.To)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataInvalidDateRange(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{From: "10/09/2025", To: "05/09/2025"}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	assert.Equal(t, dtos.TransactionDashboardResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "GetTotalsByCurrency", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataWithAccountBalances(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	checkingID := primitive.NewObjectID()
+	walletID := primitive.NewObjectID()
+
+	accounts := []*model.AccountModel{
+		{ID: checkingID, Name: "Checking", Currency: "BRL", OpeningBalance: 50000},
+		{ID: walletID, Name: "Wallet", Currency: "BRL", OpeningBalance: 5000},
+	}
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 300000},
+		{Currency: "BRL", Type: "expense", Total: 16050},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{
+		{AccountID: checkingID, Type: "income", Total: 300000},
+		{AccountID: checkingID, Type: "expense", Total: 12050},
+		{AccountID: walletID, Type: "expense", Total: 3000},
+	}, nil)
+	mockAccountsRepo.On("GetAll").Return(accounts, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("3000.00"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("160.50"), result.Totals[0].ExpenseAmount)
+	assert.Len(t, result.Accounts, 2)
+	assert.Equal(t, checkingID.Hex(), result.Accounts[0].AccountID)
+	assert.Equal(t, json.Number("3379.50"), result.Accounts[0].Balance)
+	assert.Equal(t, walletID.Hex(), result.Accounts[1].AccountID)
+	assert.Equal(t, json.Number("20.00"), result.Accounts[1].Balance)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceGetTransactionDashboardDataExcludesTransfers(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	checkingID := primitive.NewObjectID()
+	savingsID := primitive.NewObjectID()
+
+	accounts := []*model.AccountModel{
+		{ID: checkingID, Name: "Checking", Currency: "BRL"},
+		{ID: savingsID, Name: "Savings", Currency: "BRL"},
+	}
+
+	mockRepo.On("GetTotalsByCurrency", types.DateRange{}, bson.M(nil)).Return([]*types.CurrencyTotal{
+		{Currency: "BRL", Type: "income", Total: 100000},
+	}, nil)
+	mockRepo.On("GetAccountTotals", time.Time{}).Return([]*types.AccountTotal{
+		{AccountID: checkingID, Type: "income", Total: 100000},
+		{AccountID: checkingID, Type: "transfer", TransferDirection: "out", Total: 40000},
+		{AccountID: savingsID, Type: "transfer", TransferDirection: "in", Total: 40000},
+	}, nil)
+	mockAccountsRepo.On("GetAll").Return(accounts, nil)
+
+	result, err := service.GetTransactionDashboardData(dtos.TransactionDashboardQueryDTO{}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Totals, 1)
+	assert.Equal(t, json.Number("1000.00"), result.Totals[0].IncomeAmount)
+	assert.Equal(t, json.Number("0.00"), result.Totals[0].ExpenseAmount)
+	assert.Equal(t, json.Number("1000.00"), result.Totals[0].TotalAmount)
+	assert.Equal(t, json.Number("600.00"), result.Accounts[0].Balance)
+	assert.Equal(t, json.Number("400.00"), result.Accounts[0].TransfersOut)
+	assert.Equal(t, json.Number("400.00"), result.Accounts[1].Balance)
+	assert.Equal(t, json.Number("400.00"), result.Accounts[1].TransfersIn)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceCreateTransactionsEntryWithAccount(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	accountID := primitive.NewObjectID()
+
+	inputDTO := dtos.CreateTransactionsEntryDTO{
+		Amount:        json.Number("42.00"),
+		Title:         "Groceries",
+		Currency:      "BRL",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "debit_card",
+		Date:          "06/09/2025",
+		AccountID:     accountID.Hex(),
+	}
+
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(&model.AccountModel{ID: accountID, Currency: "BRL"}, nil)
+	mockRepo.On("Create", mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+		return entry.AccountID == accountID
+	})).Return(&model.TransactionsEntryModel{ID: primitive.NewObjectID(), Amount: 4200, AccountID: accountID}, nil)
+
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, accountID.Hex(), result.AccountID)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceCreateTransactionsEntryUnknownAccount(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	accountID := primitive.NewObjectID()
+
+	inputDTO := dtos.CreateTransactionsEntryDTO{
+		Amount:    json.Number("42.00"),
+		Title:     "Groceries",
+		Currency:  "BRL",
+		Type:      "expense",
+		Date:      "06/09/2025",
+		AccountID: accountID.Hex(),
+	}
+
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(nil, repository.ErrAccountNotFound)
+
+	result, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
+
+	assert.Equal(t, ErrUnknownAccount, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransactionsServiceCreateTransactionsEntryAccountCurrencyMismatch(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	accountID := primitive.NewObjectID()
+
+	inputDTO := dtos.CreateTransactionsEntryDTO{
+		Amount:    json.Number("42.00"),
+		Title:     "Groceries",
+		Currency:  "USD",
+		Type:      "expense",
+		Date:      "06/09/2025",
+		AccountID: accountID.Hex(),
+	}
+
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(&model.AccountModel{ID: accountID, Currency: "BRL"}, nil)
+
+	_, err := service.CreateTransactionsEntry(inputDTO, DateFormat)
+
+	assert.Equal(t, ErrAccountCurrencyMismatch, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestTransactionsServicePatchTransactionsEntryCurrencyMustMatchAccount(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransactionsService(mockRepo, mockAccountsRepo, new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	accountID := primitive.NewObjectID()
+	currency := "USD"
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Amount: 1000, Currency: "BRL", Type: "expense", AccountID: accountID, Version: 1}, nil)
+	mockAccountsRepo.On("GetByID", accountID.Hex()).Return(&model.AccountModel{ID: accountID, Currency: "BRL"}, nil)
+
+	_, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Currency: &currency}, DateFormat)
+
+	assert.Equal(t, ErrAccountCurrencyMismatch, err)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceDeleteTransactionsEntryTransferLeg(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	linkedID := primitive.NewObjectID()
+	transferLeg := &model.TransactionsEntryModel{
+		ID:                  objectID,
+		Type:                "transfer",
+		TransferDirection:   "out",
+		LinkedTransactionID: linkedID,
+		Version:             1,
+	}
+	linkedLeg := &model.TransactionsEntryModel{
+		ID:                  linkedID,
+		Type:                "transfer",
+		TransferDirection:   "in",
+		LinkedTransactionID: objectID,
+		Version:             1,
 	}
 
-	mockRepo.On("GetTransactions").Return(testTransactions, nil)
+	mockRepo.On("GetByID", objectID.Hex()).Return(transferLeg, nil)
+	mockRepo.On("GetByID", linkedID.Hex()).Return(linkedLeg, nil)
+	mockRepo.On("DeleteTransfer", transferLeg, linkedLeg).Return(nil)
 
-	result, err := service.GetTransactionDashboardData()
+	err := service.DeleteTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1))
 
 	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Delete", objectID.Hex(), int64(1))
+}
+
+func TestTransactionsServiceUpdateTransactionsEntryTransferLeg(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "transfer", Version: 1}, nil)
 
-	assert.Equal(t, 100.01, result.IncomeAmount) 
-	assert.Equal(t, 50.01, result.ExpenseAmount)
-	assert.Equal(t, 50.00, result.TotalAmount)
+	result, err := service.UpdateTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.UpdateTransactionsEntryDTO{
+		Amount:   json.Number("10.00"),
+		Title:    "Savings",
+		Currency: "BRL",
+		Type:     "expense",
+		Date:     "06/09/2025",
+	}, DateFormat)
 
+	assert.ErrorIs(t, err, ErrTransferLeg)
+	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServicePatchTransactionsEntrySuccess(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	date := time.Date(2025, 9, 6, 0, 0, 0, 0, time.UTC)
+	existingEntry := &model.TransactionsEntryModel{
+		ID:          objectID,
+		Amount:      15075,
+		Title:       "Old Title",
+		Currency:    "BRL",
+		Type:        "expense",
+		Category:    "food",
+		Description: "Old Description",
+		Date:        date,
+		Version:     1,
+	}
+	patchedEntry := &model.TransactionsEntryModel{
+		ID:       objectID,
+		Amount:   2000,
+		Title:    "Old Title",
+		Currency: "BRL",
+		Type:     "expense",
+		Category: "groceries",
+		Date:     date,
+	}
+
+	amount := json.Number("20.00")
+	minorAmount := int64(2000)
+	category := "groceries"
+	expectedPatch := types.TransactionPatch{
+		Version:           1,
+		Amount:            &minorAmount,
+		Category:          &category,
+		RemoveDescription: true,
+	}
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
+	mockRepo.On("Patch", objectID.Hex(), expectedPatch).Return(patchedEntry, nil)
+
+	result, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{
+		Amount:   &amount,
+		Category: &category,
+		Remove:   []string{"description"},
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("20.00"), result.Amount)
+	assert.Equal(t, "groceries", result.Category)
+	assert.Equal(t, "Old Title", result.Title)
+	assert.Empty(t, result.Description)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestTransactionsServicePatchTransactionsEntryCurrencyChange(t *testing.T) {
+	objectID := primitive.NewObjectID()
+	existingEntry := &model.TransactionsEntryModel{ID: objectID, Amount: 15075, Currency: "BRL", Type: "expense", Version: 1}
+
+	t.Run("amount_required_when_decimals_differ", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		currency := "JPY"
+		mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
+
+		result, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Currency: &currency}, DateFormat)
+
+		assert.ErrorIs(t, err, ErrAmountRequired)
+		assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+		mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("amount_kept_when_decimals_match", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		currency := "USD"
+		mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
+		mockRepo.On("Patch", objectID.Hex(), types.TransactionPatch{Version: 1, Currency: &currency}).
+			Return(&model.TransactionsEntryModel{ID: objectID, Amount: 15075, Currency: "USD", Type: "expense"}, nil)
+
+		result, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Currency: &currency}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("150.75"), result.Amount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("amount_parsed_with_new_currency", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		currency := "JPY"
+		amount := json.Number("1500.50")
+		mockRepo.On("GetByID", objectID.Hex()).Return(existingEntry, nil)
+
+		_, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Amount: &amount, Currency: &currency}, DateFormat)
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTransactionsServicePatchTransactionsEntryVersionMismatch(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	title := "Groceries"
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense", Version: 2}, nil)
+
+	result, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Title: &title}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServicePatchTransactionsEntryTransferLeg(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	objectID := primitive.NewObjectID()
+	title := "Savings"
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "transfer", Version: 1}, nil)
+
+	result, err := service.PatchTransactionsEntry(objectID.Hex(), dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Title: &title}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrTransferLeg)
+	assert.Equal(t, dtos.TransactionsEntryResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServicePatchTransactionsEntryGetByIDError(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+	title := "Groceries"
+	expectedError := errors.New("entry not found")
+	mockRepo.On("GetByID", "missing").Return(nil, expectedError)
+
+	_, err := service.PatchTransactionsEntry("missing", dtos.IfMatchVersion(1), dtos.PatchTransactionsEntryDTO{Title: &title}, DateFormat)
+
+	assert.Equal(t, expectedError, err)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestTransactionsServiceBatchTransactionsEntries(t *testing.T) {
+	existingID := primitive.NewObjectID()
+	existingEntry := &model.TransactionsEntryModel{ID: existingID, Amount: 1500, Currency: "BRL", Type: "expense", Timestamp: 1234567890, Version: 1}
+	transferID := primitive.NewObjectID()
+
+	createDTO := &dtos.CreateTransactionsEntryDTO{
+		Amount:        json.Number("10.00"),
+		Title:         "Coffee",
+		Currency:      "BRL",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "pix",
+		Date:          "01/09/2025",
+	}
+	updateDTO := &dtos.UpdateTransactionsEntryDTO{
+		Amount:        json.Number("20.00"),
+		Title:         "Lunch",
+		Currency:      "BRL",
+		Type:          "expense",
+		Category:      "food",
+		PaymentMethod: "pix",
+		Date:          "02/09/2025",
+	}
+
+	t.Run("non_atomic_reports_each_operation", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		createdID := primitive.NewObjectID()
+		mockRepo.On("Create", mock.AnythingOfType("*model.TransactionsEntryModel")).Return(&model.TransactionsEntryModel{ID: createdID, Amount: 1000, Currency: "BRL"}, nil)
+		mockRepo.On("GetByID", transferID.Hex()).Return(&model.TransactionsEntryModel{ID: transferID, Type: "transfer"}, nil)
+		mockRepo.On("GetByID", existingID.Hex()).Return(existingEntry, nil)
+		mockRepo.On("Delete", existingID.Hex(), int64(1)).Return(nil)
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "create", Create: createDTO},
+				{Op: "create", ValidationErrors: []dtos.ProblemFieldErrorDTO{{Pointer: "/operations/1/data/title", Detail: "This field is required"}}},
+				{Op: "update", ID: transferID.Hex(), Version: 1, Update: updateDTO},
+				{Op: "delete", ID: existingID.Hex(), Version: 1},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.False(t, response.Atomic)
+		assert.Equal(t, 2, response.Succeeded)
+		assert.Equal(t, 2, response.Failed)
+
+		assert.NoError(t, response.Results[0].Err)
+		assert.Equal(t, createdID.Hex(), response.Results[0].ID)
+		assert.Equal(t, json.Number("10.00"), response.Results[0].Data.Amount)
+
+		assert.ErrorIs(t, response.Results[1].Err, ErrInvalidOperation)
+		assert.Equal(t, []dtos.ProblemFieldErrorDTO{{Pointer: "/operations/1/data/title", Detail: "This field is required"}}, response.Results[1].ValidationErrors)
+
+		assert.ErrorIs(t, response.Results[2].Err, ErrTransferLeg)
+
+		assert.NoError(t, response.Results[3].Err)
+		assert.Equal(t, existingID.Hex(), response.Results[3].ID)
+		assert.Nil(t, response.Results[3].Data)
+
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "ApplyWrites", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("stale_version_fails_operation", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		mockRepo.On("GetByID", existingID.Hex()).Return(existingEntry, nil)
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "update", ID: existingID.Hex(), Version: 2, Update: updateDTO},
+				{Op: "delete", ID: existingID.Hex(), Version: 2},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Failed)
+		assert.ErrorIs(t, response.Results[0].Err, ErrVersionMismatch)
+		assert.ErrorIs(t, response.Results[1].Err, ErrVersionMismatch)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("atomic_applies_all_writes_together", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		createdID := primitive.NewObjectID()
+		mockRepo.On("GetByID", existingID.Hex()).Return(existingEntry, nil)
+		mockRepo.On("ApplyWrites", mock.MatchedBy(func(writes []types.TransactionWrite) bool {
+			return len(writes) == 3 &&
+				writes[0].Op == types.TransactionWriteCreate && writes[0].Entry.Amount == 1000 &&
+				writes[1].Op == types.TransactionWriteUpdate && writes[1].Entry.ID == existingID && writes[1].Entry.Timestamp == 1234567890 &&
+				writes[2].Op == types.TransactionWriteDelete && writes[2].Entry == existingEntry
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).([]types.TransactionWrite)[0].Entry.ID = createdID
+		}).Return(nil)
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic: true,
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "create", Create: createDTO},
+				{Op: "update", ID: existingID.Hex(), Version: 1, Update: updateDTO},
+				{Op: "delete", ID: existingID.Hex(), Version: 1},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.True(t, response.Atomic)
+		assert.Equal(t, 3, response.Succeeded)
+		assert.Equal(t, 0, response.Failed)
+		assert.Equal(t, createdID.Hex(), response.Results[0].ID)
+		assert.Equal(t, "Coffee", response.Results[0].Data.Title)
+		assert.Equal(t, json.Number("20.00"), response.Results[1].Data.Amount)
+		assert.Nil(t, response.Results[2].Data)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("atomic_aborts_on_invalid_operation", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic: true,
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "create", Create: createDTO},
+				{Op: "create", ValidationErrors: []dtos.ProblemFieldErrorDTO{{Pointer: "/operations/1/data/title", Detail: "This field is required"}}},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, response.Succeeded)
+		assert.Equal(t, 2, response.Failed)
+		assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+		assert.ErrorIs(t, response.Results[1].Err, ErrInvalidOperation)
+		mockRepo.AssertNotCalled(t, "GetByID", mock.Anything)
+		mockRepo.AssertNotCalled(t, "ApplyWrites", mock.Anything)
+	})
+
+	t.Run("atomic_aborts_when_operation_cannot_be_resolved", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		mockRepo.On("GetByID", transferID.Hex()).Return(&model.TransactionsEntryModel{ID: transferID, Type: "transfer"}, nil)
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic: true,
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "create", Create: createDTO},
+				{Op: "update", ID: transferID.Hex(), Version: 1, Update: updateDTO},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+		assert.ErrorIs(t, response.Results[1].Err, ErrTransferLeg)
+		mockRepo.AssertNotCalled(t, "ApplyWrites", mock.Anything)
+	})
+
+	t.Run("atomic_rolls_back_on_write_error", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		writeErr := errors.New("duplicate key error")
+		mockRepo.On("GetByID", existingID.Hex()).Return(existingEntry, nil)
+		mockRepo.On("ApplyWrites", mock.Anything).Return(&types.TransactionWriteError{Index: 1, Err: writeErr})
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic: true,
+			Operations: []dtos.BatchTransactionOperationDTO{
+				{Op: "create", Create: createDTO},
+				{Op: "delete", ID: existingID.Hex(), Version: 1},
+			},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Failed)
+		assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+		assert.Equal(t, writeErr, response.Results[1].Err)
+		assert.Nil(t, response.Results[0].Data)
+	})
+
+	t.Run("atomic_delete_of_transfer_leg_checks_linked_leg", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		linkedID := primitive.NewObjectID()
+		leg := &model.TransactionsEntryModel{ID: transferID, Type: "transfer", LinkedTransactionID: linkedID, Version: 2}
+		linked := &model.TransactionsEntryModel{ID: linkedID, Type: "transfer", LinkedTransactionID: transferID, Version: 2}
+
+		mockRepo.On("GetByID", transferID.Hex()).Return(leg, nil)
+		mockRepo.On("GetByID", linkedID.Hex()).Return(linked, nil)
+		mockRepo.On("ApplyWrites", []types.TransactionWrite{{Op: "delete", Entry: leg, Linked: linked}}).Return(nil)
+
+		response, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic:     true,
+			Operations: []dtos.BatchTransactionOperationDTO{{Op: "delete", ID: transferID.Hex(), Version: 2}},
+		}, DateFormat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.Succeeded)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("atomic_transaction_error", func(t *testing.T) {
+		mockRepo := new(MockTransactionsRepository)
+		service := NewTransactionsService(mockRepo, new(MockAccountsRepository), new(MockExchangeRatesRepository))
+
+		expectedError := repository.ErrTransactionsUnsupported
+		mockRepo.On("ApplyWrites", mock.Anything).Return(expectedError)
+
+		_, err := service.BatchTransactionsEntries(dtos.BatchTransactionsDTO{
+			Atomic:     true,
+			Operations: []dtos.BatchTransactionOperationDTO{{Op: "create", Create: createDTO}},
+		}, DateFormat)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
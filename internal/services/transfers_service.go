@@ -0,0 +1,211 @@
+package services
+
+import (
+	"myfin-api/internal/dates"
+	"myfin-api/internal/domain"
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/i18n"
+	"myfin-api/internal/model"
+	"myfin-api/internal/repository"
+)
+
+const (
+	transferType          = "transfer"
+	transferDirectionOut  = "out"
+	transferDirectionIn   = "in"
+	defaultTransferTitle  = "Transfer"
+	transferCategory      = "transfer"
+	transferPaymentMethod = "transfer"
+)
+
+var (
+	ErrNotATransfer             = domain.NewError(domain.ErrValidation, i18n.NotATransfer)
+	ErrTransferLeg              = domain.NewError(domain.ErrValidation, i18n.TransferLeg)
+	ErrTransferCurrencyMismatch = domain.NewError(domain.ErrValidation, i18n.TransferCurrencyMismatch)
+)
+
+type TransfersService interface {
+	CreateTransfer(transfer dtos.CreateTransferDTO, layout string) (dtos.TransferResponseDTO, error)
+	GetTransferByID(id, layout string) (dtos.TransferResponseDTO, error)
+	UpdateTransfer(id string, ifMatch dtos.IfMatchDTO, transfer dtos.UpdateTransferDTO, layout string) (dtos.TransferResponseDTO, error)
+	DeleteTransfer(id string, ifMatch dtos.IfMatchDTO) error
+}
+
+type transfersService struct {
+	transactionsRepo repository.TransactionsEntryRepository
+	accountsRepo     repository.AccountsRepository
+}
+
+func NewTransfersService(transactionsRepo repository.TransactionsEntryRepository, accountsRepo repository.AccountsRepository) TransfersService {
+	return &transfersService{
+		transactionsRepo: transactionsRepo,
+		accountsRepo:     accountsRepo,
+	}
+}
+
+func (s *transfersService) CreateTransfer(transfer dtos.CreateTransferDTO, layout string) (dtos.TransferResponseDTO, error) {
+	parsedDate, err := dates.Parse(transfer.Date)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	fromAccount, toAccount, err := s.getTransferAccounts(transfer.FromAccountID, transfer.ToAccountID)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	amount, err := parseAmount(transfer.Amount, fromAccount.Currency)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	title := transfer.Title
+	if title == "" {
+		title = defaultTransferTitle
+	}
+
+	outgoing := &model.TransactionsEntryModel{
+		Amount:            amount,
+		Title:             title,
+		Currency:          fromAccount.Currency,
+		Type:              transferType,
+		Category:          transferCategory,
+		PaymentMethod:     transferPaymentMethod,
+		Description:       transfer.Description,
+		Date:              parsedDate,
+		AccountID:         fromAccount.ID,
+		TransferDirection: transferDirectionOut,
+	}
+
+	incoming := *outgoing
+	incoming.AccountID = toAccount.ID
+	incoming.TransferDirection = transferDirectionIn
+
+	createdOutgoing, createdIncoming, err := s.transactionsRepo.CreateTransfer(outgoing, &incoming)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	return toTransferResponseDTO(createdOutgoing, createdIncoming, layout), nil
+}
+
+func (s *transfersService) GetTransferByID(id, layout string) (dtos.TransferResponseDTO, error) {
+	outgoing, incoming, err := s.getTransferLegs(id)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	return toTransferResponseDTO(outgoing, incoming, layout), nil
+}
+
+// UpdateTransfer rewrites both legs of the transfer. ifMatch is checked against
+// the version of the outgoing leg, which is the transfer's ETag.
+func (s *transfersService) UpdateTransfer(id string, ifMatch dtos.IfMatchDTO, transfer dtos.UpdateTransferDTO, layout string) (dtos.TransferResponseDTO, error) {
+	parsedDate, err := dates.Parse(transfer.Date)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	outgoing, incoming, err := s.getTransferLegs(id)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	if !ifMatch.Matches(outgoing.Version) {
+		return dtos.TransferResponseDTO{}, ErrVersionMismatch
+	}
+
+	fromAccount, toAccount, err := s.getTransferAccounts(transfer.FromAccountID, transfer.ToAccountID)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	amount, err := parseAmount(transfer.Amount, fromAccount.Currency)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	title := transfer.Title
+	if title == "" {
+		title = defaultTransferTitle
+	}
+
+	for _, entry := range []*model.TransactionsEntryModel{outgoing, incoming} {
+		entry.Amount = amount
+		entry.Title = title
+		entry.Currency = fromAccount.Currency
+		entry.Description = transfer.Description
+		entry.Date = parsedDate
+	}
+
+	outgoing.AccountID = fromAccount.ID
+	incoming.AccountID = toAccount.ID
+
+	updatedOutgoing, updatedIncoming, err := s.transactionsRepo.UpdateTransfer(outgoing, incoming)
+	if err != nil {
+		return dtos.TransferResponseDTO{}, err
+	}
+
+	return toTransferResponseDTO(updatedOutgoing, updatedIncoming, layout), nil
+}
+
+func (s *transfersService) DeleteTransfer(id string, ifMatch dtos.IfMatchDTO) error {
+	outgoing, incoming, err := s.getTransferLegs(id)
+	if err != nil {
+		return err
+	}
+
+	if !ifMatch.Matches(outgoing.Version) {
+		return ErrVersionMismatch
+	}
+
+	return s.transactionsRepo.DeleteTransfer(outgoing, incoming)
+}
+
+func (s *transfersService) getTransferAccounts(fromAccountID, toAccountID string) (*model.AccountModel, *model.AccountModel, error) {
+	fromAccount, err := s.accountsRepo.GetByID(fromAccountID)
+	if err != nil {
+		return nil, nil, referencedAccountError(err)
+	}
+
+	toAccount, err := s.accountsRepo.GetByID(toAccountID)
+	if err != nil {
+		return nil, nil, referencedAccountError(err)
+	}
+
+	if fromAccount.Currency != toAccount.Currency {
+		return nil, nil, ErrTransferCurrencyMismatch
+	}
+
+	return fromAccount, toAccount, nil
+}
+
+func (s *transfersService) getTransferLegs(id string) (*model.TransactionsEntryModel, *model.TransactionsEntryModel, error) {
+	entry, err := s.transactionsRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if entry.Type != transferType {
+		return nil, nil, ErrNotATransfer
+	}
+
+	linkedEntry, err := s.transactionsRepo.GetByID(entry.LinkedTransactionID.Hex())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if entry.TransferDirection == transferDirectionIn {
+		return linkedEntry, entry, nil
+	}
+
+	return entry, linkedEntry, nil
+}
+
+func toTransferResponseDTO(outgoing, incoming *model.TransactionsEntryModel, layout string) dtos.TransferResponseDTO {
+	return dtos.TransferResponseDTO{
+		ID:       outgoing.ID.Hex(),
+		Outgoing: toTransactionsEntryResponseDTO(outgoing, layout),
+		Incoming: toTransactionsEntryResponseDTO(incoming, layout),
+	}
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+	"myfin-api/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestTransfersServiceCreateTransferSuccess(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransfersService(mockRepo, mockAccountsRepo)
+
+	checkingID := primitive.NewObjectID()
+	savingsID := primitive.NewObjectID()
+
+	mockAccountsRepo.On("GetByID", checkingID.Hex()).Return(&model.AccountModel{ID: checkingID, Currency: "BRL"}, nil)
+	mockAccountsRepo.On("GetByID", savingsID.Hex()).Return(&model.AccountModel{ID: savingsID, Currency: "BRL"}, nil)
+
+	outgoingID := primitive.NewObjectID()
+	incomingID := primitive.NewObjectID()
+
+	mockRepo.On("CreateTransfer",
+		mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+			return entry.AccountID == checkingID && entry.TransferDirection == "out" && entry.Type == "transfer" && entry.Title == "Transfer"
+		}),
+		mock.MatchedBy(func(entry *model.TransactionsEntryModel) bool {
+			return entry.AccountID == savingsID && entry.TransferDirection == "in" && entry.Amount == 25000
+		}),
+	).Return(
+		&model.TransactionsEntryModel{ID: outgoingID, Amount: 25000, Type: "transfer", TransferDirection: "out", AccountID: checkingID, LinkedTransactionID: incomingID},
+		&model.TransactionsEntryModel{ID: incomingID, Amount: 25000, Type: "transfer", TransferDirection: "in", AccountID: savingsID, LinkedTransactionID: outgoingID},
+		nil,
+	)
+
+	result, err := service.CreateTransfer(dtos.CreateTransferDTO{
+		FromAccountID: checkingID.Hex(),
+		ToAccountID:   savingsID.Hex(),
+		Amount:        json.Number("250.00"),
+		Date:          "10/09/2025",
+	}, DateFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, outgoingID.Hex(), result.ID)
+	assert.Equal(t, incomingID.Hex(), result.Outgoing.LinkedTransactionID)
+	assert.Equal(t, outgoingID.Hex(), result.Incoming.LinkedTransactionID)
+	assert.Equal(t, "out", result.Outgoing.TransferDirection)
+	assert.Equal(t, "in", result.Incoming.TransferDirection)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransfersServiceCreateTransferCurrencyMismatch(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransfersService(mockRepo, mockAccountsRepo)
+
+	checkingID := primitive.NewObjectID()
+	dollarsID := primitive.NewObjectID()
+
+	mockAccountsRepo.On("GetByID", checkingID.Hex()).Return(&model.AccountModel{ID: checkingID, Currency: "BRL"}, nil)
+	mockAccountsRepo.On("GetByID", dollarsID.Hex()).Return(&model.AccountModel{ID: dollarsID, Currency: "USD"}, nil)
+
+	result, err := service.CreateTransfer(dtos.CreateTransferDTO{
+		FromAccountID: checkingID.Hex(),
+		ToAccountID:   dollarsID.Hex(),
+		Amount:        json.Number("100.00"),
+		Date:          "10/09/2025",
+	}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrTransferCurrencyMismatch)
+	assert.Equal(t, dtos.TransferResponseDTO{}, result)
+	mockRepo.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+}
+
+func TestTransfersServiceCreateTransferInvalidDate(t *testing.T) {
+	service := NewTransfersService(new(MockTransactionsRepository), new(MockAccountsRepository))
+
+	result, err := service.CreateTransfer(dtos.CreateTransferDTO{Amount: json.Number("10.00"), Date: "10-09-2025"}, DateFormat)
+
+	assert.Error(t, err)
+	assert.Equal(t, dtos.TransferResponseDTO{}, result)
+}
+
+func TestTransfersServiceUpdateTransferFromIncomingLeg(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	mockAccountsRepo := new(MockAccountsRepository)
+	service := NewTransfersService(mockRepo, mockAccountsRepo)
+
+	checkingID := primitive.NewObjectID()
+	savingsID := primitive.NewObjectID()
+	outgoingID := primitive.NewObjectID()
+	incomingID := primitive.NewObjectID()
+
+	outgoing := &model.TransactionsEntryModel{ID: outgoingID, Amount: 10000, Type: "transfer", TransferDirection: "out", AccountID: checkingID, LinkedTransactionID: incomingID, Version: 2}
+	incoming := &model.TransactionsEntryModel{ID: incomingID, Amount: 10000, Type: "transfer", TransferDirection: "in", AccountID: savingsID, LinkedTransactionID: outgoingID, Version: 2}
+
+	mockRepo.On("GetByID", incomingID.Hex()).Return(incoming, nil)
+	mockRepo.On("GetByID", outgoingID.Hex()).Return(outgoing, nil)
+	mockAccountsRepo.On("GetByID", checkingID.Hex()).Return(&model.AccountModel{ID: checkingID, Currency: "BRL"}, nil)
+	mockAccountsRepo.On("GetByID", savingsID.Hex()).Return(&model.AccountModel{ID: savingsID, Currency: "BRL"}, nil)
+
+	mockRepo.On("UpdateTransfer", outgoing, incoming).Return(outgoing, incoming, nil)
+
+	result, err := service.UpdateTransfer(incomingID.Hex(), dtos.IfMatchVersion(2), dtos.UpdateTransferDTO{
+		FromAccountID: checkingID.Hex(),
+		ToAccountID:   savingsID.Hex(),
+		Amount:        json.Number("175.50"),
+		Title:         "Emergency fund",
+		Date:          "11/09/2025",
+	}, DateFormat)
+
+	expectedDate, _ := time.Parse(DateFormat, "11/09/2025")
+
+	assert.NoError(t, err)
+	assert.Equal(t, outgoingID.Hex(), result.ID)
+	assert.Equal(t, int64(17550), outgoing.Amount)
+	assert.Equal(t, int64(17550), incoming.Amount)
+	assert.Equal(t, "Emergency fund", incoming.Title)
+	assert.Equal(t, expectedDate, outgoing.Date)
+
+	mockRepo.AssertExpectations(t)
+	mockAccountsRepo.AssertExpectations(t)
+}
+
+func TestTransfersServiceUpdateTransferStaleVersion(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransfersService(mockRepo, new(MockAccountsRepository))
+
+	outgoingID := primitive.NewObjectID()
+	incomingID := primitive.NewObjectID()
+
+	mockRepo.On("GetByID", outgoingID.Hex()).Return(&model.TransactionsEntryModel{ID: outgoingID, Type: "transfer", TransferDirection: "out", LinkedTransactionID: incomingID, Version: 3}, nil)
+	mockRepo.On("GetByID", incomingID.Hex()).Return(&model.TransactionsEntryModel{ID: incomingID, Type: "transfer", TransferDirection: "in", LinkedTransactionID: outgoingID, Version: 3}, nil)
+
+	_, err := service.UpdateTransfer(outgoingID.Hex(), dtos.IfMatchVersion(2), dtos.UpdateTransferDTO{
+		FromAccountID: primitive.NewObjectID().Hex(),
+		ToAccountID:   primitive.NewObjectID().Hex(),
+		Amount:        json.Number("10.00"),
+		Date:          "11/09/2025",
+	}, DateFormat)
+
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+	mockRepo.AssertNotCalled(t, "UpdateTransfer", mock.Anything, mock.Anything)
+}
+
+func TestTransfersServiceGetTransferByIDNotATransfer(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransfersService(mockRepo, new(MockAccountsRepository))
+
+	objectID := primitive.NewObjectID()
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "expense"}, nil)
+
+	result, err := service.GetTransferByID(objectID.Hex(), DateFormat)
+
+	assert.ErrorIs(t, err, ErrNotATransfer)
+	assert.Equal(t, dtos.TransferResponseDTO{}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransfersServiceDeleteTransfer(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransfersService(mockRepo, new(MockAccountsRepository))
+
+	objectID := primitive.NewObjectID()
+	linkedID := primitive.NewObjectID()
+	entry := &model.TransactionsEntryModel{ID: objectID, Type: "transfer", TransferDirection: "out", LinkedTransactionID: linkedID, Version: 1}
+	linked := &model.TransactionsEntryModel{ID: linkedID, Type: "transfer", TransferDirection: "in", LinkedTransactionID: objectID, Version: 1}
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(entry, nil)
+	mockRepo.On("GetByID", linkedID.Hex()).Return(linked, nil)
+	mockRepo.On("DeleteTransfer", entry, linked).Return(nil)
+
+	err := service.DeleteTransfer(objectID.Hex(), dtos.IfMatchVersion(1))
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransfersServiceDeleteTransferStaleVersion(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransfersService(mockRepo, new(MockAccountsRepository))
+
+	objectID := primitive.NewObjectID()
+	linkedID := primitive.NewObjectID()
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(&model.TransactionsEntryModel{ID: objectID, Type: "transfer", TransferDirection: "out", LinkedTransactionID: linkedID, Version: 2}, nil)
+	mockRepo.On("GetByID", linkedID.Hex()).Return(&model.TransactionsEntryModel{ID: linkedID, Type: "transfer", TransferDirection: "in", LinkedTransactionID: objectID, Version: 2}, nil)
+
+	err := service.DeleteTransfer(objectID.Hex(), dtos.IfMatchVersion(1))
+
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+	mockRepo.AssertNotCalled(t, "DeleteTransfer", mock.Anything, mock.Anything)
+}
+
+func TestTransfersServiceDeleteTransferRepositoryError(t *testing.T) {
+	mockRepo := new(MockTransactionsRepository)
+	service := NewTransfersService(mockRepo, new(MockAccountsRepository))
+
+	objectID := primitive.NewObjectID()
+	linkedID := primitive.NewObjectID()
+	entry := &model.TransactionsEntryModel{ID: objectID, Type: "transfer", TransferDirection: "out", LinkedTransactionID: linkedID, Version: 1}
+	linked := &model.TransactionsEntryModel{ID: linkedID, Type: "transfer", TransferDirection: "in", LinkedTransactionID: objectID, Version: 1}
+	expectedError := errors.New("transaction aborted")
+
+	mockRepo.On("GetByID", objectID.Hex()).Return(entry, nil)
+	mockRepo.On("GetByID", linkedID.Hex()).Return(linked, nil)
+	mockRepo.On("DeleteTransfer", entry, linked).Return(expectedError)
+
+	err := service.DeleteTransfer(objectID.Hex(), dtos.IfMatchVersion(1))
+
+	assert.Equal(t, expectedError, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"myfin-api/internal/services"
+)
+
+type RecurringWorker interface {
+	Start(ctx context.Context)
+	RunOnce()
+}
+
+type recurringWorker struct {
+	recurringService services.RecurringRulesService
+	interval         time.Duration
+}
+
+func NewRecurringWorker(recurringService services.RecurringRulesService, interval time.Duration) RecurringWorker {
+	return &recurringWorker{
+		recurringService: recurringService,
+		interval:         interval,
+	}
+}
+
+func (w *recurringWorker) Start(ctx context.Context) {
+	w.RunOnce()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.RunOnce()
+		}
+	}
+}
+
+func (w *recurringWorker) RunOnce() {
+	generated, err := w.recurringService.GenerateDueOccurrences(time.Now().UTC())
+	if err != nil {
+		log.Println("Erro ao gerar transações recorrentes:", err)
+	}
+
+	if generated > 0 {
+		log.Printf("%d transações recorrentes geradas", generated)
+	}
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myfin-api/internal/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRecurringRulesService struct {
+	mock.Mock
+}
+
+func (m *MockRecurringRulesService) CreateRecurringRule(rule dtos.CreateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(rule, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) GetAllRecurringRules(layout string) ([]dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(layout)
+	return args.Get(0).([]dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) GetRecurringRuleByID(id string, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(id, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) UpdateRecurringRule(id string, rule dtos.UpdateRecurringRuleDTO, layout string) (dtos.RecurringRuleResponseDTO, error) {
+	args := m.Called(id, rule, layout)
+	return args.Get(0).(dtos.RecurringRuleResponseDTO), args.Error(1)
+}
+
+func (m *MockRecurringRulesService) DeleteRecurringRule(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRecurringRulesService) GenerateDueOccurrences(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+func TestRecurringWorkerRunOnce(t *testing.T) {
+	t.Run("generates_due_occurrences", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		worker := NewRecurringWorker(mockService, time.Minute)
+
+		mockService.On("GenerateDueOccurrences", mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+
+		worker.RunOnce()
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service_error_does_not_panic", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		worker := NewRecurringWorker(mockService, time.Minute)
+
+		mockService.On("GenerateDueOccurrences", mock.AnythingOfType("time.Time")).Return(0, errors.New("database error")).Once()
+
+		assert.NotPanics(t, worker.RunOnce)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestRecurringWorkerStart(t *testing.T) {
+	t.Run("runs_on_start_and_stops_on_cancel", func(t *testing.T) {
+		mockService := new(MockRecurringRulesService)
+		worker := NewRecurringWorker(mockService, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		mockService.On("GenerateDueOccurrences", mock.AnythingOfType("time.Time")).Return(0, nil).Once().Run(func(args mock.Arguments) {
+			cancel()
+		})
+
+		done := make(chan struct{})
+		go func() {
+			worker.Start(ctx)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop after the context was cancelled")
+		}
+
+		mockService.AssertExpectations(t)
+	})
+}